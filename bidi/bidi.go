@@ -0,0 +1,673 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+// Package bidi implements the Unicode Bidirectional Algorithm,
+// resolving the embedding levels of a paragraph of text and
+// splitting it into directional runs, suitable for shaping and display.
+//
+// The reference documentation is at https://unicode.org/reports/tr9.
+package bidi
+
+import (
+	"github.com/go-text/typesetting/di"
+	ucd "github.com/go-text/typesetting/unicodedata"
+)
+
+// class is a compact version of the Bidi_Class property,
+// which is modified during the resolution.
+type class uint8
+
+const (
+	cL class = iota
+	cR
+	cAL
+	cEN
+	cES
+	cET
+	cAN
+	cCS
+	cNSM
+	cBN
+	cB
+	cS
+	cWS
+	cON
+	cLRE
+	cLRO
+	cRLE
+	cRLO
+	cPDF
+	cLRI
+	cRLI
+	cFSI
+	cPDI
+)
+
+func lookupClass(r rune) class {
+	switch ucd.LookupBidiClass(r) {
+	case ucd.BidiR:
+		return cR
+	case ucd.BidiAL:
+		return cAL
+	case ucd.BidiEN:
+		return cEN
+	case ucd.BidiES:
+		return cES
+	case ucd.BidiET:
+		return cET
+	case ucd.BidiAN:
+		return cAN
+	case ucd.BidiCS:
+		return cCS
+	case ucd.BidiNSM:
+		return cNSM
+	case ucd.BidiBN:
+		return cBN
+	case ucd.BidiB:
+		return cB
+	case ucd.BidiS:
+		return cS
+	case ucd.BidiWS:
+		return cWS
+	case ucd.BidiON:
+		return cON
+	case ucd.BidiLRE:
+		return cLRE
+	case ucd.BidiLRO:
+		return cLRO
+	case ucd.BidiRLE:
+		return cRLE
+	case ucd.BidiRLO:
+		return cRLO
+	case ucd.BidiPDF:
+		return cPDF
+	case ucd.BidiLRI:
+		return cLRI
+	case ucd.BidiRLI:
+		return cRLI
+	case ucd.BidiFSI:
+		return cFSI
+	case ucd.BidiPDI:
+		return cPDI
+	default:
+		return cL
+	}
+}
+
+func (c class) isIsolateInitiator() bool { return c == cLRI || c == cRLI || c == cFSI }
+
+// isRemoved returns true for the classes removed by rule X9
+func (c class) isRemoved() bool {
+	switch c {
+	case cRLE, cLRE, cRLO, cLRO, cPDF, cBN:
+		return true
+	}
+	return false
+}
+
+// isNeutral returns true for the NI classes used in rules N1 and N2
+func (c class) isNeutral() bool {
+	switch c {
+	case cB, cS, cWS, cON, cLRI, cRLI, cFSI, cPDI:
+		return true
+	}
+	return false
+}
+
+// isTrailingWhitespace returns true for the classes reset by rule L1
+func (c class) isTrailingWhitespace() bool {
+	return c == cWS || c.isIsolateInitiator() || c == cPDI || c.isRemoved()
+}
+
+// strongDirection returns the direction used by rule N1,
+// where EN and AN are treated as R.
+func strongDirection(c class) class {
+	if c == cL {
+		return cL
+	}
+	return cR
+}
+
+// maxDepth is the maximum explicit embedding level (BD2)
+const maxDepth = 125
+
+// Level is an embedding level, as defined in BD2.
+// Odd levels are right-to-left, even levels are left-to-right.
+type Level uint8
+
+// Direction returns the direction associated to the level parity.
+func (l Level) Direction() di.Direction {
+	if l&1 == 1 {
+		return di.DirectionRTL
+	}
+	return di.DirectionLTR
+}
+
+// embeddingDirection returns L or R
+func (l Level) embeddingDirection() class {
+	if l&1 == 1 {
+		return cR
+	}
+	return cL
+}
+
+// nextLevel returns the least odd (rtl) or even level greater than l
+func (l Level) nextLevel(rtl bool) Level {
+	if rtl {
+		return (l + 1) | 1
+	}
+	return (l + 2) &^ 1
+}
+
+// Run is a sequence of runes with the same embedding level.
+type Run struct {
+	// Start and End are the indices of the run in the paragraph,
+	// End is exclusive.
+	Start, End int
+	// Level is the resolved embedding level of the run
+	Level Level
+}
+
+// Direction returns the direction of the run.
+func (r Run) Direction() di.Direction { return r.Level.Direction() }
+
+// Paragraph stores the embedding levels resolved for a paragraph.
+// It may be reused for several paragraphs, to avoid allocations.
+//
+// Usage :
+//
+//	var p Paragraph
+//	p.Init(...)
+//	for _, run := range p.Runs() {
+//	  ... // shape the text with run.Direction()
+//	}
+//	... // once the paragraph is split into lines :
+//	visualRuns := p.Line(start, end)
+type Paragraph struct {
+	original []class // the Bidi_Class of each rune
+	types    []class // the resolved class of each rune
+	levels   []Level // the resolved level of each rune
+
+	// for each isolate initiator, the index of the matching PDI,
+	// or -1 (see BD9); for each PDI, the index of the matching initiator,
+	// or -1
+	matchingIsolate []int
+
+	// buffers used for isolating run sequences
+	runStarts []int
+	sequence  []int
+	pairs     []bracketPair
+
+	lineLevels []Level
+
+	baseLevel Level
+}
+
+// Init resolves the embedding levels of the given paragraph.
+// The text should contain one paragraph : if present,
+// a paragraph separator is only expected at the end.
+//
+// If detect is true, the paragraph embedding level is determined by the
+// first strong character of the text (rules P2 and P3), defaulting to
+// [dir] if there is none. Otherwise [dir] is used.
+func (p *Paragraph) Init(text []rune, dir di.Direction, detect bool) {
+	n := len(text)
+	p.original = p.original[:0]
+	for _, r := range text {
+		p.original = append(p.original, lookupClass(r))
+	}
+	p.types = append(p.types[:0], p.original...)
+	p.levels = append(p.levels[:0], make([]Level, n)...)
+	p.matchingIsolate = append(p.matchingIsolate[:0], make([]int, n)...)
+
+	p.resolveMatchingIsolates()
+
+	p.baseLevel = 0
+	if dir == di.DirectionRTL {
+		p.baseLevel = 1
+	}
+	if detect {
+		if l, ok := p.firstStrongLevel(0, n); ok {
+			p.baseLevel = l
+		}
+	}
+
+	p.resolveExplicitLevels()
+	p.resolveSequences(text)
+	p.assignRemovedLevels()
+}
+
+// BaseLevel returns the paragraph embedding level.
+func (p *Paragraph) BaseLevel() Level { return p.baseLevel }
+
+// Levels returns the resolved embedding level of each rune,
+// before applying the line dependent rule L1.
+// The returned slice is owned by the paragraph, and is only valid
+// until the next call to [Init].
+func (p *Paragraph) Levels() []Level { return p.levels }
+
+// Runs returns the runs of the paragraph, in logical order.
+// The runs are suitable for shaping, which should be done with
+// the [Run.Direction].
+func (p *Paragraph) Runs() []Run { return appendRuns(nil, p.levels, 0) }
+
+// Line returns the runs of the line text[start:end], in visual
+// order (from left to right), applying rules L1 and L2.
+func (p *Paragraph) Line(start, end int) []Run {
+	p.lineLevels = append(p.lineLevels[:0], p.levels[start:end]...)
+	levels := p.lineLevels
+
+	// rule L1
+	for i := start; i < end; i++ {
+		if t := p.original[i]; t == cS || t == cB {
+			levels[i-start] = p.baseLevel
+			for j := i - 1; j >= start && p.original[j].isTrailingWhitespace(); j-- {
+				levels[j-start] = p.baseLevel
+			}
+		}
+	}
+	for j := end - 1; j >= start && p.original[j].isTrailingWhitespace(); j-- {
+		levels[j-start] = p.baseLevel
+	}
+
+	runs := appendRuns(nil, levels, start)
+	reorderRuns(runs)
+	return runs
+}
+
+// appendRuns splits levels into runs, offset by start
+func appendRuns(runs []Run, levels []Level, start int) []Run {
+	for i := 0; i < len(levels); {
+		j := i + 1
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		runs = append(runs, Run{Start: start + i, End: start + j, Level: levels[i]})
+		i = j
+	}
+	return runs
+}
+
+// reorderRuns applies rule L2 : from the highest level to the lowest odd level,
+// reverse any contiguous sequence of runs at that level or higher.
+func reorderRuns(runs []Run) {
+	if len(runs) == 0 {
+		return
+	}
+	highest, lowestOdd := Level(0), Level(maxDepth+2)
+	for _, run := range runs {
+		if run.Level > highest {
+			highest = run.Level
+		}
+		if run.Level&1 == 1 && run.Level < lowestOdd {
+			lowestOdd = run.Level
+		}
+	}
+	for level := highest; level >= lowestOdd; level-- {
+		for i := 0; i < len(runs); {
+			if runs[i].Level < level {
+				i++
+				continue
+			}
+			j := i + 1
+			for j < len(runs) && runs[j].Level >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				runs[a], runs[b] = runs[b], runs[a]
+			}
+			i = j
+		}
+	}
+}
+
+// resolveMatchingIsolates implements BD9
+func (p *Paragraph) resolveMatchingIsolates() {
+	stack := p.runStarts[:0] // use as temporary storage
+	for i, t := range p.original {
+		p.matchingIsolate[i] = -1
+		switch {
+		case t.isIsolateInitiator():
+			stack = append(stack, i)
+		case t == cPDI:
+			if len(stack) != 0 {
+				opening := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				p.matchingIsolate[opening] = i
+				p.matchingIsolate[i] = opening
+			}
+		case t == cB:
+			stack = stack[:0]
+		}
+	}
+	p.runStarts = stack
+}
+
+// firstStrongLevel implements rules P2 and P3 on text[start:end],
+// returning false if no strong character is found.
+func (p *Paragraph) firstStrongLevel(start, end int) (Level, bool) {
+	for i := start; i < end; i++ {
+		switch t := p.original[i]; {
+		case t == cL:
+			return 0, true
+		case t == cR || t == cAL:
+			return 1, true
+		case t.isIsolateInitiator():
+			if p.matchingIsolate[i] == -1 {
+				return 0, false
+			}
+			i = p.matchingIsolate[i]
+		case t == cB:
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// directionalStatus is an entry of the stack used in rules X1 to X8
+type directionalStatus struct {
+	level    Level
+	override class // cON, cL or cR
+	isolate  bool
+}
+
+// resolveExplicitLevels implements rules X1 to X8
+func (p *Paragraph) resolveExplicitLevels() {
+	var storage [maxDepth + 2]directionalStatus
+	stack := append(storage[:0], directionalStatus{level: p.baseLevel, override: cON})
+	var overflowIsolates, overflowEmbeddings, validIsolates int
+
+	for i, t := range p.original {
+		top := stack[len(stack)-1]
+		switch t {
+		case cRLE, cLRE, cRLO, cLRO: // rules X2 to X5
+			p.levels[i] = top.level
+			newLevel := top.level.nextLevel(t == cRLE || t == cRLO)
+			if newLevel <= maxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				override := cON
+				if t == cRLO {
+					override = cR
+				} else if t == cLRO {
+					override = cL
+				}
+				stack = append(stack, directionalStatus{level: newLevel, override: override})
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+		case cRLI, cLRI, cFSI: // rules X5a to X5c
+			p.levels[i] = top.level
+			if top.override != cON {
+				p.types[i] = top.override
+			}
+			isRTL := t == cRLI
+			if t == cFSI {
+				end := p.matchingIsolate[i]
+				if end == -1 {
+					end = len(p.original)
+				}
+				level, _ := p.firstStrongLevel(i+1, end)
+				isRTL = level == 1
+			}
+			newLevel := top.level.nextLevel(isRTL)
+			if newLevel <= maxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates++
+				stack = append(stack, directionalStatus{level: newLevel, override: cON, isolate: true})
+			} else {
+				overflowIsolates++
+			}
+		case cPDI: // rule X6a
+			if overflowIsolates > 0 {
+				overflowIsolates--
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			top = stack[len(stack)-1]
+			p.levels[i] = top.level
+			if top.override != cON {
+				p.types[i] = top.override
+			}
+		case cPDF: // rule X7
+			p.levels[i] = top.level
+			if overflowIsolates > 0 {
+				// nothing to do
+			} else if overflowEmbeddings > 0 {
+				overflowEmbeddings--
+			} else if !top.isolate && len(stack) >= 2 {
+				stack = stack[:len(stack)-1]
+			}
+		case cB: // rule X8
+			p.levels[i] = p.baseLevel
+			stack = stack[:1]
+			overflowIsolates, overflowEmbeddings, validIsolates = 0, 0, 0
+		case cBN: // ignored
+			p.levels[i] = top.level
+		default: // rule X6
+			p.levels[i] = top.level
+			if top.override != cON {
+				p.types[i] = top.override
+			}
+		}
+	}
+}
+
+// resolveSequences implements rule X10, splitting the text into
+// isolating run sequences, and resolves each of them.
+func (p *Paragraph) resolveSequences(text []rune) {
+	n := len(p.original)
+	// runStarts[i] is the end of the level run starting at i, or -1
+	p.runStarts = append(p.runStarts[:0], make([]int, n)...)
+	for i := range p.runStarts {
+		p.runStarts[i] = -1
+	}
+	for i := 0; i < n; {
+		if p.original[i].isRemoved() {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < n && (p.original[j].isRemoved() || p.levels[j] == p.levels[i]) {
+			j++
+		}
+		p.runStarts[i] = j
+		i = j
+	}
+
+	for i := 0; i < n; i++ {
+		end := p.runStarts[i]
+		if end == -1 {
+			continue
+		}
+		seq := p.appendRun(p.sequence[:0], i, end)
+		for {
+			last := seq[len(seq)-1]
+			if !p.original[last].isIsolateInitiator() || p.matchingIsolate[last] == -1 {
+				break
+			}
+			pdi := p.matchingIsolate[last]
+			if p.runStarts[pdi] == -1 { // should not happen
+				break
+			}
+			seq = p.appendRun(seq, pdi, p.runStarts[pdi])
+			p.runStarts[pdi] = -1 // the run is now handled
+		}
+		p.sequence = seq
+		p.resolveSequence(text, seq)
+	}
+}
+
+// appendRun appends the indices of the not removed runes in [start, end)
+func (p *Paragraph) appendRun(seq []int, start, end int) []int {
+	for i := start; i < end; i++ {
+		if !p.original[i].isRemoved() {
+			seq = append(seq, i)
+		}
+	}
+	return seq
+}
+
+// resolveSequence applies the rules W1 to I2 on an isolating run sequence,
+// given by its indices in the paragraph.
+func (p *Paragraph) resolveSequence(text []rune, seq []int) {
+	types := p.types
+	first, last := seq[0], seq[len(seq)-1]
+	level := p.levels[first]
+
+	// compute sos and eos
+	prevLevel, nextLevel := p.baseLevel, p.baseLevel
+	for j := first - 1; j >= 0; j-- {
+		if !p.original[j].isRemoved() {
+			prevLevel = p.levels[j]
+			break
+		}
+	}
+	if !p.original[last].isIsolateInitiator() {
+		for j := last + 1; j < len(p.original); j++ {
+			if !p.original[j].isRemoved() {
+				nextLevel = p.levels[j]
+				break
+			}
+		}
+	}
+	sos, eos := maxLevel(level, prevLevel).embeddingDirection(), maxLevel(level, nextLevel).embeddingDirection()
+
+	// W1
+	for k, idx := range seq {
+		if types[idx] != cNSM {
+			continue
+		}
+		if k == 0 {
+			types[idx] = sos
+		} else if prev := seq[k-1]; p.original[prev].isIsolateInitiator() || p.original[prev] == cPDI {
+			types[idx] = cON
+		} else {
+			types[idx] = types[prev]
+		}
+	}
+
+	// W2 and W3
+	lastStrong := sos
+	for _, idx := range seq {
+		switch types[idx] {
+		case cL, cR:
+			lastStrong = types[idx]
+		case cAL:
+			lastStrong = cAL
+			types[idx] = cR
+		case cEN:
+			if lastStrong == cAL {
+				types[idx] = cAN
+			}
+		}
+	}
+
+	// W4
+	for k := 1; k < len(seq)-1; k++ {
+		t, prev, next := types[seq[k]], types[seq[k-1]], types[seq[k+1]]
+		if (t == cES || t == cCS) && prev == cEN && next == cEN {
+			types[seq[k]] = cEN
+		} else if t == cCS && prev == cAN && next == cAN {
+			types[seq[k]] = cAN
+		}
+	}
+
+	// W5
+	for k := 0; k < len(seq); k++ {
+		if types[seq[k]] != cET {
+			continue
+		}
+		e := k + 1
+		for e < len(seq) && types[seq[e]] == cET {
+			e++
+		}
+		if (k > 0 && types[seq[k-1]] == cEN) || (e < len(seq) && types[seq[e]] == cEN) {
+			for _, idx := range seq[k:e] {
+				types[idx] = cEN
+			}
+		}
+		k = e - 1
+	}
+
+	// W6 and W7
+	lastStrong = sos
+	for _, idx := range seq {
+		switch types[idx] {
+		case cES, cET, cCS:
+			types[idx] = cON
+		case cL, cR:
+			lastStrong = types[idx]
+		case cEN:
+			if lastStrong == cL {
+				types[idx] = cL
+			}
+		}
+	}
+
+	// N0
+	embedding := level.embeddingDirection()
+	p.resolvePairedBrackets(text, seq, sos, embedding)
+
+	// N1 and N2
+	for k := 0; k < len(seq); k++ {
+		if !types[seq[k]].isNeutral() {
+			continue
+		}
+		e := k + 1
+		for e < len(seq) && types[seq[e]].isNeutral() {
+			e++
+		}
+		leading, trailing := sos, eos
+		if k > 0 {
+			leading = strongDirection(types[seq[k-1]])
+		}
+		if e < len(seq) {
+			trailing = strongDirection(types[seq[e]])
+		}
+		dir := embedding
+		if leading == trailing {
+			dir = leading
+		}
+		for _, idx := range seq[k:e] {
+			types[idx] = dir
+		}
+		k = e - 1
+	}
+
+	// I1 and I2
+	for _, idx := range seq {
+		t := types[idx]
+		if p.levels[idx]&1 == 0 {
+			if t == cR {
+				p.levels[idx] += 1
+			} else if t == cAN || t == cEN {
+				p.levels[idx] += 2
+			}
+		} else if t == cL || t == cEN || t == cAN {
+			p.levels[idx] += 1
+		}
+	}
+}
+
+func maxLevel(a, b Level) Level {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// assignRemovedLevels gives the runes removed by rule X9
+// the level of the preceding rune, so that they do not
+// split the runs.
+func (p *Paragraph) assignRemovedLevels() {
+	level := p.baseLevel
+	for i, t := range p.original {
+		if t.isRemoved() {
+			p.levels[i] = level
+		} else {
+			level = p.levels[i]
+		}
+	}
+}
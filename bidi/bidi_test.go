@@ -0,0 +1,458 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package bidi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+)
+
+func TestLevels(t *testing.T) {
+	for _, test := range []struct {
+		text      string
+		dir       di.Direction
+		detect    bool
+		baseLevel Level
+		levels    []Level
+	}{
+		{"abc", di.DirectionLTR, false, 0, []Level{0, 0, 0}},
+		{"abc", di.DirectionRTL, false, 1, []Level{2, 2, 2}},
+		{"אבג", di.DirectionLTR, true, 1, []Level{1, 1, 1}},
+		{"", di.DirectionRTL, true, 1, nil},
+		{"123", di.DirectionRTL, true, 1, []Level{2, 2, 2}}, // no strong character
+		{"ab אב cd", di.DirectionLTR, false, 0, []Level{0, 0, 0, 1, 1, 0, 0, 0}},
+		// W4 and W7
+		{"ab 1.2", di.DirectionLTR, false, 0, []Level{0, 0, 0, 0, 0, 0}},
+		// numbers after R
+		{"אב 12", di.DirectionLTR, true, 1, []Level{1, 1, 1, 2, 2}},
+		// W2 : EN after AL is AN
+		{"ا 12", di.DirectionLTR, true, 1, []Level{1, 1, 2, 2}},
+		// W5 : ET adjacent to EN
+		{"אב $12", di.DirectionLTR, true, 1, []Level{1, 1, 1, 2, 2, 2}},
+		// W1 : NSM
+		{"אב\u0301c", di.DirectionLTR, true, 1, []Level{1, 1, 1, 2}},
+		// RLE ... PDF
+		{"a\u202Bb\u202Cc", di.DirectionLTR, false, 0, []Level{0, 0, 2, 2, 0}},
+		// RLO ... PDF
+		{"\u202Eab\u202C", di.DirectionLTR, false, 0, []Level{0, 1, 1, 1}},
+		// LRO inside RTL
+		{"\u202Dאב\u202C", di.DirectionRTL, false, 1, []Level{1, 2, 2, 2}},
+		// RLI ... PDI
+		{"a \u2067b\u2069 c", di.DirectionLTR, false, 0, []Level{0, 0, 0, 2, 0, 0, 0}},
+		// FSI ... PDI, resolved as RLI
+		{"a\u2068אב\u2069", di.DirectionLTR, false, 0, []Level{0, 0, 1, 1, 0}},
+		// FSI ... PDI, resolved as LRI
+		{"א\u2068ab\u2069", di.DirectionLTR, true, 1, []Level{1, 1, 2, 2, 1}},
+		// isolates are skipped by P2
+		{"\u2067אב\u2069c", di.DirectionRTL, true, 0, []Level{0, 1, 1, 0, 0}},
+		// unmatched PDI
+		{"a\u2069b", di.DirectionLTR, false, 0, []Level{0, 0, 0}},
+		// N0 : brackets with the embedding direction inside
+		{"אב(גד[&ef]!)gh", di.DirectionRTL, false, 1, []Level{1, 1, 1, 1, 1, 1, 1, 2, 2, 1, 1, 1, 2, 2}},
+		{"a(b)ג", di.DirectionRTL, false, 1, []Level{2, 2, 2, 2, 1}},
+		// N0 : opposite direction inside, with the same context
+		{"א(ב)c", di.DirectionLTR, false, 0, []Level{1, 1, 1, 1, 0}},
+		{"א(1)c", di.DirectionLTR, false, 0, []Level{1, 1, 2, 1, 0}},
+		// N0 : opposite direction inside, with the embedding context
+		{"a(ב)c", di.DirectionLTR, false, 0, []Level{0, 0, 1, 0, 0}},
+		// N0 : NSM after a bracket
+		{"א(ב)\u0301c", di.DirectionLTR, false, 0, []Level{1, 1, 1, 1, 1, 0}},
+		// N0 : unmatched brackets
+		{"א(ב]c", di.DirectionLTR, false, 0, []Level{1, 1, 1, 0, 0}},
+		// N0 : canonical equivalent brackets
+		{"א\u2329ב\u3009c", di.DirectionLTR, false, 0, []Level{1, 1, 1, 1, 0}},
+		// N0 : brackets overridden by RLO are not paired
+		{"\u202Eא(ב)\u202Cc", di.DirectionLTR, false, 0, []Level{0, 1, 1, 1, 1, 1, 0}},
+	} {
+		var p Paragraph
+		p.Init([]rune(test.text), test.dir, test.detect)
+		if p.BaseLevel() != test.baseLevel {
+			t.Errorf("%q: expected base level %d, got %d", test.text, test.baseLevel, p.BaseLevel())
+		}
+		if got := p.Levels(); !reflect.DeepEqual(got, test.levels) {
+			t.Errorf("%q: expected levels %v, got %v", test.text, test.levels, got)
+		}
+	}
+}
+
+func TestRuns(t *testing.T) {
+	var p Paragraph
+	p.Init([]rune("ab אב cd"), di.DirectionLTR, false)
+	expected := []Run{{0, 3, 0}, {3, 5, 1}, {5, 8, 0}}
+	if got := p.Runs(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if p.Runs()[1].Direction() != di.DirectionRTL {
+		t.Error("expected RTL run")
+	}
+
+	// visual order
+	p.Init([]rune("אב ab גד"), di.DirectionLTR, true)
+	expected = []Run{{5, 8, 1}, {3, 5, 2}, {0, 3, 1}}
+	if got := p.Line(0, 8); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	expected = []Run{{3, 5, 2}, {0, 3, 1}}
+	if got := p.Line(0, 5); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	// rule L1 : trailing whitespaces are reset to the paragraph level
+	p.Init([]rune("a\u202Bb \u202C"), di.DirectionLTR, false)
+	if got, exp := p.Levels(), []Level{0, 0, 2, 1, 1}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("expected %v, got %v", exp, got)
+	}
+	expected = []Run{{0, 2, 0}, {2, 3, 2}, {3, 5, 0}}
+	if got := p.Line(0, 5); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	// a segment separator is also reset
+	p.Init([]rune("אב\tגד"), di.DirectionLTR, false)
+	expected = []Run{{0, 2, 1}, {2, 3, 0}, {3, 5, 1}}
+	if got := p.Line(0, 5); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestLookupBracket(t *testing.T) {
+	for _, test := range []struct {
+		r         rune
+		pair      rune
+		isOpening bool
+	}{
+		{'(', '(', true},
+		{')', '(', false},
+		{']', '[', false},
+		{'\\', 0, false},
+		{'a', 0, false},
+		{0x2329, 0x3008, true},
+		{0x3009, 0x3008, false},
+		{0xFF63, 0xFF62, false},
+	} {
+		pair, isOpening := lookupBracket(test.r)
+		if pair != test.pair || isOpening != test.isOpening {
+			t.Errorf("%U: expected (%U, %v), got (%U, %v)", test.r, test.pair, test.isOpening, pair, isOpening)
+		}
+	}
+}
+
+// bidiTestClasses maps the Bidi_Class values used in BidiTest.txt
+// to a character of that class.
+var bidiTestClasses = map[string]rune{
+	"L":   'a',
+	"R":   0x05D0,
+	"AL":  0x0627,
+	"EN":  '1',
+	"ES":  '+',
+	"ET":  '$',
+	"AN":  0x0660,
+	"CS":  ',',
+	"NSM": 0x0300,
+	"BN":  0x00AD,
+	"B":   0x2029,
+	"S":   '\t',
+	"WS":  ' ',
+	"ON":  '!',
+	"LRE": 0x202A,
+	"RLE": 0x202B,
+	"PDF": 0x202C,
+	"LRO": 0x202D,
+	"RLO": 0x202E,
+	"LRI": 0x2066,
+	"RLI": 0x2067,
+	"FSI": 0x2068,
+	"PDI": 0x2069,
+}
+
+// readBidiTestFile returns the lines of a test file from the Unicode
+// Character Database, stored in the test directory.
+func readBidiTestFile(t *testing.T, name string) []string {
+	file := "test/" + name
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		t.Fatalf("%s is missing, download it from https://www.unicode.org/Public/15.0.0/ucd/%s", file, name)
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(string(b), "\n")
+}
+
+// parseBidiLevels parses the space separated levels of a test file,
+// where x marks a character removed by rule X9, returned as -1.
+func parseBidiLevels(field string) ([]int, error) {
+	var levels []int
+	for _, f := range strings.Fields(field) {
+		if f == "x" {
+			levels = append(levels, -1)
+			continue
+		}
+		level, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+// parseBidiOrder parses the space separated indices of a test file.
+func parseBidiOrder(field string) ([]int, error) {
+	var order []int
+	for _, f := range strings.Fields(field) {
+		index, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, err
+		}
+		order = append(order, index)
+	}
+	return order, nil
+}
+
+// checkBidiLine compares the levels and the visual order of the paragraph, displayed
+// on one line, to the expected ones, ignoring the characters removed by rule X9.
+func checkBidiLine(p *Paragraph, expectedLevels, expectedOrder []int) error {
+	n := len(expectedLevels)
+	levels := make([]int, n)
+	var order []int
+	for _, run := range p.Line(0, n) {
+		for i := run.Start; i < run.End; i++ {
+			levels[i] = int(run.Level)
+		}
+		// rule L2 reverses the characters of the runs with an odd level
+		for k := 0; k < run.End-run.Start; k++ {
+			i := run.Start + k
+			if run.Level&1 == 1 {
+				i = run.End - 1 - k
+			}
+			if expectedLevels[i] != -1 {
+				order = append(order, i)
+			}
+		}
+	}
+	for i, level := range expectedLevels {
+		if level == -1 {
+			levels[i] = -1
+		}
+	}
+	if !reflect.DeepEqual(levels, expectedLevels) {
+		return fmt.Errorf("expected levels %v, got %v", expectedLevels, levels)
+	}
+	if len(order) == 0 && len(expectedOrder) == 0 {
+		return nil
+	}
+	if !reflect.DeepEqual(order, expectedOrder) {
+		return fmt.Errorf("expected order %v, got %v", expectedOrder, order)
+	}
+	return nil
+}
+
+func TestBidiUnicodeReference(t *testing.T) {
+	lines := readBidiTestFile(t, "BidiTest.txt")
+
+	var (
+		p              Paragraph
+		expectedLevels []int
+		expectedOrder  []int
+		err            error
+	)
+	for i, line := range lines {
+		line = strings.TrimSpace(strings.Split(line, "#")[0])
+		if len(line) == 0 {
+			continue
+		}
+		if strings.HasPrefix(line, "@Levels:") {
+			if expectedLevels, err = parseBidiLevels(strings.TrimPrefix(line, "@Levels:")); err != nil {
+				t.Fatalf("line %d: %s", i+1, err)
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "@Reorder:") {
+			if expectedOrder, err = parseBidiOrder(strings.TrimPrefix(line, "@Reorder:")); err != nil {
+				t.Fatalf("line %d: %s", i+1, err)
+			}
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) != 2 {
+			t.Fatalf("line %d: invalid line %q", i+1, line)
+		}
+		var text []rune
+		for _, class := range strings.Fields(fields[0]) {
+			r, ok := bidiTestClasses[class]
+			if !ok {
+				t.Fatalf("line %d: unknown bidi class %q", i+1, class)
+			}
+			text = append(text, r)
+		}
+		bitset, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if err != nil {
+			t.Fatalf("line %d: %s", i+1, err)
+		}
+
+		// the bitset lists the paragraph directions : auto, LTR and RTL
+		for _, para := range []struct {
+			bit    int
+			dir    di.Direction
+			detect bool
+		}{
+			{1, di.DirectionLTR, true},
+			{2, di.DirectionLTR, false},
+			{4, di.DirectionRTL, false},
+		} {
+			if bitset&para.bit == 0 {
+				continue
+			}
+			p.Init(text, para.dir, para.detect)
+			if err := checkBidiLine(&p, expectedLevels, expectedOrder); err != nil {
+				t.Errorf("line %d [%s] (paragraph %d): %s", i+1, fields[0], para.bit, err)
+			}
+		}
+	}
+}
+
+// checkBidiCharacterTestLine runs one line of BidiCharacterTest.txt
+func checkBidiCharacterTestLine(p *Paragraph, line string) error {
+	fields := strings.Split(line, ";")
+	if len(fields) != 5 {
+		return fmt.Errorf("invalid line %q", line)
+	}
+	var text []rune
+	for _, f := range strings.Fields(fields[0]) {
+		r, err := strconv.ParseUint(f, 16, 32)
+		if err != nil {
+			return err
+		}
+		text = append(text, rune(r))
+	}
+	var (
+		dir    = di.DirectionLTR
+		detect bool
+	)
+	switch fields[1] {
+	case "0":
+	case "1":
+		dir = di.DirectionRTL
+	case "2":
+		detect = true
+	default:
+		return fmt.Errorf("invalid paragraph direction %q", fields[1])
+	}
+	baseLevel, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return err
+	}
+	expectedLevels, err := parseBidiLevels(fields[3])
+	if err != nil {
+		return err
+	}
+	expectedOrder, err := parseBidiOrder(fields[4])
+	if err != nil {
+		return err
+	}
+
+	p.Init(text, dir, detect)
+	if p.BaseLevel() != Level(baseLevel) {
+		return fmt.Errorf("expected base level %d, got %d", baseLevel, p.BaseLevel())
+	}
+	return checkBidiLine(p, expectedLevels, expectedOrder)
+}
+
+// checkBidiBrackets compares the paired brackets used by rule N0
+// with BidiBrackets.txt
+func checkBidiBrackets(t *testing.T) {
+	lines := readBidiTestFile(t, "BidiBrackets.txt")
+
+	var pairs int
+	for i, line := range lines {
+		line = strings.TrimSpace(strings.Split(line, "#")[0])
+		if len(line) == 0 {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 3 {
+			t.Fatalf("line %d: invalid line %q", i+1, line)
+		}
+		if strings.TrimSpace(fields[2]) != "o" {
+			continue // each pair is also listed by its opening bracket
+		}
+		opening, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 16, 32)
+		if err != nil {
+			t.Fatalf("line %d: %s", i+1, err)
+		}
+		closing, err := strconv.ParseUint(strings.TrimSpace(fields[1]), 16, 32)
+		if err != nil {
+			t.Fatalf("line %d: %s", i+1, err)
+		}
+		pairs++
+
+		pair1, isOpening1 := lookupBracket(rune(opening))
+		pair2, isOpening2 := lookupBracket(rune(closing))
+		if pair1 == 0 || pair1 != pair2 || !isOpening1 || isOpening2 {
+			t.Errorf("line %d: %U and %U are not paired: (%U, %v) and (%U, %v)",
+				i+1, opening, closing, pair1, isOpening1, pair2, isOpening2)
+		}
+	}
+	if pairs != len(bracketPairs) {
+		t.Errorf("expected %d bracket pairs, got %d", pairs, len(bracketPairs))
+	}
+}
+
+func TestBidiCharacterUnicodeReference(t *testing.T) {
+	lines := readBidiTestFile(t, "BidiCharacterTest.txt")
+	// rule N0 depends on the paired brackets
+	checkBidiBrackets(t)
+
+	var (
+		p        Paragraph
+		brackets int // number of lines with a paired bracket
+	)
+	for i, line := range lines {
+		line = strings.TrimSpace(strings.Split(line, "#")[0])
+		if len(line) == 0 {
+			continue
+		}
+		if err := checkBidiCharacterTestLine(&p, line); err != nil {
+			t.Errorf("line %d [%s]: %s", i+1, strings.Split(line, ";")[0], err)
+		}
+		for _, code := range strings.Fields(strings.Split(line, ";")[0]) {
+			r, err := strconv.ParseUint(code, 16, 32)
+			if err != nil {
+				continue // already reported
+			}
+			if pair, _ := lookupBracket(rune(r)); pair != 0 {
+				brackets++
+				break
+			}
+		}
+	}
+	if brackets == 0 {
+		t.Error("no paired brackets were tested")
+	}
+}
+
+func TestBidiCharacterTestLine(t *testing.T) {
+	// lines in the format of BidiCharacterTest.txt, checking the test harness
+	for _, line := range []string{
+		"0061 0020 05D0 05D1 0020 0063 0064;0;0;0 0 1 1 0 0 0;0 1 3 2 4 5 6",
+		"05D0 05D1 0020 0031 0032;2;1;1 1 1 2 2;3 4 2 1 0",
+		"0061 202B 0062 0020 202C;0;0;0 x 2 0 x;0 2 3",
+		"05D0 0028 05D1 0029 0063;0;0;1 1 1 1 0;3 2 1 0 4",
+		"0061 0028 05D1 0029 0063;0;0;0 0 1 0 0;0 1 2 3 4",
+		"05D0 0028 05D1 0029 0301 0063;0;0;1 1 1 1 1 0;4 3 2 1 0 5",
+		"05D0 2329 05D1 3009 0063;0;0;1 1 1 1 0;3 2 1 0 4",
+		"202E 05D0 0028 05D1 0029 202C 0063;0;0;x 1 1 1 1 x 0;4 3 2 1 6",
+	} {
+		var p Paragraph
+		if err := checkBidiCharacterTestLine(&p, line); err != nil {
+			t.Errorf("%s: %s", line, err)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package bidi
+
+// bracketPairs lists the opening and closing paired brackets
+// (Bidi_Paired_Bracket_Type property), from BidiBrackets.txt.
+// It is sorted by opening and closing brackets.
+var bracketPairs = [...][2]rune{
+	{0x0028, 0x0029}, // ( )
+	{0x005B, 0x005D}, // [ ]
+	{0x007B, 0x007D}, // { }
+	{0x0F3A, 0x0F3B}, // ༺ ༻
+	{0x0F3C, 0x0F3D}, // ༼ ༽
+	{0x169B, 0x169C}, // ᚛ ᚜
+	{0x2045, 0x2046}, // ⁅ ⁆
+	{0x207D, 0x207E}, // ⁽ ⁾
+	{0x208D, 0x208E}, // ₍ ₎
+	{0x2308, 0x2309}, // ⌈ ⌉
+	{0x230A, 0x230B}, // ⌊ ⌋
+	{0x2329, 0x232A}, // 〈 〉
+	{0x2768, 0x2769}, // ❨ ❩
+	{0x276A, 0x276B}, // ❪ ❫
+	{0x276C, 0x276D}, // ❬ ❭
+	{0x276E, 0x276F}, // ❮ ❯
+	{0x2770, 0x2771}, // ❰ ❱
+	{0x2772, 0x2773}, // ❲ ❳
+	{0x2774, 0x2775}, // ❴ ❵
+	{0x27C5, 0x27C6}, // ⟅ ⟆
+	{0x27E6, 0x27E7}, // ⟦ ⟧
+	{0x27E8, 0x27E9}, // ⟨ ⟩
+	{0x27EA, 0x27EB}, // ⟪ ⟫
+	{0x27EC, 0x27ED}, // ⟬ ⟭
+	{0x27EE, 0x27EF}, // ⟮ ⟯
+	{0x2983, 0x2984}, // ⦃ ⦄
+	{0x2985, 0x2986}, // ⦅ ⦆
+	{0x2987, 0x2988}, // ⦇ ⦈
+	{0x2989, 0x298A}, // ⦉ ⦊
+	{0x298B, 0x298C}, // ⦋ ⦌
+	{0x298D, 0x298E}, // ⦍ ⦎
+	{0x298F, 0x2990}, // ⦏ ⦐
+	{0x2991, 0x2992}, // ⦑ ⦒
+	{0x2993, 0x2994}, // ⦓ ⦔
+	{0x2995, 0x2996}, // ⦕ ⦖
+	{0x2997, 0x2998}, // ⦗ ⦘
+	{0x29D8, 0x29D9}, // ⧘ ⧙
+	{0x29DA, 0x29DB}, // ⧚ ⧛
+	{0x29FC, 0x29FD}, // ⧼ ⧽
+	{0x2E22, 0x2E23}, // ⸢ ⸣
+	{0x2E24, 0x2E25}, // ⸤ ⸥
+	{0x2E26, 0x2E27}, // ⸦ ⸧
+	{0x2E28, 0x2E29}, // ⸨ ⸩
+	{0x2E55, 0x2E56}, // ⹕ ⹖
+	{0x2E57, 0x2E58}, // ⹗ ⹘
+	{0x2E59, 0x2E5A}, // ⹙ ⹚
+	{0x2E5B, 0x2E5C}, // ⹛ ⹜
+	{0x3008, 0x3009}, // 〈 〉
+	{0x300A, 0x300B}, // 《 》
+	{0x300C, 0x300D}, // 「 」
+	{0x300E, 0x300F}, // 『 』
+	{0x3010, 0x3011}, // 【 】
+	{0x3014, 0x3015}, // 〔 〕
+	{0x3016, 0x3017}, // 〖 〗
+	{0x3018, 0x3019}, // 〘 〙
+	{0x301A, 0x301B}, // 〚 〛
+	{0xFE59, 0xFE5A}, // ﹙ ﹚
+	{0xFE5B, 0xFE5C}, // ﹛ ﹜
+	{0xFE5D, 0xFE5E}, // ﹝ ﹞
+	{0xFF08, 0xFF09}, // （ ）
+	{0xFF3B, 0xFF3D}, // ［ ］
+	{0xFF5B, 0xFF5D}, // ｛ ｝
+	{0xFF5F, 0xFF60}, // ｟ ｠
+	{0xFF62, 0xFF63}, // ｢ ｣
+}
+
+// lookupBracket returns the opening bracket of the pair [r] belongs to,
+// and true if [r] is the opening bracket.
+// It returns 0 if [r] is not a paired bracket.
+func lookupBracket(r rune) (pair rune, isOpening bool) {
+	// canonical equivalents of U+3008 and U+3009
+	switch r {
+	case 0x2329:
+		r = 0x3008
+	case 0x232A:
+		r = 0x3009
+	}
+	// binary search
+	for i, j := 0, len(bracketPairs); i < j; {
+		h := i + (j-i)/2
+		entry := bracketPairs[h]
+		if r < entry[0] {
+			j = h
+		} else if entry[1] < r {
+			i = h + 1
+		} else if r == entry[0] {
+			return r, true
+		} else if r == entry[1] {
+			return entry[0], false
+		} else {
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// maxBracketDepth is the size of the stack used by BD16
+const maxBracketDepth = 63
+
+// bracketPair stores the positions of a pair of brackets
+// in an isolating run sequence.
+type bracketPair struct {
+	opening, closing int
+}
+
+// locateBrackets identifies the bracket pairs of the isolating
+// run sequence [seq], following BD16, and stores them in p.pairs,
+// sorted by opening position.
+func (p *Paragraph) locateBrackets(text []rune, seq []int) {
+	type stackEntry struct {
+		bracket rune // the opening bracket
+		pos     int  // position in seq
+	}
+	var (
+		stack [maxBracketDepth]stackEntry
+		depth int
+	)
+	p.pairs = p.pairs[:0]
+	for k, idx := range seq {
+		if p.types[idx] != cON {
+			continue
+		}
+		bracket, isOpening := lookupBracket(text[idx])
+		if bracket == 0 {
+			continue
+		}
+		if isOpening {
+			if depth == maxBracketDepth { // stop processing
+				break
+			}
+			stack[depth] = stackEntry{bracket, k}
+			depth++
+			continue
+		}
+		for d := depth - 1; d >= 0; d-- {
+			if stack[d].bracket == bracket {
+				p.pairs = append(p.pairs, bracketPair{stack[d].pos, k})
+				depth = d
+				break
+			}
+		}
+	}
+	// sort by opening position : the list is small, use an insertion sort
+	for i := 1; i < len(p.pairs); i++ {
+		for j := i; j > 0 && p.pairs[j].opening < p.pairs[j-1].opening; j-- {
+			p.pairs[j], p.pairs[j-1] = p.pairs[j-1], p.pairs[j]
+		}
+	}
+}
+
+// strongBracketType returns L or R for the strong types
+// used by rule N0 (EN and AN are treated as R), or ON otherwise.
+func strongBracketType(c class) class {
+	switch c {
+	case cL:
+		return cL
+	case cR, cEN, cAN:
+		return cR
+	}
+	return cON
+}
+
+// resolvePairedBrackets applies rule N0 on the isolating run sequence [seq],
+// with embedding direction [embedding] and start of sequence type [sos].
+func (p *Paragraph) resolvePairedBrackets(text []rune, seq []int, sos, embedding class) {
+	p.locateBrackets(text, seq)
+	types := p.types
+	for _, pair := range p.pairs {
+		// N0 b and c : look for strong types inside the brackets
+		found := cON
+		for _, idx := range seq[pair.opening+1 : pair.closing] {
+			if dir := strongBracketType(types[idx]); dir == embedding {
+				found = dir
+				break
+			} else if dir != cON {
+				found = dir
+			}
+		}
+		if found == cON { // N0 d : no strong type, nothing to do
+			continue
+		}
+		if found != embedding {
+			// N0 c : check the context before the opening bracket
+			context := sos
+			for k := pair.opening - 1; k >= 0; k-- {
+				if dir := strongBracketType(types[seq[k]]); dir != cON {
+					context = dir
+					break
+				}
+			}
+			if context != found {
+				found = embedding
+			}
+		}
+		p.setBracketType(seq, pair.opening, found)
+		p.setBracketType(seq, pair.closing, found)
+	}
+}
+
+// setBracketType sets the type of the bracket at position [k] in [seq],
+// and of the non spacing marks following it, which were resolved
+// to the type of the bracket by rule W1.
+func (p *Paragraph) setBracketType(seq []int, k int, dir class) {
+	p.types[seq[k]] = dir
+	for _, idx := range seq[k+1:] {
+		if p.original[idx] != cNSM {
+			break
+		}
+		p.types[idx] = dir
+	}
+}
@@ -0,0 +1,23 @@
+package di
+
+import "testing"
+
+func TestDirection(t *testing.T) {
+	for _, test := range []struct {
+		d           Direction
+		vertical    bool
+		progression Progression
+	}{
+		{DirectionLTR, false, FromTopLeft},
+		{DirectionRTL, false, TowardTopLeft},
+		{DirectionTTB, true, FromTopLeft},
+		{DirectionBTT, true, TowardTopLeft},
+	} {
+		if test.d.IsVertical() != test.vertical || (test.d.Axis() == Vertical) != test.vertical {
+			t.Errorf("unexpected axis for %d", test.d)
+		}
+		if test.d.Progression() != test.progression {
+			t.Errorf("unexpected progression for %d", test.d)
+		}
+	}
+}
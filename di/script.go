@@ -0,0 +1,30 @@
+package di
+
+// Orientation describes how the glyphs of a shaped run are
+// displayed relative to the axis of the text.
+type Orientation uint8
+
+const (
+	// OrientationUpright displays the glyphs in their natural orientation.
+	OrientationUpright Orientation = iota
+	// OrientationSidewaysRight displays the run (shaped horizontally)
+	// rotated by 90 degrees clockwise, so that the start of the text is at the top.
+	OrientationSidewaysRight
+	// OrientationSidewaysLeft displays the run (shaped horizontally)
+	// rotated by 90 degrees counter-clockwise, so that the start of the text is at the bottom.
+	OrientationSidewaysLeft
+)
+
+// ScriptLayout describes how text written in a given script
+// is laid out along an axis, as returned by shaping.ResolveScriptLayout.
+type ScriptLayout struct {
+	// Direction is the direction to use when shaping the text.
+	// It is horizontal for sideways orientations.
+	Direction Direction
+	// Orientation is the orientation of the shaped glyphs.
+	Orientation Orientation
+	// LineProgression is the direction in which successive lines are stacked,
+	// relative to the top left corner : from top to bottom for horizontal text,
+	// from right to left (TowardTopLeft) or left to right (FromTopLeft) for vertical text.
+	LineProgression Progression
+}
@@ -21,6 +21,10 @@ type (
 
 type Resource = loader.Resource
 
+// TableError is an error found when loading a font table,
+// reported by [ParseTTFBestEffort].
+type TableError = font.TableError
+
 // ParseTTF parse an Opentype font file (.otf, .ttf).
 // See ParseTTC for support for collections.
 func ParseTTF(file Resource) (Face, error) {
@@ -35,6 +39,20 @@ func ParseTTF(file Resource) (Face, error) {
 	return &font.Face{Font: ft}, nil
 }
 
+// ParseTTFBestEffort is the same as [ParseTTF], but invalid tables
+// (including the required ones) do not prevent the font from being loaded,
+// with degraded functionality. The errors found are returned, so that
+// applications may warn their users.
+// An error is still returned if the file is not a font file.
+func ParseTTFBestEffort(file Resource) (Face, []TableError, error) {
+	ld, err := loader.NewLoader(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	ft, diags := font.NewFontBestEffort(ld)
+	return &font.Face{Font: ft}, diags, nil
+}
+
 // ParseTTC parse an Opentype font file, with support for collections.
 // Single font files are supported, returning a slice with length 1.
 func ParseTTC(file Resource) ([]Face, error) {
@@ -0,0 +1,57 @@
+package font
+
+import (
+	"sync"
+
+	"github.com/go-text/typesetting/opentype/api/font"
+)
+
+// FacePool hands out [Face]s sharing the same [Font], so that text
+// may be shaped concurrently without locking nor parsing the font file again.
+// Each goroutine should [FacePool.Get] its own [Face] and [FacePool.Put] it back
+// once done.
+//
+// A FacePool is safe for concurrent use.
+type FacePool struct {
+	font         Font
+	coords       []float32
+	xPpem, yPpem uint16
+
+	faces sync.Pool
+}
+
+// NewFacePool returns a pool of faces sharing the font of [face],
+// and initialized with its variable coordinates and ppem.
+// [face] itself is not used by the pool.
+func NewFacePool(face Face) *FacePool {
+	return &FacePool{
+		font:   face.Font,
+		coords: append([]float32(nil), face.Coords...),
+		xPpem:  face.XPpem,
+		yPpem:  face.YPpem,
+	}
+}
+
+// Font returns the font shared by the faces of the pool.
+func (p *FacePool) Font() Font { return p.font }
+
+// Get returns a face which is not used by any other goroutine,
+// with the settings of the face given to [NewFacePool].
+func (p *FacePool) Get() Face {
+	face, _ := p.faces.Get().(Face)
+	if face == nil {
+		face = &font.Face{Font: p.font}
+	}
+	face.Coords = append(face.Coords[:0], p.coords...)
+	face.XPpem, face.YPpem = p.xPpem, p.yPpem
+	return face
+}
+
+// Put returns [face] to the pool. It must not be used afterwards.
+// Faces not obtained from this pool are ignored.
+func (p *FacePool) Put(face Face) {
+	if face == nil || face.Font != p.font {
+		return
+	}
+	p.faces.Put(face)
+}
@@ -0,0 +1,54 @@
+package font
+
+import (
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestFacePool(t *testing.T) {
+	face, err := ParseTTF(mustOpen(t, "testdata/Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	face.XPpem, face.YPpem = 12, 12
+
+	pool := NewFacePool(face)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				f := pool.Get()
+				if f == face || f.Font != face.Font || f.XPpem != 12 || f.YPpem != 12 {
+					t.Error("unexpected face settings")
+				}
+				// settings changed by users are reset by Get
+				f.XPpem = 40
+				f.HorizontalAdvance(0)
+				pool.Put(f)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// faces of other fonts are ignored
+	other, err := ParseTTF(mustOpen(t, "testdata/Amiri-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool.Put(other)
+	if f := pool.Get(); f.Font != face.Font {
+		t.Error("unexpected font")
+	}
+}
+
+func mustOpen(t *testing.T, filename string) *os.File {
+	file, err := os.Open(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { file.Close() })
+	return file
+}
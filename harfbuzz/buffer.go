@@ -2,6 +2,7 @@ package harfbuzz
 
 import (
 	"math"
+	"unicode/utf8"
 
 	"github.com/go-text/typesetting/language"
 	"github.com/go-text/typesetting/opentype/tables"
@@ -65,6 +66,8 @@ type Buffer struct {
 	// Text before / after the main buffer contents, ordered outward !
 	// Index 0 is for "pre-Context", 1 for "post-Context".
 	context [2][]rune
+	// storage for the post-context of `AddString`
+	postContext [contextLength]rune
 
 	// temporary storage, usully used the following way:
 	// 	- truncate the slice
@@ -104,16 +107,19 @@ type Buffer struct {
 
 	haveOutput bool
 
-	planCache map[Face][]*shapePlan
+	planCache map[planKey][]*shapePlan
+	planOrder []planKey // one entry per cached plan, oldest first
+	planStats PlanCacheStats
+	maxPlans  int // zero for no limit
 }
 
 // NewBuffer allocate a storage with default options.
 // It should then be populated with `AddRunes` and shapped with `Shape`.
 func NewBuffer() *Buffer {
 	return &Buffer{
-		ClusterLevel:  MonotoneGraphemes,
-		maxOps:        maxOpsDefault,
-		planCache:     map[Face][]*shapePlan{},
+		ClusterLevel: MonotoneGraphemes,
+		maxOps:       maxOpsDefault,
+		planCache:    map[planKey][]*shapePlan{},
 	}
 }
 
@@ -175,6 +181,75 @@ func (b *Buffer) AddRunes(text []rune, itemOffset, itemLength int) {
 	b.context[1] = text[itemOffset+itemLength : s]
 }
 
+// AddString is the same as `AddRunes`, but for UTF-8 encoded text :
+// `itemOffset` and `itemLength` are expressed in bytes (-1 means the end of the string),
+// and the cluster value attributed to each rune is the index of its first byte in `text`.
+// Invalid UTF-8 sequences are replaced by U+FFFD.
+func (b *Buffer) AddString(text string, itemOffset, itemLength int) {
+	if len(b.Info) == 0 && itemOffset > 0 {
+		// add pre-context
+		b.clearContext(0)
+		for prev := itemOffset; prev > 0 && len(b.context[0]) < contextLength; {
+			r, size := utf8.DecodeLastRuneInString(text[:prev])
+			b.context[0] = append(b.context[0], r)
+			prev -= size
+		}
+	}
+
+	if itemLength < 0 {
+		itemLength = len(text) - itemOffset
+	}
+
+	for i, u := range text[itemOffset : itemOffset+itemLength] {
+		b.append(u, itemOffset+i)
+	}
+
+	// add post-context, using an internal storage since
+	// the runes are decoded
+	n := 0
+	for _, r := range text[itemOffset+itemLength:] {
+		if n == contextLength {
+			break
+		}
+		b.postContext[n] = r
+		n++
+	}
+	b.context[1] = b.postContext[:n]
+}
+
+// AddBytes is the same as `AddString`, for UTF-8 encoded text stored in a
+// byte slice, which is not retained.
+func (b *Buffer) AddBytes(text []byte, itemOffset, itemLength int) {
+	if len(b.Info) == 0 && itemOffset > 0 {
+		// add pre-context
+		b.clearContext(0)
+		for prev := itemOffset; prev > 0 && len(b.context[0]) < contextLength; {
+			r, size := utf8.DecodeLastRune(text[:prev])
+			b.context[0] = append(b.context[0], r)
+			prev -= size
+		}
+	}
+
+	if itemLength < 0 {
+		itemLength = len(text) - itemOffset
+	}
+
+	for i := itemOffset; i < itemOffset+itemLength; {
+		u, size := utf8.DecodeRune(text[i : itemOffset+itemLength])
+		b.append(u, i)
+		i += size
+	}
+
+	// add post-context
+	n := 0
+	for rest := text[itemOffset+itemLength:]; len(rest) != 0 && n < contextLength; n++ {
+		r, size := utf8.DecodeRune(rest)
+		b.postContext[n] = r
+		rest = rest[size:]
+	}
+	b.context[1] = b.postContext[:n]
+}
+
 // GuessSegmentProperties fills unset buffer segment properties based on buffer Unicode
 // contents and can be used when no other information is available.
 //
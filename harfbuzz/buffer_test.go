@@ -180,6 +180,49 @@ func TestBuffer(t *testing.T) {
 	}
 }
 
+func TestBufferAddString(t *testing.T) {
+	text := string(utf32[:])
+	start := len(string(utf32[:1]))
+	end := len(text) - len(string(utf32[6:]))
+
+	b := NewBuffer()
+	b.AddString(text, start, end-start)
+	assertEqualInt(t, len(b.Info), 5)
+	for i, exp := range []struct {
+		r       rune
+		cluster int
+	}{{'b', 1}, {0x20000, 2}, {'d', 6}, {'e', 7}, {'f', 8}} {
+		assertEqualInt(t, int(b.Info[i].codepoint), int(exp.r))
+		assertEqualInt(t, b.Info[i].Cluster, exp.cluster)
+	}
+	tu.Assert(t, string(b.context[0]) == "a")
+	tu.Assert(t, string(b.context[1]) == "g")
+
+	// same content as AddRunes, up to the clusters
+	ref := newTestBuffer(bufferUtf32)
+	tu.Assert(t, string(ref.context[0]) == string(b.context[0]))
+	tu.Assert(t, string(ref.context[1]) == string(b.context[1]))
+
+	// same content with a byte slice
+	bb := NewBuffer()
+	bb.AddBytes([]byte(text), start, end-start)
+	tu.Assert(t, len(bb.Info) == len(b.Info))
+	for i := range b.Info {
+		tu.Assert(t, bb.Info[i] == b.Info[i])
+	}
+	tu.Assert(t, string(bb.context[0]) == string(b.context[0]))
+	tu.Assert(t, string(bb.context[1]) == string(b.context[1]))
+
+	// the post-context does not alias the previous text
+	runes := []rune("xyz")
+	b.Clear()
+	b.AddRunes(runes, 0, 1)
+	b.Clear()
+	b.AddString("uvw", 0, 1)
+	tu.Assert(t, string(runes) == "xyz")
+	tu.Assert(t, string(b.context[1]) == "vw")
+}
+
 /*
  * Comparing buffers.
  */
@@ -74,6 +74,14 @@ func getHorizontalDirection(script language.Script) Direction {
 	return LeftToRight
 }
 
+// HorizontalDirection returns the natural direction of [script]
+// when set horizontally : [RightToLeft] for the right-to-left scripts,
+// [LeftToRight] for the other ones, except for the scripts which may be
+// written in both directions, for which the zero (invalid) value is returned.
+func HorizontalDirection(script language.Script) Direction {
+	return getHorizontalDirection(script)
+}
+
 // Tests whether a text direction is horizontal. Requires
 // that the direction be valid.
 func (dir Direction) isHorizontal() bool { return dir & ^Direction(1) == 4 }
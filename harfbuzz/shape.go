@@ -91,11 +91,6 @@ func (plan shapePlan) userFeaturesMatch(other shapePlan) bool {
 	return true
 }
 
-func (plan shapePlan) equal(other shapePlan) bool {
-	return plan.props == other.props &&
-		plan.userFeaturesMatch(other) && plan.shaper.kind() == other.shaper.kind()
-}
-
 // Constructs a shaping plan for a combination of @face, @userFeatures, @props,
 // plus the variation-space coordinates @coords.
 // See newShapePlanCached for caching support.
@@ -132,28 +127,119 @@ func (sp *shapePlan) execute(font *Font, buffer *Buffer, features []Feature) {
  * Caching
  */
 
+// planKey groups the shaping plans sharing the same face,
+// segment properties and variation indices of the layout tables.
+// These plans only differ by their user features.
+type planKey struct {
+	face       Face
+	props      SegmentProperties
+	variations otShapePlanKey
+}
+
+// PlanCacheStats reports the usage of the shaping plans cache of a [Buffer].
+type PlanCacheStats struct {
+	// Hits is the number of calls to [Buffer.Shape] which reused a cached plan.
+	Hits int
+	// Misses is the number of calls to [Buffer.Shape] which compiled a new plan.
+	Misses int
+	// Evictions is the number of plans removed from the cache to
+	// respect the size set by [Buffer.SetPlanCacheSize].
+	Evictions int
+	// Size is the number of plans currently cached.
+	Size int
+}
+
+// PlanCacheStats returns the statistics of the shaping plans cache,
+// accumulated since the creation of the buffer, or the last call to [Buffer.ClearPlanCache].
+func (b *Buffer) PlanCacheStats() PlanCacheStats {
+	stats := b.planStats
+	stats.Size = len(b.planOrder)
+	return stats
+}
+
+// SetPlanCacheSize limits the number of shaping plans cached by the buffer,
+// evicting the oldest plans when needed. A zero or negative size (the default) does not limit the cache.
+//
+// Plans are compiled for each combination of face, script, language, direction
+// and features, and are reused by the subsequent calls to [Buffer.Shape].
+func (b *Buffer) SetPlanCacheSize(size int) {
+	b.maxPlans = size
+	b.evictPlans()
+}
+
+// ClearPlanCache removes all the cached shaping plans,
+// and resets the statistics.
+func (b *Buffer) ClearPlanCache() {
+	b.planCache = map[planKey][]*shapePlan{}
+	b.planOrder = b.planOrder[:0]
+	b.planStats = PlanCacheStats{}
+}
+
+// EvictOldestPlan removes the oldest cached shaping plan,
+// returning false if the cache is empty.
+// It may be used to control the cache size with an external memory budget.
+func (b *Buffer) EvictOldestPlan() bool {
+	if len(b.planOrder) == 0 {
+		return false
+	}
+	b.evictOldestPlan()
+	return true
+}
+
+// evictPlans removes the oldest plans until the cache size is respected
+func (b *Buffer) evictPlans() {
+	if b.maxPlans <= 0 {
+		return
+	}
+	for len(b.planOrder) > b.maxPlans {
+		b.evictOldestPlan()
+	}
+}
+
+func (b *Buffer) evictOldestPlan() {
+	key := b.planOrder[0]
+	b.planOrder = b.planOrder[1:]
+	// plans are appended, so that the first one is the oldest
+	if plans := b.planCache[key]; len(plans) > 1 {
+		b.planCache[key] = plans[1:]
+	} else {
+		delete(b.planCache, key)
+	}
+	b.planStats.Evictions++
+}
+
 // creates (or returns) a cached shaping plan suitable for reuse, for a combination
 // of `face`, `userFeatures`, `props`, plus the variation-space coordinates `coords`.
 func (b *Buffer) newShapePlanCached(font *Font, props SegmentProperties,
 	userFeatures []Feature, coords []float32,
 ) *shapePlan {
-	var key shapePlan
-	key.init(false, font, props, userFeatures, coords)
-
-	plans := b.planCache[font.face]
+	tables := font.face.Font
+	key := planKey{
+		face:  font.face,
+		props: props,
+		variations: otShapePlanKey{
+			0: tables.GSUB.FindVariationIndex(coords),
+			1: tables.GPOS.FindVariationIndex(coords),
+		},
+	}
+	features := shapePlan{userFeatures: userFeatures}
 
+	plans := b.planCache[key]
 	for _, plan := range plans {
-		if plan.equal(key) {
+		if plan.userFeaturesMatch(features) {
 			if debugMode >= 1 {
 				fmt.Printf("\tPLAN %p fulfilled from cache\n", plan)
 			}
+			b.planStats.Hits++
 			return plan
 		}
 	}
 	plan := newShapePlan(font, props, userFeatures, coords)
 
-	plans = append(plans, plan)
-	b.planCache[font.face] = plans
+	b.planCache[key] = append(plans, plan)
+	b.planOrder = append(b.planOrder, key)
+	b.planStats.Misses++
+	b.evictPlans()
 
 	if debugMode >= 1 {
 		fmt.Printf("\tPLAN %p inserted into cache\n", plan)
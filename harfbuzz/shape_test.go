@@ -0,0 +1,71 @@
+package harfbuzz
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/fontbuilder"
+	"github.com/go-text/typesetting/opentype/loader"
+	tu "github.com/go-text/typesetting/opentype/testutils"
+)
+
+func TestPlanCache(t *testing.T) {
+	fb := fontbuilder.NewBuilder()
+	f := fb.AddRune('f', fontbuilder.Glyph{Advance: 300, Contours: [][]fontbuilder.Point{fontbuilder.Box(50, 0, 250, 700)}})
+	i := fb.AddRune('i', fontbuilder.Glyph{Advance: 200, Contours: [][]fontbuilder.Point{fontbuilder.Box(50, 0, 150, 500)}})
+	fi := fb.AddGlyph(fontbuilder.Glyph{Advance: 450, Contours: [][]fontbuilder.Point{fontbuilder.Box(50, 0, 400, 700)}})
+	fb.AddLigature(fi, f, i)
+	face, err := fb.Face()
+	tu.AssertNoErr(t, err)
+	font := NewFont(face)
+
+	buf := NewBuffer()
+	shape := func(dir Direction, features []Feature) {
+		buf.Clear()
+		buf.AddRunes([]rune("fi"), 0, -1)
+		buf.Props = SegmentProperties{Direction: dir, Script: language.Latin, Language: language.NewLanguage("en")}
+		buf.Shape(font, features)
+	}
+	noLiga := []Feature{{Tag: loader.MustNewTag("liga"), Value: 0, Start: FeatureGlobalStart, End: FeatureGlobalEnd}}
+
+	shape(LeftToRight, nil)
+	tu.Assert(t, len(buf.Info) == 1 && buf.Info[0].Glyph == fi)
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Misses: 1, Size: 1})
+
+	shape(LeftToRight, nil)
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 1, Misses: 1, Size: 1})
+
+	// the user features are part of the plan
+	shape(LeftToRight, noLiga)
+	tu.Assert(t, len(buf.Info) == 2)
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 1, Misses: 2, Size: 2})
+	shape(LeftToRight, noLiga)
+	tu.Assert(t, len(buf.Info) == 2)
+	shape(LeftToRight, nil)
+	tu.Assert(t, len(buf.Info) == 1)
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 3, Misses: 2, Size: 2})
+
+	// so are the segment properties
+	shape(RightToLeft, nil)
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 3, Misses: 3, Size: 3})
+
+	// the oldest plans are evicted
+	buf.SetPlanCacheSize(2)
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 3, Misses: 3, Evictions: 1, Size: 2})
+	shape(LeftToRight, noLiga) // still cached
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 4, Misses: 3, Evictions: 1, Size: 2})
+	shape(LeftToRight, nil) // evicted
+	tu.Assert(t, len(buf.Info) == 1)
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 4, Misses: 4, Evictions: 2, Size: 2})
+
+	tu.Assert(t, buf.EvictOldestPlan())
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 4, Misses: 4, Evictions: 3, Size: 1})
+	shape(LeftToRight, nil) // still cached
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Hits: 5, Misses: 4, Evictions: 3, Size: 1})
+
+	buf.ClearPlanCache()
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{})
+	tu.Assert(t, !buf.EvictOldestPlan())
+	shape(LeftToRight, nil)
+	tu.Assert(t, buf.PlanCacheStats() == PlanCacheStats{Misses: 1, Size: 1})
+}
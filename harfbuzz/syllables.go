@@ -0,0 +1,88 @@
+package harfbuzz
+
+import "github.com/go-text/typesetting/language"
+
+// Syllable is a range of runes forming an orthographic syllable,
+// as found by the shaper of the Indic, Khmer and Myanmar scripts.
+// Such a syllable is shaped as a whole, so that it is a natural unit
+// for deletion or selection.
+type Syllable struct {
+	// Start and End are the rune indices of the syllable (End is exclusive).
+	Start, End int
+	// Broken is true if the syllable is not well-formed, in which case
+	// the shaper inserts a dotted circle to display it.
+	Broken bool
+}
+
+// SyllableIterator iterates over the syllables of a text,
+// using the same segmentation as the shaper.
+//
+// Usage :
+//
+//	iter := NewSyllableIterator(text, language.Devanagari)
+//	for iter.Next() {
+//	  ... // use iter.Syllable()
+//	}
+type SyllableIterator struct {
+	buffer Buffer
+	iter   syllableIterator
+	broken uint8 // the syllable type of broken clusters
+	syl    Syllable
+}
+
+// NewSyllableIterator returns an iterator over the syllables of [text], written in [script].
+// The scripts supported are the ones handled by the Indic shaper (like Devanagari, Bengali or Tamil),
+// Khmer and Myanmar. For the other scripts, each rune is reported as its own syllable.
+func NewSyllableIterator(text []rune, script language.Script) *SyllableIterator {
+	var it SyllableIterator
+	it.buffer.AddRunes(text, 0, -1)
+	info := it.buffer.Info
+	switch script {
+	case language.Bengali, language.Devanagari, language.Gujarati, language.Gurmukhi, language.Kannada,
+		language.Malayalam, language.Oriya, language.Tamil, language.Telugu, language.Sinhala:
+		for i := range info {
+			info[i].setIndicProperties()
+		}
+		findSyllablesIndic(&it.buffer)
+		it.broken = indicBrokenCluster
+	case language.Khmer:
+		for i := range info {
+			setKhmerProperties(&info[i])
+		}
+		findSyllablesKhmer(&it.buffer)
+		it.broken = khmerBrokenCluster
+	case language.Myanmar:
+		for i := range info {
+			setMyanmarProperties(&info[i])
+		}
+		findSyllablesMyanmar(&it.buffer)
+		it.broken = myanmarBrokenCluster
+	default:
+		// use distinct values for consecutive runes
+		for i := range info {
+			info[i].syllable = uint8(i%2) + 1
+		}
+		it.broken = 0xFF
+	}
+	it.iter = syllableIterator{buffer: &it.buffer}
+	return &it
+}
+
+// Next returns true if there is still a syllable to process,
+// and advances the iterator; or return false.
+func (it *SyllableIterator) Next() bool {
+	start, end := it.iter.next()
+	if start >= len(it.buffer.Info) {
+		return false
+	}
+	it.syl = Syllable{
+		Start:  start,
+		End:    end,
+		Broken: it.buffer.Info[start].syllable&0x0F == it.broken,
+	}
+	return true
+}
+
+// Syllable returns the current syllable, and is only valid
+// after a call to [Next] returning true.
+func (it *SyllableIterator) Syllable() Syllable { return it.syl }
@@ -0,0 +1,45 @@
+package harfbuzz
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-text/typesetting/language"
+)
+
+func TestSyllableIterator(t *testing.T) {
+	for _, test := range []struct {
+		text     string
+		script   language.Script
+		expected []Syllable
+	}{
+		{"", language.Devanagari, nil},
+		// न म स्ते
+		{"नमस्ते", language.Devanagari, []Syllable{
+			{0, 1, false}, {1, 2, false}, {2, 6, false},
+		}},
+		// a vowel sign without base
+		{"िक", language.Devanagari, []Syllable{
+			{0, 1, true}, {1, 2, false},
+		}},
+		// ខ្មែរ
+		{"ខ្មែរ", language.Khmer, []Syllable{
+			{0, 4, false}, {4, 5, false},
+		}},
+		// မြန်မာ
+		{"မြန်မာ", language.Myanmar, []Syllable{
+			{0, 2, false}, {2, 4, false}, {4, 6, false},
+		}},
+		{"abc", language.Latin, []Syllable{
+			{0, 1, false}, {1, 2, false}, {2, 3, false},
+		}},
+	} {
+		var got []Syllable
+		for iter := NewSyllableIterator([]rune(test.text), test.script); iter.Next(); {
+			got = append(got, iter.Syllable())
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.text, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package language
+
+// scriptExtension maps a range of runes to the scripts
+// using them (Script_Extensions property).
+type scriptExtension struct {
+	start, end rune
+	scripts    []Script
+}
+
+var (
+	arabicPunctuation = []Script{Arabic, Nko, Hanifi_Rohingya, Syriac, Thaana, Yezidi}
+	cjkPunctuation    = []Script{Bopomofo, Hangul, Han, Hiragana, Katakana, Yi}
+	cjkSymbols        = []Script{Bopomofo, Hangul, Han, Hiragana, Katakana}
+	kana              = []Script{Hiragana, Katakana}
+)
+
+// scriptExtensions is a subset of the ScriptExtensions.txt file,
+// covering the punctuation and marks commonly shared by several scripts.
+// It is sorted by range.
+var scriptExtensions = [...]scriptExtension{
+	{0x0483, 0x0483, []Script{Cyrillic, Old_Permic}},
+	{0x0485, 0x0486, []Script{Cyrillic, Latin}},
+	{0x0589, 0x0589, []Script{Armenian, Georgian}},
+	{0x060C, 0x060C, arabicPunctuation},
+	{0x061B, 0x061B, arabicPunctuation},
+	{0x061F, 0x061F, []Script{Adlam, Arabic, Nko, Hanifi_Rohingya, Syriac, Thaana, Yezidi}},
+	{0x0640, 0x0640, []Script{Adlam, Arabic, Mandaic, Manichaean, Psalter_Pahlavi, Hanifi_Rohingya, Sogdian, Syriac}},
+	{0x064B, 0x0655, []Script{Arabic, Syriac}},
+	{0x0660, 0x0669, []Script{Arabic, Thaana, Yezidi}},
+	{0x0670, 0x0670, []Script{Arabic, Syriac}},
+	{0x06D4, 0x06D4, []Script{Arabic, Hanifi_Rohingya}},
+	{0x0964, 0x0965, []Script{
+		Bengali, Devanagari, Dogra, Gunjala_Gondi, Masaram_Gondi, Grantha, Gujarati, Gurmukhi, Kannada, Mahajani,
+		Malayalam, Nandinagari, Oriya, Khudawadi, Sinhala, Syloti_Nagri, Takri, Tamil, Telugu, Tirhuta,
+	}},
+	{0x0966, 0x096F, []Script{Devanagari, Dogra, Kaithi, Mahajani}},
+	{0x10FB, 0x10FB, []Script{Georgian, Latin}},
+	{0x2E43, 0x2E43, []Script{Cyrillic, Glagolitic}},
+	{0x3001, 0x3002, cjkPunctuation},
+	{0x3003, 0x3003, cjkSymbols},
+	{0x3008, 0x3011, cjkPunctuation},
+	{0x3013, 0x3013, cjkSymbols},
+	{0x3014, 0x301B, cjkPunctuation},
+	{0x301C, 0x301F, cjkSymbols},
+	{0x302A, 0x302D, []Script{Bopomofo, Han}},
+	{0x3030, 0x3030, cjkSymbols},
+	{0x3031, 0x3035, kana},
+	{0x3037, 0x3037, cjkSymbols},
+	{0x303C, 0x303D, []Script{Han, Hiragana, Katakana}},
+	{0x3099, 0x309C, kana},
+	{0x30A0, 0x30A0, kana},
+	{0x30FB, 0x30FB, cjkPunctuation},
+	{0x30FC, 0x30FC, kana},
+	{0xFF61, 0xFF65, cjkPunctuation},
+	{0xFF70, 0xFF70, kana},
+	{0xFF9E, 0xFF9F, kana},
+}
+
+// LookupScriptExtensions returns the scripts using the given rune,
+// as defined by the Script_Extensions property (see Unicode Standard Annex #24),
+// or nil if the rune is only used with the script returned by [LookupScript].
+//
+// Only the punctuation and marks commonly shared between scripts (like the
+// Devanagari danda or the Katakana middle dot) are supported.
+// The returned slice must not be modified.
+func LookupScriptExtensions(r rune) []Script {
+	// binary search
+	for i, j := 0, len(scriptExtensions); i < j; {
+		h := i + (j-i)/2
+		entry := scriptExtensions[h]
+		if r < entry.start {
+			j = h
+		} else if entry.end < r {
+			i = h + 1
+		} else {
+			return entry.scripts
+		}
+	}
+	return nil
+}
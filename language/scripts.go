@@ -3,6 +3,7 @@ package language
 import (
 	"encoding/binary"
 	"fmt"
+	"sort"
 )
 
 // Script identifies different writing systems.
@@ -43,3 +44,43 @@ func (s Script) String() string {
 	binary.BigEndian.PutUint32(buf[:], uint32(s))
 	return string(buf[:])
 }
+
+// ScriptCount is the number of runes of a text
+// using a given script.
+type ScriptCount struct {
+	Script Script
+	Runes  int
+}
+
+// CountScripts scans [text] and returns the scripts used, with their rune count,
+// sorted by decreasing count (and then by script).
+// Runes which are not specific to one script (Common, Inherited or Unknown)
+// are ignored.
+// It may be used to select the fallback fonts required to display [text].
+func CountScripts(text string) []ScriptCount {
+	var out []ScriptCount
+	for _, r := range text {
+		script := LookupScript(r)
+		if script == Common || script == Inherited || script == Unknown {
+			continue
+		}
+		found := false
+		for i := range out {
+			if out[i].Script == script {
+				out[i].Runes++
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, ScriptCount{Script: script, Runes: 1})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Runes != out[j].Runes {
+			return out[i].Runes > out[j].Runes
+		}
+		return out[i].Script < out[j].Script
+	})
+	return out
+}
@@ -178,3 +178,60 @@ const scriptsSample = `
 	我能吞下玻璃而不傷身體。 
 	Saya boleh makan kaca dan ia tidak mencederakan saya. 
 `
+
+func TestLookupScriptExtensions(t *testing.T) {
+	for i := 1; i < len(scriptExtensions); i++ {
+		if scriptExtensions[i-1].end >= scriptExtensions[i].start {
+			t.Fatalf("unsorted table at %d", i)
+		}
+	}
+	for _, entry := range scriptExtensions {
+		if !containsScript(entry.scripts, LookupScript(entry.start)) && !isCommon(LookupScript(entry.start)) {
+			t.Errorf("extensions for %x do not include its script", entry.start)
+		}
+	}
+
+	if got := LookupScriptExtensions('a'); got != nil {
+		t.Errorf("unexpected extensions %v", got)
+	}
+	if got := LookupScriptExtensions(0x0964); len(got) == 0 || got[1] != Devanagari {
+		t.Errorf("unexpected extensions for danda: %v", got)
+	}
+	if got := LookupScriptExtensions(0x30FB); !containsScript(got, Hiragana) || !containsScript(got, Katakana) {
+		t.Errorf("unexpected extensions for katakana middle dot: %v", got)
+	}
+}
+
+func containsScript(scripts []Script, script Script) bool {
+	for _, s := range scripts {
+		if s == script {
+			return true
+		}
+	}
+	return false
+}
+
+func isCommon(script Script) bool { return script == Common || script == Inherited }
+
+func TestCountScripts(t *testing.T) {
+	for _, test := range []struct {
+		text     string
+		expected []ScriptCount
+	}{
+		{"", nil},
+		{"123 !?", nil},
+		{"Hello", []ScriptCount{{Latin, 5}}},
+		{"Hello, мир! 日本語とカナ", []ScriptCount{{Latin, 5}, {Cyrillic, 3}, {Han, 3}, {Katakana, 2}, {Hiragana, 1}}},
+		{"é", []ScriptCount{{Latin, 1}}}, // inherited marks are ignored
+	} {
+		got := CountScripts(test.text)
+		if len(got) != len(test.expected) {
+			t.Fatalf("%q: expected %v, got %v", test.text, test.expected, got)
+		}
+		for i := range got {
+			if got[i] != test.expected[i] {
+				t.Errorf("%q: expected %v, got %v", test.text, test.expected, got)
+			}
+		}
+	}
+}
@@ -144,6 +144,52 @@ func TestCmap12(t *testing.T) {
 	}
 }
 
+func TestCmap13(t *testing.T) {
+	// a last resort like font, with one (3, 10) format 13 subtable
+	groups := [][3]uint32{
+		{0x0000, 0x007F, 1},
+		{0x0080, 0x00FF, 2},
+		{0x10000, 0x10FFFF, 3},
+	}
+	data := make([]byte, 28+12*len(groups))
+	binary.BigEndian.PutUint16(data[2:], 1) // numTables
+	binary.BigEndian.PutUint16(data[4:], 3)
+	binary.BigEndian.PutUint16(data[6:], 10)
+	binary.BigEndian.PutUint32(data[8:], 12)  // offset
+	binary.BigEndian.PutUint16(data[12:], 13) // format
+	binary.BigEndian.PutUint32(data[16:], uint32(16+12*len(groups)))
+	binary.BigEndian.PutUint32(data[24:], uint32(len(groups)))
+	for i, group := range groups {
+		binary.BigEndian.PutUint32(data[28+12*i:], group[0])
+		binary.BigEndian.PutUint32(data[28+12*i+4:], group[1])
+		binary.BigEndian.PutUint32(data[28+12*i+8:], group[2])
+	}
+
+	cmaps, _, err := tables.ParseCmap(data)
+	tu.AssertNoErr(t, err)
+	cmap, _, err := ProcessCmap(cmaps)
+	tu.AssertNoErr(t, err)
+
+	for r, expected := range map[rune]GID{'a': 1, 0x7F: 1, 0xE9: 2, 0x1F600: 3, 0x10FFFF: 3} {
+		got, ok := cmap.Lookup(r)
+		tu.Assert(t, ok && got == expected)
+	}
+	_, ok := cmap.Lookup(0x2026)
+	tu.Assert(t, !ok)
+
+	// all the runes of a group are mapped to the same glyph
+	iter := cmap.Iter()
+	count := 0
+	for iter.Next() {
+		r, gid := iter.Char()
+		if r < 0x100 {
+			tu.Assert(t, gid == GID(1+r/0x80))
+		}
+		count++
+	}
+	tu.Assert(t, count == 0x100+0x100000)
+}
+
 func TestCmap14(t *testing.T) {
 	font := readFontFile(t, "cmap/CMAP14.otf")
 	cmaps, _, err := tables.ParseCmap(readTable(t, font, "cmap"))
@@ -77,8 +77,12 @@ const (
 	SubscriptEmYOffset
 	SubscriptEmXOffset
 
+	// Distance above the baseline of the top of flat capital letters.
 	CapHeight
+	// Distance above the baseline of the top of flat lowercase letters.
 	XHeight
+
+	SuperscriptEmYOffset
 )
 
 // GlyphExtents exposes extent values, measured in font units.
@@ -174,6 +178,153 @@ type GlyphBitmap struct {
 	Outline *GlyphOutline
 }
 
+// GlyphLayer is one drawable element of a glyph, as returned by
+// the GlyphLayers method of fonts.
+// Layers are drawn in order, on top of each other, at the glyph origin.
+type GlyphLayer struct {
+	// Data is either a GlyphOutline, GlyphSVG or GlyphBitmap.
+	// It is nil for the 'COLR' layers which can't be described as
+	// one filled outline : Paint then is the full paint graph of the layer,
+	// whose PaintGlyph nodes provide the outlines.
+	Data GlyphData
+
+	// Paint is the paint used to fill a GlyphOutline,
+	// and is nil for SVG and bitmap layers, which carry their own colors.
+	Paint Paint
+}
+
+// Paint describes how to fill an outline layer.
+// Besides the solid colors, the 'COLR' version 1 table describes
+// paint graphs, built from gradients, transformations, glyph clips and
+// compositing.
+// The coordinates are expressed in font units, with the y axis pointing up.
+type Paint interface {
+	isPaint()
+}
+
+func (PaintSolid) isPaint()          {}
+func (PaintLinearGradient) isPaint() {}
+func (PaintRadialGradient) isPaint() {}
+func (PaintSweepGradient) isPaint()  {}
+func (PaintGlyph) isPaint()          {}
+func (PaintTransform) isPaint()      {}
+func (PaintComposite) isPaint()      {}
+func (PaintLayers) isPaint()         {}
+
+// PaintSolid fills the outline with a single color.
+type PaintSolid struct {
+	// Foreground is true if the current text color should be used,
+	// in which case only the alpha of Color is used,
+	// as a multiplier of the text color alpha.
+	Foreground bool
+	// Color is a non premultiplied RGBA color.
+	Color [4]uint8
+}
+
+// Extend defines how a gradient is drawn outside of its color line.
+type Extend uint8
+
+const (
+	ExtendPad     Extend = iota // use the color of the closest stop
+	ExtendRepeat                // repeat the color line
+	ExtendReflect               // repeat the color line, alternating its direction
+)
+
+// ColorStop is a color at a given position of a [ColorLine].
+type ColorStop struct {
+	Offset float32
+	Color  PaintSolid
+}
+
+// ColorLine defines the colors of a gradient.
+type ColorLine struct {
+	Extend Extend
+	Stops  []ColorStop // sorted by increasing Offset
+}
+
+// PaintLinearGradient fills with a linear gradient, from P0 (offset 0) to
+// P1 (offset 1), whose color lines are parallel to the line (P0, P2).
+type PaintLinearGradient struct {
+	ColorLine
+	P0, P1, P2 [2]float32
+}
+
+// PaintRadialGradient fills with a gradient between the circle
+// (C0, R0) (offset 0) and the circle (C1, R1) (offset 1).
+type PaintRadialGradient struct {
+	ColorLine
+	C0, C1 [2]float32
+	R0, R1 float32
+}
+
+// PaintSweepGradient fills with a gradient around Center, from
+// StartAngle (offset 0) to EndAngle (offset 1), in counter-clockwise degrees.
+type PaintSweepGradient struct {
+	ColorLine
+	Center               [2]float32
+	StartAngle, EndAngle float32
+}
+
+// PaintGlyph fills the Outline with Paint.
+type PaintGlyph struct {
+	Outline GlyphOutline
+	Paint   Paint
+}
+
+// PaintTransform draws Paint with the given transformation.
+type PaintTransform struct {
+	Transform Transform
+	Paint     Paint
+}
+
+// CompositeMode is the mode used to combine the source and the backdrop of a
+// [PaintComposite], as defined in the 'COLR' table specification.
+type CompositeMode uint8
+
+const (
+	CompositeClear CompositeMode = iota
+	CompositeSrc
+	CompositeDest
+	CompositeSrcOver
+	CompositeDestOver
+	CompositeSrcIn
+	CompositeDestIn
+	CompositeSrcOut
+	CompositeDestOut
+	CompositeSrcAtop
+	CompositeDestAtop
+	CompositeXor
+	CompositePlus
+	CompositeScreen
+	CompositeOverlay
+	CompositeDarken
+	CompositeLighten
+	CompositeColorDodge
+	CompositeColorBurn
+	CompositeHardLight
+	CompositeSoftLight
+	CompositeDifference
+	CompositeExclusion
+	CompositeMultiply
+	CompositeHSLHue
+	CompositeHSLSaturation
+	CompositeHSLColor
+	CompositeHSLLuminosity
+)
+
+// PaintComposite draws Source and Backdrop in separate groups,
+// and combines them with Mode.
+type PaintComposite struct {
+	Mode     CompositeMode
+	Source   Paint
+	Backdrop Paint
+}
+
+// PaintLayers draws each paint in order, on top of each other.
+type PaintLayers struct {
+	Layers []Paint
+}
+
 // BitmapFormat identifies the format on the glyph
 // raw data. Across the various font files, many formats
 // may be encountered : black and white bitmaps, PNG, TIFF, JPG.
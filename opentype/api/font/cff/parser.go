@@ -26,6 +26,8 @@ type Font struct {
 	charset     []uint16 // indexed by glyph ID
 
 	cidFontName string
+	// Registry-Ordering-Supplement, only valid for CIDFonts
+	ros ROS
 
 	// Charstrings contains the actual glyph definition.
 	// It has a length of numGlyphs and is indexed by glyph ID.
@@ -75,6 +77,57 @@ func (f *Font) GlyphName(glyph api.GID) string {
 	return out
 }
 
+// ROS is the Registry-Ordering-Supplement triple identifying
+// the character collection of a CIDFont, such as Adobe-Japan1-7.
+type ROS struct {
+	Registry, Ordering string
+	Supplement         int32
+}
+
+// IsCIDKeyed returns true if the font is a CIDFont, whose glyphs are identified
+// by CIDs and whose charstrings use the Font DICT selected by the FDSelect data.
+func (f *Font) IsCIDKeyed() bool { return f.fdSelect != nil }
+
+// ROS returns the character collection of a CIDFont,
+// or the zero value for other fonts.
+func (f *Font) ROS() ROS { return f.ros }
+
+// CID returns the CID of [glyph], as defined by the charset of a CIDFont.
+// It returns false for other fonts, or if [glyph] is out of range.
+func (f *Font) CID(glyph api.GID) (uint16, bool) {
+	if f.fdSelect == nil || int(glyph) >= len(f.charset) {
+		return 0, false
+	}
+	return f.charset[glyph], true
+}
+
+// GlyphFromCID returns the glyph with the given [cid] in a CIDFont.
+// It returns false for other fonts, or if [cid] is not mapped.
+func (f *Font) GlyphFromCID(cid uint16) (api.GID, bool) {
+	if f.fdSelect == nil {
+		return 0, false
+	}
+	// charsets are usually sorted, which allows a binary search
+	lo, hi := 0, len(f.charset)
+	for lo < hi {
+		i := (lo + hi) / 2
+		if f.charset[i] < cid {
+			lo = i + 1
+		} else {
+			hi = i
+		}
+	}
+	if lo < len(f.charset) && f.charset[lo] == cid {
+		return api.GID(lo), true
+	}
+	for i, c := range f.charset {
+		if c == cid {
+			return api.GID(i), true
+		}
+	}
+	return 0, false
+}
+
 // since SID = 0 means .notdef, we use a reserved value
 // to mean unset
 const unsetSID = uint16(0xFFFF)
@@ -177,6 +230,17 @@ func (p *cffParser) parse() ([]Font, error) {
 		if err != nil {
 			return nil, err
 		}
+		if topDict.isCIDFont {
+			out[i].ros.Supplement = topDict.supplement
+			out[i].ros.Registry, err = strs.getString(topDict.registry)
+			if err != nil {
+				return nil, err
+			}
+			out[i].ros.Ordering, err = strs.getString(topDict.ordering)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Parse the Global Subrs [Subroutines] INDEX,
@@ -632,6 +696,8 @@ type topDictData struct {
 	fdArray                                            int32
 	fdSelect                                           int32
 	isCIDFont                                          bool
+	registry, ordering                                 uint16 // SIDs
+	supplement                                         int32
 	cidFontName                                        uint16
 	privateDictOffset                                  int32
 	privateDictLength                                  int32
@@ -752,8 +818,11 @@ var topDictOperators = [2][]topDictOperator{
 		21: {topDictNoOp, +1 /*PostScript*/},
 		22: {topDictNoOp, +1 /*BaseFontName*/},
 		23: {topDictNoOp, -2 /*BaseFontBlend*/},
-		30: {func(t *topDictData, _ *ps.Machine) error {
+		30: {func(t *topDictData, s *ps.Machine) error {
 			t.isCIDFont = true
+			t.registry = uint16(s.ArgStack.Vals[s.ArgStack.Top-3])
+			t.ordering = uint16(s.ArgStack.Vals[s.ArgStack.Top-2])
+			t.supplement = s.ArgStack.Vals[s.ArgStack.Top-1]
 			return nil
 		}, +3 /*ROS*/},
 		31: {topDictNoOp, +1 /*CIDFontVersion*/},
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	td "github.com/go-text/typesetting-utils/opentype"
+	"github.com/go-text/typesetting/opentype/api"
 	"github.com/go-text/typesetting/opentype/loader"
 	"github.com/go-text/typesetting/opentype/tables"
 	tu "github.com/go-text/typesetting/opentype/testutils"
@@ -84,3 +85,123 @@ func TestGlyhName(t *testing.T) {
 	}
 	tu.Assert(t, reflect.DeepEqual(expectedUserStrings, gotUserStrings))
 }
+
+// dictInt encodes [v] as a 5 bytes DICT operand
+func dictInt(v int) []byte {
+	return []byte{29, byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+func buildIndex(items ...[]byte) []byte {
+	out := []byte{byte(len(items) >> 8), byte(len(items)), 4}
+	offset := 1
+	for i := 0; i <= len(items); i++ {
+		out = append(out, byte(offset>>24), byte(offset>>16), byte(offset>>8), byte(offset))
+		if i < len(items) {
+			offset += len(items[i])
+		}
+	}
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+// buildCIDFont returns a CIDFont with 3 glyphs, using CIDs 0, 100 and 101,
+// and two Font DICTs: glyphs 0 and 1 use the first one, glyph 2 the second one.
+// Glyphs 1 and 2 only call the local subroutine 0 of their Font DICT,
+// which draws a horizontal line in the first Font DICT, and a vertical one in the second.
+func buildCIDFont() []byte {
+	header := []byte{1, 0, 4, 4}
+	names := buildIndex([]byte("CIDTest"))
+	strs := buildIndex([]byte("Adobe"), []byte("Identity"))
+	globalSubrs := buildIndex()
+
+	charset := []byte{2, 0, 100, 0, 1} // format 2, CIDs 100 and 101
+	fdSelect := []byte{3, 0, 2, 0, 0, 0, 0, 2, 1, 0, 3}
+	charstrings := buildIndex(
+		[]byte{14},         // endchar
+		[]byte{32, 10, 14}, // -107 callsubr endchar
+		[]byte{32, 10, 14}, // -107 callsubr endchar
+	)
+	subrs := [2][]byte{
+		buildIndex([]byte{139, 139, 21, 239, 139, 5, 11}),     // 0 0 rmoveto 100 0 rlineto return
+		buildIndex([]byte{139, 139, 21, 139, 247, 92, 5, 11}), // 0 0 rmoveto 0 200 rlineto return
+	}
+
+	topDict := func(charsetOffset, fdSelectOffset, charstringsOffset, fdArrayOffset int) []byte {
+		var out []byte
+		out = append(out, dictInt(391)...)
+		out = append(out, dictInt(392)...)
+		out = append(out, dictInt(0)...)
+		out = append(out, 12, 30) // ROS
+		out = append(out, dictInt(charsetOffset)...)
+		out = append(out, 15)
+		out = append(out, dictInt(fdSelectOffset)...)
+		out = append(out, 12, 37)
+		out = append(out, dictInt(charstringsOffset)...)
+		out = append(out, 17)
+		out = append(out, dictInt(fdArrayOffset)...)
+		out = append(out, 12, 36)
+		return out
+	}
+	fontDict := func(privateOffset int) []byte {
+		out := dictInt(6)
+		out = append(out, dictInt(privateOffset)...)
+		return append(out, 18)
+	}
+
+	start := len(header) + len(names) + len(buildIndex(topDict(0, 0, 0, 0))) + len(strs) + len(globalSubrs)
+	charsetOffset := start
+	fdSelectOffset := charsetOffset + len(charset)
+	charstringsOffset := fdSelectOffset + len(fdSelect)
+	fdArrayOffset := charstringsOffset + len(charstrings)
+	private0 := fdArrayOffset + len(buildIndex(fontDict(0), fontDict(0)))
+	private1 := private0 + 6 + len(subrs[0])
+
+	var out []byte
+	out = append(out, header...)
+	out = append(out, names...)
+	out = append(out, buildIndex(topDict(charsetOffset, fdSelectOffset, charstringsOffset, fdArrayOffset))...)
+	out = append(out, strs...)
+	out = append(out, globalSubrs...)
+	out = append(out, charset...)
+	out = append(out, fdSelect...)
+	out = append(out, charstrings...)
+	out = append(out, buildIndex(fontDict(private0), fontDict(private1))...)
+	for _, subr := range subrs {
+		out = append(out, dictInt(6)...) // Subrs, relative to the Private DICT
+		out = append(out, 19)
+		out = append(out, subr...)
+	}
+	return out
+}
+
+func TestCIDFont(t *testing.T) {
+	font, err := Parse(buildCIDFont())
+	tu.AssertNoErr(t, err)
+
+	tu.Assert(t, font.IsCIDKeyed())
+	tu.Assert(t, font.ROS() == ROS{Registry: "Adobe", Ordering: "Identity", Supplement: 0})
+	tu.Assert(t, font.GlyphName(1) == "")
+
+	for gid, cid := range []uint16{0, 100, 101} {
+		got, ok := font.CID(api.GID(gid))
+		tu.Assert(t, ok && got == cid)
+		glyph, ok := font.GlyphFromCID(cid)
+		tu.Assert(t, ok && glyph == api.GID(gid))
+	}
+	_, ok := font.GlyphFromCID(50)
+	tu.Assert(t, !ok)
+	_, ok = font.CID(3)
+	tu.Assert(t, !ok)
+
+	// each glyph uses the local subroutines of its Font DICT
+	segments, _, err := font.LoadGlyph(1)
+	tu.AssertNoErr(t, err)
+	tu.Assert(t, len(segments) >= 2 && segments[1].Op == api.SegmentOpLineTo &&
+		segments[1].Args[0] == api.SegmentPoint{X: 100, Y: 0})
+	segments, _, err = font.LoadGlyph(2)
+	tu.AssertNoErr(t, err)
+	tu.Assert(t, len(segments) >= 2 && segments[1].Op == api.SegmentOpLineTo &&
+		segments[1].Args[0] == api.SegmentPoint{X: 0, Y: 200})
+}
@@ -0,0 +1,651 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package font
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/opentype/tables"
+)
+
+var (
+	errInvalidColrTable = errors.New("invalid 'COLR' table (EOF)")
+	errInvalidCpalTable = errors.New("invalid 'CPAL' table (EOF)")
+)
+
+// maxPaintDepth limits the nesting of the paint graphs,
+// which protects against cycles in malformed fonts.
+const maxPaintDepth = 64
+
+// maxPaintEdges limits the number of paints visited for one glyph,
+// since paint graphs sharing their nodes may require an exponential work.
+// This is the limit used by HarfBuzz.
+const maxPaintEdges = 1024 * 64
+
+// noVariationIndex is the varIndexBase value of a paint without variations
+const noVariationIndex = 0xFFFFFFFF
+
+// foregroundPaletteIndex selects the text color instead of a palette entry
+const foregroundPaletteIndex = 0xFFFF
+
+// cpal stores the first palette of the 'CPAL' table,
+// as non premultiplied RGBA colors.
+type cpal [][4]uint8
+
+func parseCPAL(src []byte) (cpal, error) {
+	if len(src) < 12 {
+		return nil, errInvalidCpalTable
+	}
+	numPaletteEntries := int(binary.BigEndian.Uint16(src[2:]))
+	numPalettes := int(binary.BigEndian.Uint16(src[4:]))
+	numColorRecords := int(binary.BigEndian.Uint16(src[6:]))
+	colorRecordsOffset := int(binary.BigEndian.Uint32(src[8:]))
+	if numPalettes == 0 {
+		return nil, nil
+	}
+	if len(src) < 14 {
+		return nil, errInvalidCpalTable
+	}
+	first := int(binary.BigEndian.Uint16(src[12:]))
+	if first+numPaletteEntries > numColorRecords {
+		return nil, fmt.Errorf("invalid 'CPAL' table (palette out of range: %d > %d)", first+numPaletteEntries, numColorRecords)
+	}
+	if len(src) < colorRecordsOffset+4*numColorRecords {
+		return nil, errInvalidCpalTable
+	}
+	out := make(cpal, numPaletteEntries)
+	for i := range out {
+		record := src[colorRecordsOffset+4*(first+i):]
+		out[i] = [4]uint8{record[2], record[1], record[0], record[3]} // stored as BGRA
+	}
+	return out, nil
+}
+
+type colrBaseGlyph struct {
+	glyph      gID
+	firstLayer uint16
+	numLayers  uint16
+}
+
+type colrLayer struct {
+	glyph        gID
+	paletteIndex uint16
+}
+
+// colrBasePaint is a base glyph of the version 1 table,
+// with the offset of its paint from the start of the table.
+type colrBasePaint struct {
+	glyph gID
+	paint int
+}
+
+// colr is the 'COLR' table. The paints of the version 1 table are
+// resolved when required, since they depend on the variable coordinates.
+// The ClipList, which is only an optimization for renderers, is ignored.
+type colr struct {
+	raw []byte
+
+	// version 0
+	baseGlyphs []colrBaseGlyph // sorted by glyph
+	layers     []colrLayer
+
+	// version 1
+	basePaints  []colrBasePaint // sorted by glyph
+	layerPaints []int           // offsets from the start of the table
+	varMap      tables.DeltaSetMapping
+	varStore    tables.ItemVarStore
+}
+
+func parseCOLR(src []byte) (colr, error) {
+	if len(src) < 14 {
+		return colr{}, errInvalidColrTable
+	}
+	out := colr{raw: src}
+	version := binary.BigEndian.Uint16(src)
+	numBaseGlyphs := int(binary.BigEndian.Uint16(src[2:]))
+	baseGlyphsOffset := int(binary.BigEndian.Uint32(src[4:]))
+	layersOffset := int(binary.BigEndian.Uint32(src[8:]))
+	numLayers := int(binary.BigEndian.Uint16(src[12:]))
+
+	if numBaseGlyphs != 0 {
+		if len(src) < baseGlyphsOffset+6*numBaseGlyphs {
+			return colr{}, errInvalidColrTable
+		}
+		out.baseGlyphs = make([]colrBaseGlyph, numBaseGlyphs)
+		for i := range out.baseGlyphs {
+			record := src[baseGlyphsOffset+6*i:]
+			out.baseGlyphs[i] = colrBaseGlyph{
+				glyph:      gID(binary.BigEndian.Uint16(record)),
+				firstLayer: binary.BigEndian.Uint16(record[2:]),
+				numLayers:  binary.BigEndian.Uint16(record[4:]),
+			}
+		}
+	}
+	if numLayers != 0 {
+		if len(src) < layersOffset+4*numLayers {
+			return colr{}, errInvalidColrTable
+		}
+		out.layers = make([]colrLayer, numLayers)
+		for i := range out.layers {
+			record := src[layersOffset+4*i:]
+			out.layers[i] = colrLayer{
+				glyph:        gID(binary.BigEndian.Uint16(record)),
+				paletteIndex: binary.BigEndian.Uint16(record[2:]),
+			}
+		}
+	}
+
+	if version == 0 {
+		return out, nil
+	}
+
+	if len(src) < 34 {
+		return colr{}, errInvalidColrTable
+	}
+	baseGlyphListOffset := int(binary.BigEndian.Uint32(src[14:]))
+	layerListOffset := int(binary.BigEndian.Uint32(src[18:]))
+	// the ClipList offset is at src[22:]
+	varIndexMapOffset := int(binary.BigEndian.Uint32(src[26:]))
+	varStoreOffset := int(binary.BigEndian.Uint32(src[30:]))
+
+	if baseGlyphListOffset != 0 {
+		if len(src) < baseGlyphListOffset+4 {
+			return colr{}, errInvalidColrTable
+		}
+		count := int(binary.BigEndian.Uint32(src[baseGlyphListOffset:]))
+		if len(src) < baseGlyphListOffset+4+6*count {
+			return colr{}, errInvalidColrTable
+		}
+		out.basePaints = make([]colrBasePaint, count)
+		for i := range out.basePaints {
+			record := src[baseGlyphListOffset+4+6*i:]
+			out.basePaints[i] = colrBasePaint{
+				glyph: gID(binary.BigEndian.Uint16(record)),
+				paint: baseGlyphListOffset + int(binary.BigEndian.Uint32(record[2:])),
+			}
+		}
+	}
+	if layerListOffset != 0 {
+		if len(src) < layerListOffset+4 {
+			return colr{}, errInvalidColrTable
+		}
+		count := int(binary.BigEndian.Uint32(src[layerListOffset:]))
+		if len(src) < layerListOffset+4+4*count {
+			return colr{}, errInvalidColrTable
+		}
+		out.layerPaints = make([]int, count)
+		for i := range out.layerPaints {
+			out.layerPaints[i] = layerListOffset + int(binary.BigEndian.Uint32(src[layerListOffset+4+4*i:]))
+		}
+	}
+	if varIndexMapOffset != 0 {
+		if len(src) < varIndexMapOffset {
+			return colr{}, errInvalidColrTable
+		}
+		var err error
+		out.varMap, _, err = tables.ParseDeltaSetMapping(src[varIndexMapOffset:])
+		if err != nil {
+			return colr{}, fmt.Errorf("invalid 'COLR' table: %s", err)
+		}
+	}
+	if varStoreOffset != 0 {
+		if len(src) < varStoreOffset {
+			return colr{}, errInvalidColrTable
+		}
+		var err error
+		out.varStore, _, err = tables.ParseItemVarStore(src[varStoreOffset:])
+		if err != nil {
+			return colr{}, fmt.Errorf("invalid 'COLR' table: %s", err)
+		}
+	}
+
+	return out, nil
+}
+
+// baseGlyph returns the version 0 record for [gid]
+func (c *colr) baseGlyph(gid gID) (colrBaseGlyph, bool) {
+	i := sort.Search(len(c.baseGlyphs), func(i int) bool { return c.baseGlyphs[i].glyph >= gid })
+	if i < len(c.baseGlyphs) && c.baseGlyphs[i].glyph == gid {
+		return c.baseGlyphs[i], true
+	}
+	return colrBaseGlyph{}, false
+}
+
+// basePaint returns the offset of the version 1 paint for [gid]
+func (c *colr) basePaint(gid gID) (int, bool) {
+	i := sort.Search(len(c.basePaints), func(i int) bool { return c.basePaints[i].glyph >= gid })
+	if i < len(c.basePaints) && c.basePaints[i].glyph == gid {
+		return c.basePaints[i].paint, true
+	}
+	return 0, false
+}
+
+// hasGlyph returns true if [gid] is a color glyph
+func (c *colr) hasGlyph(gid gID) bool {
+	if _, ok := c.basePaint(gid); ok {
+		return true
+	}
+	_, ok := c.baseGlyph(gid)
+	return ok
+}
+
+// delta returns the variation of the value at [varIndex], for the given coordinates.
+func (c *colr) delta(varIndex uint32, coords []float32) float32 {
+	if len(coords) == 0 {
+		return 0
+	}
+	// without mapping, the index is split in outer and inner indices
+	index := tables.VariationStoreIndex{DeltaSetOuter: uint16(varIndex >> 16), DeltaSetInner: uint16(varIndex)}
+	if m := c.varMap.Map; len(m) != 0 {
+		if int(varIndex) >= len(m) {
+			varIndex = uint32(len(m) - 1)
+		}
+		index = m[varIndex]
+	}
+	return c.varStore.GetDelta(index, coords)
+}
+
+// colrLayers returns the layers of [gid] defined in the 'COLR' table,
+// or false if [gid] is not a color glyph or is invalid.
+// Version 1 paints take precedence over version 0 layers.
+func (f *Face) colrLayers(gid gID) ([]api.GlyphLayer, bool) {
+	if offset, ok := f.colr.basePaint(gid); ok {
+		r := colrResolver{face: f, glyphs: []gID{gid}}
+		layers, err := r.rootLayers(offset)
+		return layers, err == nil
+	}
+
+	base, ok := f.colr.baseGlyph(gid)
+	if !ok {
+		return nil, false
+	}
+	end := int(base.firstLayer) + int(base.numLayers)
+	if end > len(f.colr.layers) {
+		return nil, false
+	}
+	layers := make([]api.GlyphLayer, 0, base.numLayers)
+	for _, layer := range f.colr.layers[base.firstLayer:end] {
+		color, err := f.paletteColor(layer.paletteIndex, 1)
+		if err != nil {
+			return nil, false
+		}
+		outline, _ := f.outlineGlyphData(layer.glyph)
+		layers = append(layers, api.GlyphLayer{Data: outline, Paint: color})
+	}
+	return layers, true
+}
+
+// paletteColor returns the color at [index] in the palette, with its alpha
+// multiplied by [alpha]
+func (f *Font) paletteColor(index uint16, alpha float32) (api.PaintSolid, error) {
+	var out api.PaintSolid
+	if index == foregroundPaletteIndex {
+		out = api.PaintSolid{Foreground: true, Color: [4]uint8{0, 0, 0, 0xFF}}
+	} else if int(index) < len(f.cpal) {
+		out = api.PaintSolid{Color: f.cpal[index]}
+	} else {
+		return out, fmt.Errorf("invalid palette index %d", index)
+	}
+	if alpha < 0 {
+		alpha = 0
+	} else if alpha > 1 {
+		alpha = 1
+	}
+	out.Color[3] = uint8(float32(out.Color[3])*alpha + 0.5)
+	return out, nil
+}
+
+// colrResolver builds the paint graph of a 'COLR' version 1 glyph.
+type colrResolver struct {
+	face   *Face
+	depth  int
+	edges  int   // number of paints visited
+	glyphs []gID // the base glyphs being resolved, to detect cycles
+}
+
+// rootLayers returns the layers of the paint at [offset] :
+// the children of a PaintColrLayers are returned as separate layers.
+func (r *colrResolver) rootLayers(offset int) ([]api.GlyphLayer, error) {
+	raw := r.face.colr.raw
+	if len(raw) <= offset {
+		return nil, errInvalidColrTable
+	}
+	var paints []api.Paint
+	if raw[offset] == 1 {
+		var err error
+		paints, err = r.colrLayers(offset)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		paint, err := r.paint(offset)
+		if err != nil {
+			return nil, err
+		}
+		paints = []api.Paint{paint}
+	}
+
+	layers := make([]api.GlyphLayer, len(paints))
+	for i, paint := range paints {
+		if glyph, ok := paint.(api.PaintGlyph); ok {
+			layers[i] = api.GlyphLayer{Data: glyph.Outline, Paint: glyph.Paint}
+		} else {
+			layers[i] = api.GlyphLayer{Paint: paint}
+		}
+	}
+	return layers, nil
+}
+
+// paintSizes is the size of the paint tables, indexed by format
+var paintSizes = [...]int{
+	1: 6, 2: 5, 3: 9, 4: 16, 5: 20, 6: 16, 7: 20, 8: 12, 9: 16, 10: 6,
+	11: 3, 12: 7, 13: 7, 14: 8, 15: 12, 16: 8, 17: 12, 18: 12, 19: 16, 20: 6,
+	21: 10, 22: 10, 23: 14, 24: 6, 25: 10, 26: 10, 27: 14, 28: 8, 29: 12, 30: 12,
+	31: 16, 32: 8,
+}
+
+// paint resolves the paint at [offset] from the start of the table
+func (r *colrResolver) paint(offset int) (api.Paint, error) {
+	if r.depth >= maxPaintDepth {
+		return nil, errors.New("invalid 'COLR' table (paint graph too deep)")
+	}
+	if r.edges >= maxPaintEdges {
+		return nil, errors.New("invalid 'COLR' table (paint graph too large)")
+	}
+	r.edges++
+	r.depth++
+	defer func() { r.depth-- }()
+
+	raw := r.face.colr.raw
+	if len(raw) <= offset {
+		return nil, errInvalidColrTable
+	}
+	format := int(raw[offset])
+	if format == 0 || format >= len(paintSizes) {
+		return nil, fmt.Errorf("invalid 'COLR' paint format %d", format)
+	}
+	if len(raw) < offset+paintSizes[format] {
+		return nil, errInvalidColrTable
+	}
+	data := raw[offset:]
+	// the odd formats from 3 to 31 are the variable versions of the previous one
+	variable := format >= 3 && format <= 31 && format%2 == 1
+
+	switch format {
+	case 1: // PaintColrLayers
+		layers, err := r.colrLayers(offset)
+		return api.PaintLayers{Layers: layers}, err
+	case 2, 3: // PaintSolid, PaintVarSolid
+		var v [1]float32
+		r.values(v[:], data[3:], 0, format == 3)
+		return r.face.paletteColor(binary.BigEndian.Uint16(data[1:]), v[0]/(1<<14))
+	case 4, 5: // PaintLinearGradient, PaintVarLinearGradient
+		line, err := r.colorLine(offset+int(uint24(data[1:])), variable)
+		if err != nil {
+			return nil, err
+		}
+		var v [6]float32
+		r.values(v[:], data[4:], 0, variable)
+		return api.PaintLinearGradient{
+			ColorLine: line,
+			P0:        [2]float32{v[0], v[1]},
+			P1:        [2]float32{v[2], v[3]},
+			P2:        [2]float32{v[4], v[5]},
+		}, nil
+	case 6, 7: // PaintRadialGradient, PaintVarRadialGradient
+		line, err := r.colorLine(offset+int(uint24(data[1:])), variable)
+		if err != nil {
+			return nil, err
+		}
+		var v [6]float32
+		r.values(v[:], data[4:], 1<<2|1<<5, variable)
+		return api.PaintRadialGradient{
+			ColorLine: line,
+			C0:        [2]float32{v[0], v[1]},
+			R0:        v[2],
+			C1:        [2]float32{v[3], v[4]},
+			R1:        v[5],
+		}, nil
+	case 8, 9: // PaintSweepGradient, PaintVarSweepGradient
+		line, err := r.colorLine(offset+int(uint24(data[1:])), variable)
+		if err != nil {
+			return nil, err
+		}
+		var v [4]float32
+		r.values(v[:], data[4:], 0, variable)
+		return api.PaintSweepGradient{
+			ColorLine:  line,
+			Center:     [2]float32{v[0], v[1]},
+			StartAngle: v[2] / (1 << 14) * 180,
+			EndAngle:   v[3] / (1 << 14) * 180,
+		}, nil
+	case 10: // PaintGlyph
+		paint, err := r.paint(offset + int(uint24(data[1:])))
+		if err != nil {
+			return nil, err
+		}
+		outline, _ := r.face.outlineGlyphData(gID(binary.BigEndian.Uint16(data[4:])))
+		return api.PaintGlyph{Outline: outline, Paint: paint}, nil
+	case 11: // PaintColrGlyph
+		return r.colrGlyph(gID(binary.BigEndian.Uint16(data[1:])))
+	case 32: // PaintComposite
+		source, err := r.paint(offset + int(uint24(data[1:])))
+		if err != nil {
+			return nil, err
+		}
+		mode := api.CompositeMode(data[4])
+		if mode > api.CompositeHSLLuminosity {
+			return nil, fmt.Errorf("invalid 'COLR' composite mode %d", mode)
+		}
+		backdrop, err := r.paint(offset + int(uint24(data[5:])))
+		if err != nil {
+			return nil, err
+		}
+		return api.PaintComposite{Mode: mode, Source: source, Backdrop: backdrop}, nil
+	default: // transformations, from 12 to 31
+		paint, err := r.paint(offset + int(uint24(data[1:])))
+		if err != nil {
+			return nil, err
+		}
+		transform, err := r.transform(format, offset, variable)
+		return api.PaintTransform{Transform: transform, Paint: paint}, err
+	}
+}
+
+// colrLayers resolves the children of the PaintColrLayers at [offset]
+func (r *colrResolver) colrLayers(offset int) ([]api.Paint, error) {
+	raw := r.face.colr.raw
+	if len(raw) < offset+6 {
+		return nil, errInvalidColrTable
+	}
+	numLayers := int(raw[offset+1])
+	first := int(binary.BigEndian.Uint32(raw[offset+2:]))
+	if first+numLayers > len(r.face.colr.layerPaints) {
+		return nil, fmt.Errorf("invalid 'COLR' table (layer out of range: %d > %d)", first+numLayers, len(r.face.colr.layerPaints))
+	}
+	out := make([]api.Paint, numLayers)
+	for i, layer := range r.face.colr.layerPaints[first : first+numLayers] {
+		var err error
+		out[i], err = r.paint(layer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// colrGlyph resolves the paint of the base glyph [gid], referenced by a PaintColrGlyph
+func (r *colrResolver) colrGlyph(gid gID) (api.Paint, error) {
+	for _, g := range r.glyphs {
+		if g == gid {
+			return nil, fmt.Errorf("invalid 'COLR' table (cycle for glyph %d)", gid)
+		}
+	}
+	offset, ok := r.face.colr.basePaint(gid)
+	if !ok { // nothing to draw
+		return api.PaintLayers{}, nil
+	}
+	r.glyphs = append(r.glyphs, gid)
+	defer func() { r.glyphs = r.glyphs[:len(r.glyphs)-1] }()
+	return r.paint(offset)
+}
+
+// colorLine resolves the (variable) color line at [offset]
+func (r *colrResolver) colorLine(offset int, variable bool) (api.ColorLine, error) {
+	raw := r.face.colr.raw
+	if len(raw) < offset+3 {
+		return api.ColorLine{}, errInvalidColrTable
+	}
+	extend := api.Extend(raw[offset])
+	if extend > api.ExtendReflect { // unknown values are treated as pad
+		extend = api.ExtendPad
+	}
+	numStops := int(binary.BigEndian.Uint16(raw[offset+1:]))
+	stopSize := 6
+	if variable {
+		stopSize = 10
+	}
+	if len(raw) < offset+3+stopSize*numStops {
+		return api.ColorLine{}, errInvalidColrTable
+	}
+	out := api.ColorLine{Extend: extend, Stops: make([]api.ColorStop, numStops)}
+	for i := range out.Stops {
+		stop := raw[offset+3+stopSize*i:]
+		// the stop offset and the alpha are both variable
+		var v [2]float32
+		r.values(v[:1], stop, 0, false)
+		r.values(v[1:], stop[4:], 0, false)
+		if variable {
+			if base := binary.BigEndian.Uint32(stop[6:]); base != noVariationIndex {
+				v[0] += r.face.colr.delta(base, r.face.Coords)
+				v[1] += r.face.colr.delta(base+1, r.face.Coords)
+			}
+		}
+		color, err := r.face.paletteColor(binary.BigEndian.Uint16(stop[2:]), v[1]/(1<<14))
+		if err != nil {
+			return api.ColorLine{}, err
+		}
+		out.Stops[i] = api.ColorStop{Offset: v[0] / (1 << 14), Color: color}
+	}
+	sort.SliceStable(out.Stops, func(i, j int) bool { return out.Stops[i].Offset < out.Stops[j].Offset })
+	return out, nil
+}
+
+// transform returns the transformation of the paint at [offset], whose format is in [12, 31]
+func (r *colrResolver) transform(format, offset int, variable bool) (api.Transform, error) {
+	data := r.face.colr.raw[offset:]
+	if format == 12 || format == 13 { // Affine2x3, stored as Fixed values
+		matrixOffset := offset + int(uint24(data[4:]))
+		size := 24
+		if variable {
+			size = 28
+		}
+		if len(r.face.colr.raw) < matrixOffset+size {
+			return api.Transform{}, errInvalidColrTable
+		}
+		matrix := r.face.colr.raw[matrixOffset:]
+		var v [6]float32
+		for i := range v {
+			v[i] = float32(int32(binary.BigEndian.Uint32(matrix[4*i:])))
+		}
+		if variable {
+			if base := binary.BigEndian.Uint32(matrix[24:]); base != noVariationIndex {
+				for i := range v {
+					v[i] += r.face.colr.delta(base+uint32(i), r.face.Coords)
+				}
+			}
+		}
+		return api.Transform{
+			A: v[0] / (1 << 16), B: v[1] / (1 << 16),
+			C: v[2] / (1 << 16), D: v[3] / (1 << 16),
+			E: v[4] / (1 << 16), F: v[5] / (1 << 16),
+		}, nil
+	}
+
+	const f2dot14 = 1 << 14
+	var v [5]float32
+	fields := data[4:]
+	switch format {
+	case 14, 15: // PaintTranslate
+		r.values(v[:2], fields, 0, variable)
+		return api.Transform{A: 1, D: 1, E: v[0], F: v[1]}, nil
+	case 16, 17: // PaintScale
+		r.values(v[:2], fields, 0, variable)
+		return api.Transform{A: v[0] / f2dot14, D: v[1] / f2dot14}, nil
+	case 18, 19: // PaintScaleAroundCenter
+		r.values(v[:4], fields, 0, variable)
+		return aroundCenter(api.Transform{A: v[0] / f2dot14, D: v[1] / f2dot14}, v[2], v[3]), nil
+	case 20, 21: // PaintScaleUniform
+		r.values(v[:1], fields, 0, variable)
+		return api.Transform{A: v[0] / f2dot14, D: v[0] / f2dot14}, nil
+	case 22, 23: // PaintScaleUniformAroundCenter
+		r.values(v[:3], fields, 0, variable)
+		return aroundCenter(api.Transform{A: v[0] / f2dot14, D: v[0] / f2dot14}, v[1], v[2]), nil
+	case 24, 25: // PaintRotate
+		r.values(v[:1], fields, 0, variable)
+		return rotation(v[0] / f2dot14), nil
+	case 26, 27: // PaintRotateAroundCenter
+		r.values(v[:3], fields, 0, variable)
+		return aroundCenter(rotation(v[0]/f2dot14), v[1], v[2]), nil
+	case 28, 29: // PaintSkew
+		r.values(v[:2], fields, 0, variable)
+		return skew(v[0]/f2dot14, v[1]/f2dot14), nil
+	default: // 30, 31 : PaintSkewAroundCenter
+		r.values(v[:4], fields, 0, variable)
+		return aroundCenter(skew(v[0]/f2dot14, v[1]/f2dot14), v[2], v[3]), nil
+	}
+}
+
+// values reads len(dst) 16 bits values from [data], signed unless flagged in [unsigned],
+// without conversion. If [variable] is true, the values are followed by
+// a varIndexBase, used to apply the variations.
+// The length of [data] must have been checked.
+func (r *colrResolver) values(dst []float32, data []byte, unsigned uint32, variable bool) {
+	for i := range dst {
+		v := binary.BigEndian.Uint16(data[2*i:])
+		if unsigned&(1<<i) != 0 {
+			dst[i] = float32(v)
+		} else {
+			dst[i] = float32(int16(v))
+		}
+	}
+	if !variable {
+		return
+	}
+	base := binary.BigEndian.Uint32(data[2*len(dst):])
+	if base == noVariationIndex {
+		return
+	}
+	for i := range dst {
+		dst[i] += r.face.colr.delta(base+uint32(i), r.face.Coords)
+	}
+}
+
+func uint24(b []byte) uint32 { return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2]) }
+
+// rotation returns the counter-clockwise rotation of [angle] half turns
+func rotation(angle float32) api.Transform {
+	sin, cos := math.Sincos(float64(angle) * math.Pi)
+	return api.Transform{A: float32(cos), B: float32(sin), C: float32(-sin), D: float32(cos)}
+}
+
+// skew returns the skew of the given counter-clockwise angles, in half turns
+func skew(xAngle, yAngle float32) api.Transform {
+	return api.Transform{
+		A: 1, D: 1,
+		B: float32(math.Tan(float64(yAngle) * math.Pi)),
+		C: float32(-math.Tan(float64(xAngle) * math.Pi)),
+	}
+}
+
+// aroundCenter applies [t] around (cx, cy) instead of the origin
+func aroundCenter(t api.Transform, cx, cy float32) api.Transform {
+	toOrigin := api.Transform{A: 1, D: 1, E: -cx, F: -cy}
+	fromOrigin := api.Transform{A: 1, D: 1, E: cx, F: cy}
+	return fromOrigin.Mul(t.Mul(toOrigin))
+}
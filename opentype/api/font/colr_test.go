@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package font
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/go-text/typesetting/opentype/api"
+	tu "github.com/go-text/typesetting/opentype/testutils"
+)
+
+// the following helpers build the binary 'COLR' and 'CPAL' tables used in tests
+
+func be16(v uint16) []byte { return []byte{byte(v >> 8), byte(v)} }
+func be24(v uint32) []byte { return []byte{byte(v >> 16), byte(v >> 8), byte(v)} }
+func be32(v uint32) []byte { return []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)} }
+
+func cat(parts ...[]byte) []byte {
+	var out []byte
+	for _, part := range parts {
+		out = append(out, part...)
+	}
+	return out
+}
+
+// withChildren appends the children to the table [head], writing
+// their Offset24 at the given positions.
+func withChildren(head []byte, positions []int, children ...[]byte) []byte {
+	out := append([]byte(nil), head...)
+	for i, child := range children {
+		copy(out[positions[i]:], be24(uint32(len(out))))
+		out = append(out, child...)
+	}
+	return out
+}
+
+// offsetList returns a list of records, made of a prefix and an Offset32 to a paint,
+// followed by the paints.
+func offsetList(prefixes [][]byte, paints [][]byte) []byte {
+	headerSize := 4
+	for _, prefix := range prefixes {
+		headerSize += len(prefix) + 4
+	}
+	out := be32(uint32(len(paints)))
+	offset := headerSize
+	for i, paint := range paints {
+		out = cat(out, prefixes[i], be32(uint32(offset)))
+		offset += len(paint)
+	}
+	return cat(out, cat(paints...))
+}
+
+func paintSolid(paletteIndex, alpha uint16) []byte {
+	return cat([]byte{2}, be16(paletteIndex), be16(alpha))
+}
+
+func paintGlyph(paint []byte, gid uint16) []byte {
+	return withChildren(cat([]byte{10}, be24(0), be16(gid)), []int{1}, paint)
+}
+
+func colorLine(extend uint8, stops ...[3]uint16) []byte {
+	out := cat([]byte{extend}, be16(uint16(len(stops))))
+	for _, stop := range stops {
+		out = cat(out, be16(stop[0]), be16(stop[1]), be16(stop[2]))
+	}
+	return out
+}
+
+var testPalette = cat(
+	be16(0), be16(2), be16(1), be16(2), be32(14), be16(0), // header
+	[]byte{0, 0, 0xFF, 0xFF}, // red
+	[]byte{0xFF, 0, 0, 0x80}, // semi transparent blue
+)
+
+var (
+	red        = api.PaintSolid{Color: [4]uint8{0xFF, 0, 0, 0xFF}}
+	blue       = api.PaintSolid{Color: [4]uint8{0, 0, 0xFF, 0x80}}
+	foreground = api.PaintSolid{Foreground: true, Color: [4]uint8{0, 0, 0, 0xFF}}
+)
+
+func TestParseCPAL(t *testing.T) {
+	palette, err := parseCPAL(testPalette)
+	tu.AssertNoErr(t, err)
+	tu.Assert(t, reflect.DeepEqual(palette, cpal{red.Color, blue.Color}))
+
+	_, err = parseCPAL(testPalette[:16])
+	tu.Assert(t, err != nil)
+}
+
+func TestCOLRv0(t *testing.T) {
+	table := cat(
+		be16(0), be16(1), be32(14), be32(20), be16(2), // header
+		be16(5), be16(0), be16(2), // base glyph 5
+		be16(6), be16(0), be16(7), be16(0xFFFF), // layers
+	)
+	colr, err := parseCOLR(table)
+	tu.AssertNoErr(t, err)
+	palette, _ := parseCPAL(testPalette)
+	ft := &Face{Font: &Font{colr: colr, cpal: palette}}
+
+	expected := []api.GlyphLayer{
+		{Data: api.GlyphOutline{}, Paint: red},
+		{Data: api.GlyphOutline{}, Paint: foreground},
+	}
+	tu.Assert(t, reflect.DeepEqual(ft.GlyphLayers(5), expected))
+	tu.Assert(t, ft.GlyphLayers(6) == nil)
+	tu.Assert(t, ft.HasColorTables())
+	tu.Assert(t, ft.HasColorGlyph(5) && !ft.HasColorGlyph(6))
+	tu.Assert(t, !(&Font{}).HasColorTables())
+
+	_, err = parseCOLR(table[:20])
+	tu.Assert(t, err != nil)
+}
+
+// buildCOLRv1 returns a 'COLR' version 1 table with the following base glyphs :
+//   - 10 : two layers, a semi transparent red glyph 3 and
+//     a linear gradient in glyph 4, rotated around (100, 0)
+//   - 11 : the composition of glyph 10 with a sweep gradient
+//   - 12 : an invalid cycle
+//   - 13 : glyph 3 filled with a variable alpha
+func buildCOLRv1() []byte {
+	linear := withChildren(cat([]byte{4}, be24(0),
+		be16(0), be16(0), be16(100), be16(0), be16(0), be16(100)), []int{1},
+		colorLine(0, [3]uint16{1 << 14, 1, 1 << 14}, [3]uint16{0, 0xFFFF, 1 << 14}),
+	)
+	rotate := withChildren(cat([]byte{26}, be24(0), be16(1<<13), be16(100), be16(0)), []int{1},
+		paintGlyph(linear, 4),
+	)
+	layerList := offsetList([][]byte{nil, nil}, [][]byte{
+		paintGlyph(paintSolid(0, 1<<13), 3),
+		rotate,
+	})
+
+	sweep := withChildren(cat([]byte{8}, be24(0), be16(50), be16(50), be16(0), be16(1<<14)), []int{1},
+		colorLine(2, [3]uint16{0, 0, 1 << 14}),
+	)
+	composite := withChildren(cat([]byte{32}, be24(0), []byte{byte(api.CompositeSrcIn)}, be24(0)), []int{1, 5},
+		cat([]byte{11}, be16(10)), // PaintColrGlyph
+		sweep,
+	)
+	varSolid := cat([]byte{3}, be16(0), be16(1<<13), be32(0))
+	baseGlyphList := offsetList([][]byte{be16(10), be16(11), be16(12), be16(13)}, [][]byte{
+		cat([]byte{1, 2}, be32(0)), // PaintColrLayers
+		composite,
+		cat([]byte{11}, be16(12)),
+		paintGlyph(varSolid, 3),
+	})
+
+	// one axis, with a delta of 0.25 on the alpha for the coordinate 1
+	varStore := cat(
+		be16(1), be32(12), be16(1), be32(22),
+		be16(1), be16(1), be16(0), be16(1<<14), be16(1<<14), // region list
+		be16(1), be16(1), be16(1), be16(0), be16(1<<12), // item variation data
+	)
+
+	const headerSize = 34
+	layerListOffset := headerSize + len(baseGlyphList)
+	varStoreOffset := layerListOffset + len(layerList)
+	header := cat(
+		be16(1), be16(0), be32(0), be32(0), be16(0),
+		be32(headerSize), be32(uint32(layerListOffset)), be32(0), be32(0), be32(uint32(varStoreOffset)),
+	)
+	return cat(header, baseGlyphList, layerList, varStore)
+}
+
+func assertTransform(t *testing.T, got, expected api.Transform) {
+	t.Helper()
+	for i, pair := range [...][2]float32{
+		{got.A, expected.A}, {got.B, expected.B}, {got.C, expected.C},
+		{got.D, expected.D}, {got.E, expected.E}, {got.F, expected.F},
+	} {
+		if math.Abs(float64(pair[0]-pair[1])) > 1e-4 {
+			t.Fatalf("coefficient %d: expected %v, got %v", i, expected, got)
+		}
+	}
+}
+
+func TestCOLRv1(t *testing.T) {
+	colr, err := parseCOLR(buildCOLRv1())
+	tu.AssertNoErr(t, err)
+	palette, _ := parseCPAL(testPalette)
+	ft := &Face{Font: &Font{colr: colr, cpal: palette}}
+
+	layers := ft.GlyphLayers(10)
+	tu.Assert(t, len(layers) == 2)
+	semiRed := api.PaintSolid{Color: [4]uint8{0xFF, 0, 0, 0x80}}
+	tu.Assert(t, reflect.DeepEqual(layers[0], api.GlyphLayer{Data: api.GlyphOutline{}, Paint: semiRed}))
+
+	tu.Assert(t, layers[1].Data == nil)
+	rotate, ok := layers[1].Paint.(api.PaintTransform)
+	tu.Assert(t, ok)
+	assertTransform(t, rotate.Transform, api.Transform{A: 0, B: 1, C: -1, D: 0, E: 100, F: -100})
+	expectedGlyph := api.PaintGlyph{
+		Outline: api.GlyphOutline{},
+		Paint: api.PaintLinearGradient{
+			ColorLine: api.ColorLine{
+				Extend: api.ExtendPad,
+				Stops:  []api.ColorStop{{Offset: 0, Color: foreground}, {Offset: 1, Color: blue}}, // sorted
+			},
+			P0: [2]float32{0, 0}, P1: [2]float32{100, 0}, P2: [2]float32{0, 100},
+		},
+	}
+	tu.Assert(t, reflect.DeepEqual(rotate.Paint, expectedGlyph))
+
+	layers = ft.GlyphLayers(11)
+	tu.Assert(t, len(layers) == 1 && layers[0].Data == nil)
+	composite, ok := layers[0].Paint.(api.PaintComposite)
+	tu.Assert(t, ok && composite.Mode == api.CompositeSrcIn)
+	source, ok := composite.Source.(api.PaintLayers)
+	tu.Assert(t, ok && len(source.Layers) == 2)
+	expectedSweep := api.PaintSweepGradient{
+		ColorLine:  api.ColorLine{Extend: api.ExtendReflect, Stops: []api.ColorStop{{Offset: 0, Color: red}}},
+		Center:     [2]float32{50, 50},
+		StartAngle: 0,
+		EndAngle:   180,
+	}
+	tu.Assert(t, reflect.DeepEqual(composite.Backdrop, expectedSweep))
+
+	for _, gid := range []api.GID{10, 11, 12, 13} {
+		tu.Assert(t, ft.HasColorGlyph(gid))
+	}
+	tu.Assert(t, !ft.HasColorGlyph(3))
+
+	// cycles are detected, and the glyph has no content
+	tu.Assert(t, ft.GlyphLayers(12) == nil)
+
+	// variations
+	for _, test := range []struct {
+		coords []float32
+		alpha  uint8
+	}{
+		{nil, 0x80},
+		{[]float32{0.5}, 159},
+		{[]float32{1}, 191},
+	} {
+		ft.Coords = test.coords
+		layers = ft.GlyphLayers(13)
+		tu.Assert(t, len(layers) == 1)
+		tu.Assert(t, layers[0].Paint == api.PaintSolid{Color: [4]uint8{0xFF, 0, 0, test.alpha}})
+	}
+}
+
+// buildCOLRv1Chain returns a 'COLR' version 1 table where the base glyph 20 is
+// a chain of [levels] PaintColrLayers, each one with 255 layers pointing
+// to the next PaintColrLayers.
+func buildCOLRv1Chain(levels int) []byte {
+	const numLayers = 255
+	layersSize := 4 + 4*levels*numLayers
+	layerList := be32(uint32(levels * numLayers))
+	for level := 0; level < levels; level++ {
+		next := layersSize + 6*level // the last one is the leaf
+		for i := 0; i < numLayers; i++ {
+			layerList = cat(layerList, be32(uint32(next)))
+		}
+	}
+	for level := 1; level < levels; level++ {
+		layerList = cat(layerList, []byte{1, numLayers}, be32(uint32(level*numLayers)))
+	}
+	layerList = cat(layerList, paintSolid(0, 1<<14))
+
+	baseGlyphList := offsetList([][]byte{be16(20)}, [][]byte{
+		cat([]byte{1, numLayers}, be32(0)),
+	})
+	const headerSize = 34
+	header := cat(
+		be16(1), be16(0), be32(0), be32(0), be16(0),
+		be32(headerSize), be32(uint32(headerSize+len(baseGlyphList))), be32(0), be32(0), be32(0),
+	)
+	return cat(header, baseGlyphList, layerList)
+}
+
+func TestCOLRv1PaintBudget(t *testing.T) {
+	palette, _ := parseCPAL(testPalette)
+
+	// a small chain is valid
+	colr, err := parseCOLR(buildCOLRv1Chain(2))
+	tu.AssertNoErr(t, err)
+	ft := &Face{Font: &Font{colr: colr, cpal: palette}}
+	layers := ft.GlyphLayers(20)
+	tu.Assert(t, len(layers) == 255)
+	inner, ok := layers[0].Paint.(api.PaintLayers)
+	tu.Assert(t, ok && len(inner.Layers) == 255 && inner.Layers[0] == red)
+
+	// 255^10 paints would be visited without limit
+	colr, err = parseCOLR(buildCOLRv1Chain(10))
+	tu.AssertNoErr(t, err)
+	ft = &Face{Font: &Font{colr: colr, cpal: palette}}
+	_, ok = ft.colrLayers(20)
+	tu.Assert(t, !ok)
+	tu.Assert(t, ft.GlyphLayers(20) == nil)
+}
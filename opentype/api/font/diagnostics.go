@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package font
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/opentype/loader"
+)
+
+var errInvalidBitmapTable = errors.New("invalid bitmap table")
+
+// TableError is an error found when loading a font table.
+type TableError struct {
+	Tag Tag
+	Err error
+}
+
+func (te TableError) Error() string {
+	return fmt.Sprintf("invalid '%s' table: %s", te.Tag, te.Err)
+}
+
+func (te TableError) Unwrap() error { return te.Err }
+
+// NewFontBestEffort is the same as [NewFont], but never fails : invalid tables
+// are ignored and the font is loaded with degraded functionality.
+// For instance, an invalid 'GPOS' table disables kerning and mark positioning,
+// an invalid 'cmap' table maps no rune, and an invalid 'head' table
+// uses the default 1000 units per em.
+//
+// The errors found are returned, so that applications may warn their users.
+// Absent optional tables are not considered as errors.
+func NewFontBestEffort(ld *loader.Loader) (*Font, []TableError) {
+	diags := fontDiagnostics{ld: ld}
+	ft, _ := newFont(ld, &diags) // never fails in best effort mode
+	return ft, diags.errors
+}
+
+// fontDiagnostics collects the errors found when loading a font.
+// Its methods are no-ops on a nil pointer.
+type fontDiagnostics struct {
+	ld     *loader.Loader
+	errors []TableError
+}
+
+// add records [err], if not nil.
+func (fd *fontDiagnostics) add(tag string, err error) {
+	if fd == nil || err == nil {
+		return
+	}
+	fd.errors = append(fd.errors, TableError{Tag: loader.MustNewTag(tag), Err: err})
+}
+
+// check records [err], if not nil and if the table is present in the font,
+// since absent optional tables are not errors.
+func (fd *fontDiagnostics) check(tag string, err error) {
+	if fd == nil || err == nil || !fd.ld.HasTable(loader.MustNewTag(tag)) {
+		return
+	}
+	fd.add(tag, err)
+}
+
+// emptyCmap is used when the 'cmap' table is invalid
+type emptyCmap struct{}
+
+type emptyCmapIter struct{}
+
+func (emptyCmapIter) Next() bool            { return false }
+func (emptyCmapIter) Char() (rune, api.GID) { return 0, 0 }
+
+func (emptyCmap) Iter() api.CmapIter          { return emptyCmapIter{} }
+func (emptyCmap) Lookup(rune) (api.GID, bool) { return 0, false }
@@ -33,6 +33,8 @@ type Font struct {
 	cff  *cff.Font
 	post post // optional
 	svg  svg  // optional
+	colr colr // optional
+	cpal cpal // optional
 
 	// Optional, only present in variable fonts
 
@@ -70,38 +72,50 @@ type Font struct {
 
 // NewFont loads all the font tables, sanitizing them.
 // An error is returned only when required tables 'cmap', 'head', 'maxp' are invalid (or missing).
-// More control on errors is available by using package [tables].
+// More control on errors is available by using package [tables], or [NewFontBestEffort].
 func NewFont(ld *loader.Loader) (*Font, error) {
+	return newFont(ld, nil)
+}
+
+// newFont loads the font tables. If [diags] is nil, errors on the required
+// tables are returned, otherwise they are recorded (as the errors on the optional tables)
+// and zero values are used instead.
+func newFont(ld *loader.Loader, diags *fontDiagnostics) (*Font, error) {
 	var (
 		out Font
 		err error
 	)
 
-	raw, err := ld.RawTable(loader.MustNewTag("cmap"))
-	if err != nil {
-		return nil, err
-	}
-	tb, _, err := tables.ParseCmap(raw)
-	if err != nil {
-		return nil, err
-	}
-	out.Cmap, out.cmapVar, err = api.ProcessCmap(tb)
+	out.Cmap, out.cmapVar, err = loadCmap(ld)
 	if err != nil {
-		return nil, err
+		if diags == nil {
+			return nil, err
+		}
+		diags.add("cmap", err)
+		out.Cmap = emptyCmap{}
 	}
 
 	out.head, err = LoadHeadTable(ld)
 	if err != nil {
-		return nil, err
+		if diags == nil {
+			return nil, err
+		}
+		diags.add("head", err)
 	}
 
-	raw, err = ld.RawTable(loader.MustNewTag("maxp"))
+	raw, err := ld.RawTable(loader.MustNewTag("maxp"))
 	if err != nil {
-		return nil, err
+		if diags == nil {
+			return nil, err
+		}
+		diags.add("maxp", err)
 	}
 	maxp, _, err := tables.ParseMaxp(raw)
 	if err != nil {
-		return nil, err
+		if diags == nil {
+			return nil, err
+		}
+		diags.check("maxp", err)
 	}
 
 	// We considerer all the following tables as optional,
@@ -112,64 +126,101 @@ func NewFont(ld *loader.Loader) (*Font, error) {
 	// which in turn will return a zero value
 
 	raw, _ = ld.RawTable(loader.MustNewTag("fvar"))
-	fvar, _, _ := tables.ParseFvar(raw)
+	fvar, _, err := tables.ParseFvar(raw)
+	diags.check("fvar", err)
 	out.fvar = newFvar(fvar)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("avar"))
-	out.avar, _, _ = tables.ParseAvar(raw)
+	out.avar, _, err = tables.ParseAvar(raw)
+	diags.check("avar", err)
 
 	out.upem = out.head.Upem()
 
 	raw, _ = ld.RawTable(loader.MustNewTag("OS/2"))
-	os2, _, _ := tables.ParseOs2(raw)
-	out.os2, _ = newOs2(os2)
+	os2, _, err := tables.ParseOs2(raw)
+	if err == nil {
+		out.os2, err = newOs2(os2)
+	}
+	diags.check("OS/2", err)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("glyf"))
 	locaRaw, _ := ld.RawTable(loader.MustNewTag("loca"))
 	loca, err := tables.ParseLoca(locaRaw, int(maxp.NumGlyphs), out.head.IndexToLocFormat == 1)
 	if err == nil { // ParseGlyf panics if len(loca) == 0
-		out.glyf, _ = tables.ParseGlyf(raw, loca)
+		out.glyf, err = tables.ParseGlyf(raw, loca)
+		diags.check("glyf", err)
+	} else {
+		diags.check("loca", err)
 	}
 
 	out.bitmap = selectBitmapTable(ld)
+	if out.bitmap == nil {
+		for _, tag := range [...]string{"CBLC", "EBLC", "bloc"} {
+			diags.check(tag, errInvalidBitmapTable)
+		}
+	}
 
 	raw, _ = ld.RawTable(loader.MustNewTag("sbix"))
-	sbix, _, _ := tables.ParseSbix(raw, int(maxp.NumGlyphs))
+	sbix, _, err := tables.ParseSbix(raw, int(maxp.NumGlyphs))
+	diags.check("sbix", err)
 	out.sbix = newSbix(sbix)
 
-	out.cff, _ = loadCff(ld, int(maxp.NumGlyphs))
+	out.cff, err = loadCff(ld, int(maxp.NumGlyphs))
+	diags.check("CFF ", err)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("post"))
-	post, _, _ := tables.ParsePost(raw)
-	out.post, _ = newPost(post)
+	post, _, err := tables.ParsePost(raw)
+	if err == nil {
+		out.post, err = newPost(post)
+	}
+	diags.check("post", err)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("SVG "))
-	svg, _, _ := tables.ParseSVG(raw)
-	out.svg, _ = newSvg(svg)
+	svg, _, err := tables.ParseSVG(raw)
+	if err == nil {
+		out.svg, err = newSvg(svg)
+	}
+	diags.check("SVG ", err)
+
+	raw, _ = ld.RawTable(loader.MustNewTag("COLR"))
+	out.colr, err = parseCOLR(raw)
+	diags.check("COLR", err)
 
-	out.hhea, out.hmtx, _ = LoadHmtx(ld, int(maxp.NumGlyphs))
-	out.vhea, out.vmtx, _ = loadVmtx(ld, int(maxp.NumGlyphs))
+	raw, _ = ld.RawTable(loader.MustNewTag("CPAL"))
+	out.cpal, err = parseCPAL(raw)
+	diags.check("CPAL", err)
+
+	out.hhea, out.hmtx, err = LoadHmtx(ld, int(maxp.NumGlyphs))
+	diags.check("hhea", err)
+	out.vhea, out.vmtx, err = loadVmtx(ld, int(maxp.NumGlyphs))
+	diags.check("vhea", err)
 
 	if len(out.fvar) != 0 {
 		raw, _ = ld.RawTable(loader.MustNewTag("MVAR"))
-		mvar, _, _ := tables.ParseMVAR(raw)
+		mvar, _, err := tables.ParseMVAR(raw)
+		diags.check("MVAR", err)
 		out.mvar = newMvar(mvar)
 
 		raw, _ = ld.RawTable(loader.MustNewTag("gvar"))
-		gvar, _, _ := tables.ParseGvar(raw)
-		out.gvar, _ = newGvar(gvar, out.glyf)
+		gvar, _, err := tables.ParseGvar(raw)
+		if err == nil {
+			out.gvar, err = newGvar(gvar, out.glyf)
+		}
+		diags.check("gvar", err)
 
 		raw, _ = ld.RawTable(loader.MustNewTag("HVAR"))
 		hvar, _, err := tables.ParseHVAR(raw)
 		if err == nil {
 			out.hvar = &hvar
 		}
+		diags.check("HVAR", err)
 
 		raw, _ = ld.RawTable(loader.MustNewTag("VVAR"))
 		vvar, _, err := tables.ParseHVAR(raw)
 		if err == nil {
 			out.vvar = &vvar
 		}
+		diags.check("VVAR", err)
 	}
 
 	raw, _ = ld.RawTable(loader.MustNewTag("VORG"))
@@ -177,48 +228,72 @@ func NewFont(ld *loader.Loader) (*Font, error) {
 	if err == nil {
 		out.vorg = &vorg
 	}
+	diags.check("VORG", err)
 
 	// layout tables
-	out.GDEF, _ = loadGDEF(ld, len(out.fvar))
+	out.GDEF, err = loadGDEF(ld, len(out.fvar))
+	diags.check("GDEF", err)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("GSUB"))
 	layout, _, err := tables.ParseLayout(raw)
 	// harfbuzz relies on GSUB.Loookups being nil when the table is absent
 	if err == nil {
-		out.GSUB, _ = newGSUB(layout)
+		out.GSUB, err = newGSUB(layout)
+		out.GSUB.setFeatureParams(layout.FeatureParams(raw))
 	}
+	diags.check("GSUB", err)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("GPOS"))
 	layout, _, err = tables.ParseLayout(raw)
 	// harfbuzz relies on GPOS.Loookups being nil when the table is absent
 	if err == nil {
-		out.GPOS, _ = newGPOS(layout)
+		out.GPOS, err = newGPOS(layout)
+		out.GPOS.setFeatureParams(layout.FeatureParams(raw))
 	}
+	diags.check("GPOS", err)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("morx"))
-	morx, _, _ := tables.ParseMorx(raw, int(maxp.NumGlyphs))
+	morx, _, err := tables.ParseMorx(raw, int(maxp.NumGlyphs))
+	diags.check("morx", err)
 	out.Morx = newMorx(morx)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("kerx"))
-	kerx, _, _ := tables.ParseKerx(raw, int(maxp.NumGlyphs))
+	kerx, _, err := tables.ParseKerx(raw, int(maxp.NumGlyphs))
+	diags.check("kerx", err)
 	out.Kerx = newKernxFromKerx(kerx)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("kern"))
-	kern, _, _ := tables.ParseKern(raw)
+	kern, _, err := tables.ParseKern(raw)
+	diags.check("kern", err)
 	out.Kern = newKernxFromKern(kern)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("ankr"))
-	out.Ankr, _, _ = tables.ParseAnkr(raw, int(maxp.NumGlyphs))
+	out.Ankr, _, err = tables.ParseAnkr(raw, int(maxp.NumGlyphs))
+	diags.check("ankr", err)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("trak"))
-	out.Trak, _, _ = tables.ParseTrak(raw)
+	out.Trak, _, err = tables.ParseTrak(raw)
+	diags.check("trak", err)
 
 	raw, _ = ld.RawTable(loader.MustNewTag("feat"))
-	out.Feat, _, _ = tables.ParseFeat(raw)
+	out.Feat, _, err = tables.ParseFeat(raw)
+	diags.check("feat", err)
 
 	return &out, nil
 }
 
+func loadCmap(ld *loader.Loader) (api.Cmap, api.UnicodeVariations, error) {
+	raw, err := ld.RawTable(loader.MustNewTag("cmap"))
+	if err != nil {
+		return nil, nil, err
+	}
+	tb, _, err := tables.ParseCmap(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return api.ProcessCmap(tb)
+}
+
 var bhedTag = loader.MustNewTag("bhed")
 
 // LoadHeadTable loads the table corresponding to the 'head' tag.
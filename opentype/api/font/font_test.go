@@ -3,10 +3,14 @@
 package font
 
 import (
+	"bytes"
+	"encoding/binary"
 	"testing"
 
 	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/opentype/loader"
 	tu "github.com/go-text/typesetting/opentype/testutils"
+	"golang.org/x/image/font/gofont/goregular"
 )
 
 func TestCrashes(t *testing.T) {
@@ -36,3 +40,65 @@ func BenchmarkLoad(b *testing.B) {
 		}
 	}
 }
+
+func TestCapHeightFallback(t *testing.T) {
+	ld, err := loader.NewLoader(bytes.NewReader(goregular.TTF))
+	tu.AssertNoErr(t, err)
+	ft, err := NewFont(ld)
+	tu.AssertNoErr(t, err)
+	face := &Face{Font: ft}
+
+	capHeight, xHeight := face.LineMetric(api.CapHeight), face.LineMetric(api.XHeight)
+	tu.Assert(t, capHeight > xHeight && xHeight > 0)
+
+	// simulate an old 'OS/2' table : the glyphs are measured
+	ft.os2.version = 1
+	tu.Assert(t, face.LineMetric(api.CapHeight) == face.glyphTop('H'))
+	tu.Assert(t, face.LineMetric(api.XHeight) == face.glyphTop('x'))
+	close := func(a, b float32) bool { return a-b <= 2 && b-a <= 2 }
+	tu.Assert(t, close(face.LineMetric(api.CapHeight), capHeight))
+	tu.Assert(t, close(face.LineMetric(api.XHeight), xHeight))
+	tu.Assert(t, face.glyphTop(0x10FFFF) == 0)
+}
+
+// truncateTable returns a copy of [font], where the table [tag] is truncated to 2 bytes
+func truncateTable(font []byte, tag string) []byte {
+	font = append([]byte(nil), font...)
+	numTables := int(binary.BigEndian.Uint16(font[4:]))
+	for i := 0; i < numTables; i++ {
+		record := font[12+16*i:]
+		if string(record[:4]) == tag {
+			binary.BigEndian.PutUint32(record[12:], 2)
+		}
+	}
+	return font
+}
+
+func TestNewFontBestEffort(t *testing.T) {
+	ld, err := loader.NewLoader(bytes.NewReader(goregular.TTF))
+	tu.AssertNoErr(t, err)
+	ft, diags := NewFontBestEffort(ld)
+	tu.Assert(t, len(diags) == 0)
+	_, ok := ft.NominalGlyph('a')
+	tu.Assert(t, ok)
+
+	data := truncateTable(truncateTable(goregular.TTF, "cmap"), "hhea")
+	ld, err = loader.NewLoader(bytes.NewReader(data))
+	tu.AssertNoErr(t, err)
+
+	_, err = NewFont(ld)
+	tu.Assert(t, err != nil)
+
+	ft, diags = NewFontBestEffort(ld)
+	tu.Assert(t, len(diags) == 2)
+	tu.Assert(t, diags[0].Tag == loader.MustNewTag("cmap"))
+	tu.Assert(t, diags[1].Tag == loader.MustNewTag("hhea"))
+	tu.Assert(t, diags[1].Error() != "")
+
+	// the font is usable, with degraded functionality
+	_, ok = ft.NominalGlyph('a')
+	tu.Assert(t, !ok)
+	face := &Face{Font: ft}
+	_, ok = face.GlyphExtents(36)
+	tu.Assert(t, ok)
+}
@@ -124,6 +124,7 @@ var (
 	tagUnderlineOffset    = loader.MustNewTag("undo")
 	tagSuperscriptYSize   = loader.MustNewTag("spys")
 	tagSuperscriptXOffset = loader.MustNewTag("spxo")
+	tagSuperscriptYOffset = loader.MustNewTag("spyo")
 	tagSubscriptYSize     = loader.MustNewTag("sbys")
 	tagSubscriptYOffset   = loader.MustNewTag("sbyo")
 	tagSubscriptXOffset   = loader.MustNewTag("sbxo")
@@ -132,6 +133,8 @@ var (
 )
 
 // LineMetric returns the metric identified by `metric` (in fonts units).
+// For fonts without cap height or x-height metrics, these
+// are measured on the glyphs for 'H' and 'x'.
 func (f *Face) LineMetric(metric api.LineMetric) float32 {
 	switch metric {
 	case api.UnderlinePosition:
@@ -146,6 +149,8 @@ func (f *Face) LineMetric(metric api.LineMetric) float32 {
 		return float32(f.os2.ySuperscriptYSize) + f.mvar.getVar(tagSuperscriptYSize, f.Coords)
 	case api.SuperscriptEmXOffset:
 		return float32(f.os2.ySuperscriptXOffset) + f.mvar.getVar(tagSuperscriptXOffset, f.Coords)
+	case api.SuperscriptEmYOffset:
+		return float32(f.os2.ySuperscriptYOffset) + f.mvar.getVar(tagSuperscriptYOffset, f.Coords)
 	case api.SubscriptEmYSize:
 		return float32(f.os2.ySubscriptYSize) + f.mvar.getVar(tagSubscriptYSize, f.Coords)
 	case api.SubscriptEmYOffset:
@@ -153,14 +158,35 @@ func (f *Face) LineMetric(metric api.LineMetric) float32 {
 	case api.SubscriptEmXOffset:
 		return float32(f.os2.ySubscriptXOffset) + f.mvar.getVar(tagSubscriptXOffset, f.Coords)
 	case api.CapHeight:
+		if f.os2.version < 2 {
+			return f.glyphTop('H')
+		}
 		return float32(f.os2.sCapHeight) + f.mvar.getVar(tagCapHeight, f.Coords)
 	case api.XHeight:
+		if f.os2.version < 2 {
+			return f.glyphTop('x')
+		}
 		return float32(f.os2.sxHeigh) + f.mvar.getVar(tagXHeight, f.Coords)
 	default:
 		return 0
 	}
 }
 
+// glyphTop returns the top of the glyph used for [r], or 0 if not found.
+// It is used as a fallback for the cap height and x-height,
+// which are only provided by the 'OS/2' table from version 2.
+func (f *Face) glyphTop(r rune) float32 {
+	gid, ok := f.NominalGlyph(r)
+	if !ok {
+		return 0
+	}
+	extents, ok := f.GlyphExtents(gid)
+	if !ok {
+		return 0
+	}
+	return extents.YBearing
+}
+
 // NominalGlyph returns the glyph used to represent the given rune,
 // or false if not found.
 // Note that it only looks into the cmap, without taking account substitutions
@@ -331,12 +357,12 @@ func (f *Font) getExtentsFromBitmap(glyph gID, xPpem, yPpem uint16) (api.GlyphEx
 	}
 
 	/* convert to font units. */
-	xScale := float32(f.upem) / float32(strike.ppemX)
-	yScale := float32(f.upem) / float32(strike.ppemY)
-	extents.XBearing *= xScale
-	extents.YBearing *= yScale
-	extents.Width *= xScale
-	extents.Height *= yScale
+	xScale := api.Scale{Upem: f.upem, Ppem: float32(strike.ppemX)}
+	yScale := api.Scale{Upem: f.upem, Ppem: float32(strike.ppemY)}
+	extents.XBearing = xScale.FontUnits(extents.XBearing)
+	extents.YBearing = yScale.FontUnits(extents.YBearing)
+	extents.Width = xScale.FontUnits(extents.Width)
+	extents.Height = yScale.FontUnits(extents.Height)
 	return extents, true
 }
 
@@ -352,11 +378,11 @@ func (f *Font) getExtentsFromSbix(glyph gID, xPpem, yPpem uint16) (api.GlyphExte
 	extents, ok := bitmapGlyphExtents(data)
 
 	/* convert to font units. */
-	scale := float32(f.upem) / float32(strike.Ppem)
-	extents.XBearing *= scale
-	extents.YBearing *= scale
-	extents.Width *= scale
-	extents.Height *= scale
+	scale := api.Scale{Upem: f.upem, Ppem: float32(strike.Ppem)}
+	extents.XBearing = scale.FontUnits(extents.XBearing)
+	extents.YBearing = scale.FontUnits(extents.YBearing)
+	extents.Width = scale.FontUnits(extents.Width)
+	extents.Height = scale.FontUnits(extents.Height)
 	return extents, ok
 }
 
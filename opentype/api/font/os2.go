@@ -22,6 +22,7 @@ type os2 struct {
 	ySuperscriptXSize   float32
 	ySuperscriptYSize   float32
 	ySuperscriptXOffset float32
+	ySuperscriptYOffset float32
 	yStrikeoutSize      float32
 	yStrikeoutPosition  float32
 	sTypoAscender       float32
@@ -42,6 +43,7 @@ func newOs2(os tables.Os2) (os2, error) {
 		ySuperscriptXSize:   float32(os.YSuperscriptXSize),
 		ySuperscriptYSize:   float32(os.YSuperscriptYSize),
 		ySuperscriptXOffset: float32(os.YSuperscriptXOffset),
+		ySuperscriptYOffset: float32(os.YSuperscriptYOffset),
 		yStrikeoutSize:      float32(os.YStrikeoutSize),
 		yStrikeoutPosition:  float32(os.YStrikeoutPosition),
 		sTypoAscender:       float32(os.STypoAscender),
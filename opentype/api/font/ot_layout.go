@@ -2,7 +2,12 @@
 
 package font
 
-import "github.com/go-text/typesetting/opentype/tables"
+import (
+	"sort"
+
+	"github.com/go-text/typesetting/opentype/loader"
+	"github.com/go-text/typesetting/opentype/tables"
+)
 
 // shared between GSUB and GPOS
 type Layout struct {
@@ -42,6 +47,19 @@ type Script struct {
 type Feature struct {
 	tables.Feature
 	Tag Tag
+	// Params is the optional data of the feature, such as the
+	// name of a stylistic set, or nil.
+	Params tables.FeatureParams
+}
+
+// setFeatureParams sets the parameters of the features,
+// as returned by [tables.Layout.FeatureParams].
+func (la *Layout) setFeatureParams(params []tables.FeatureParams) {
+	for i := range la.Features {
+		if i < len(params) {
+			la.Features[i].Params = params[i]
+		}
+	}
 }
 
 // FindScript looks for [script] and return its index into the Scripts slice,
@@ -106,6 +124,257 @@ func (la *Layout) FindFeatureIndex(featureTag Tag) (uint16, bool) {
 	return 0, false
 }
 
+// DefaultLanguage is the language tag used by [LanguageSystem]
+// for the default language system of a script.
+var DefaultLanguage = loader.MustNewTag("dflt")
+
+// LanguageSystem is a script and language pair of a GSUB or GPOS table,
+// with the features it uses.
+type LanguageSystem struct {
+	Script Tag
+	// Language is [DefaultLanguage] for the default language system of the script.
+	Language Tag
+	// RequiredFeature is the index into the Features slice of the layout
+	// of the feature always applied, or -1.
+	RequiredFeature int
+	// Features are the (optional) features of the language system,
+	// as indices into the Features slice of the layout.
+	Features []uint16
+}
+
+// LanguageSystems returns the language systems of the layout,
+// sorted by script, with the default language system (if any)
+// before the other languages of each script.
+func (la *Layout) LanguageSystems() []LanguageSystem {
+	var out []LanguageSystem
+	add := func(script, language Tag, ls tables.LangSys) {
+		required := -1
+		if int(ls.RequiredFeatureIndex) < len(la.Features) {
+			required = int(ls.RequiredFeatureIndex)
+		}
+		features := make([]uint16, 0, len(ls.FeatureIndices))
+		for _, index := range ls.FeatureIndices {
+			if int(index) < len(la.Features) {
+				features = append(features, index)
+			}
+		}
+		out = append(out, LanguageSystem{Script: script, Language: language, RequiredFeature: required, Features: features})
+	}
+	for _, script := range la.Scripts {
+		if script.DefaultLangSys != nil {
+			add(script.Tag, DefaultLanguage, *script.DefaultLangSys)
+		}
+		for i, record := range script.LangSysRecords {
+			if i < len(script.LangSys) {
+				add(script.Tag, record.Tag, script.LangSys[i])
+			}
+		}
+	}
+	return out
+}
+
+// LayoutFeature describes a feature tag of the GSUB and GPOS tables.
+type LayoutFeature struct {
+	Tag Tag
+	// InGSUB and InGPOS are true if the feature is
+	// found in the respective table.
+	InGSUB, InGPOS bool
+	// Params is the optional data of the feature (see [Feature.Params]),
+	// or nil.
+	Params tables.FeatureParams
+}
+
+// LayoutScripts returns the script tags found in the GSUB and GPOS tables,
+// sorted and without duplicates.
+func (f *Font) LayoutScripts() []Tag {
+	var out []Tag
+	for _, layout := range [2]*Layout{&f.GSUB.Layout, &f.GPOS.Layout} {
+		for _, script := range layout.Scripts {
+			out = append(out, script.Tag)
+		}
+	}
+	return sortUniqueTags(out)
+}
+
+// LayoutFeatures returns the features found in the GSUB and GPOS tables,
+// sorted by tag, and with one entry per tag. This is typically used to display
+// the optional features (like stylistic sets) supported by a font.
+func (f *Font) LayoutFeatures() []LayoutFeature {
+	byTag := make(map[Tag]*LayoutFeature)
+	var out []LayoutFeature
+	for i, layout := range [2]*Layout{&f.GSUB.Layout, &f.GPOS.Layout} {
+		for _, feature := range layout.Features {
+			lf := byTag[feature.Tag]
+			if lf == nil {
+				lf = &LayoutFeature{Tag: feature.Tag}
+				byTag[feature.Tag] = lf
+			}
+			if i == 0 {
+				lf.InGSUB = true
+			} else {
+				lf.InGPOS = true
+			}
+			if lf.Params == nil {
+				lf.Params = feature.Params
+			}
+		}
+	}
+	for _, lf := range byTag {
+		out = append(out, *lf)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Tag < out[j].Tag })
+	return out
+}
+
+var (
+	tagDefaultScript = loader.MustNewTag("DFLT")
+	tagLatinScript   = loader.MustNewTag("latn")
+)
+
+// FeatureLookups returns the indices (into the lookup list) of the lookups of the
+// features [feature], enabled for [script] and [language].
+// As harfbuzz does, the 'DFLT' and 'latn' scripts are used when [script] is not found,
+// and the default language system is used when [language] is not found.
+func (la *Layout) FeatureLookups(feature, script, language Tag) []uint16 {
+	scriptIndex := la.FindScript(script)
+	if scriptIndex == -1 {
+		scriptIndex = la.FindScript(tagDefaultScript)
+	}
+	if scriptIndex == -1 {
+		scriptIndex = la.FindScript(tagLatinScript)
+	}
+	if scriptIndex == -1 {
+		return nil
+	}
+	sc := la.Scripts[scriptIndex]
+	langSys := sc.GetLangSys(uint16(sc.FindLanguage(language))) // -1 selects the default
+
+	var out []uint16
+	add := func(featureIndex uint16) {
+		if int(featureIndex) >= len(la.Features) || la.Features[featureIndex].Tag != feature {
+			return
+		}
+		for _, lookup := range la.Features[featureIndex].LookupListIndices {
+			if !containsUint16(out, lookup) {
+				out = append(out, lookup)
+			}
+		}
+	}
+	add(langSys.RequiredFeatureIndex)
+	for _, index := range langSys.FeatureIndices {
+		add(index)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func containsUint16(values []uint16, v uint16) bool {
+	for _, w := range values {
+		if v == w {
+			return true
+		}
+	}
+	return false
+}
+
+// FeatureCoverage is the set of glyphs which may be
+// affected by a feature, as returned by [Font.FeatureCoverage].
+type FeatureCoverage struct {
+	coverages []tables.Coverage
+}
+
+// FeatureCoverage returns the glyphs which may be affected by the GSUB and GPOS lookups
+// of [feature], enabled for [script] and [language] (see [Layout.FeatureLookups]).
+//
+// For contextual lookups, only the glyphs starting an input sequence are
+// included : the coverage is an upper bound, which may be used to check
+// that enabling a feature (like 'smcp' or 'frac') does not change a given text.
+func (f *Font) FeatureCoverage(feature, script, language Tag) FeatureCoverage {
+	var out FeatureCoverage
+	add := func(cov tables.Coverage) {
+		if cov != nil && cov.Len() != 0 {
+			out.coverages = append(out.coverages, cov)
+		}
+	}
+	for _, index := range f.GSUB.FeatureLookups(feature, script, language) {
+		if int(index) < len(f.GSUB.Lookups) {
+			for _, subtable := range f.GSUB.Lookups[index].Subtables {
+				add(subtable.Cov())
+			}
+		}
+	}
+	for _, index := range f.GPOS.FeatureLookups(feature, script, language) {
+		if int(index) < len(f.GPOS.Lookups) {
+			for _, subtable := range f.GPOS.Lookups[index].Subtables {
+				add(subtable.Cov())
+			}
+		}
+	}
+	return out
+}
+
+// IsEmpty returns true if the feature does not affect any glyph.
+func (fc FeatureCoverage) IsEmpty() bool { return len(fc.coverages) == 0 }
+
+// Contains returns true if [gid] may be affected by the feature.
+func (fc FeatureCoverage) Contains(gid GID) bool {
+	for _, cov := range fc.coverages {
+		if _, ok := cov.Index(tables.GlyphID(gid)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAny returns true if one of the [glyphs] may be affected by the feature.
+func (fc FeatureCoverage) ContainsAny(glyphs []GID) bool {
+	for _, gid := range glyphs {
+		if fc.Contains(gid) {
+			return true
+		}
+	}
+	return false
+}
+
+// Glyphs returns the glyphs which may be affected by the feature,
+// sorted and without duplicates.
+func (fc FeatureCoverage) Glyphs() []GID {
+	var out []GID
+	for _, cov := range fc.coverages {
+		switch cov := cov.(type) {
+		case tables.Coverage1:
+			for _, gid := range cov.Glyphs {
+				out = append(out, GID(gid))
+			}
+		case tables.Coverage2:
+			for _, rg := range cov.Ranges {
+				for gid := int(rg.StartGlyphID); gid <= int(rg.EndGlyphID); gid++ {
+					out = append(out, GID(gid))
+				}
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	unique := out[:0]
+	for _, gid := range out {
+		if len(unique) == 0 || gid != unique[len(unique)-1] {
+			unique = append(unique, gid)
+		}
+	}
+	return unique
+}
+
+func sortUniqueTags(tags []Tag) []Tag {
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+	out := tags[:0]
+	for _, tag := range tags {
+		if len(out) == 0 || tag != out[len(out)-1] {
+			out = append(out, tag)
+		}
+	}
+	return out
+}
+
 // ---------------------------------- GSUB ----------------------------------
 
 type GSUB struct {
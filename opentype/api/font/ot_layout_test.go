@@ -7,6 +7,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/go-text/typesetting/opentype/loader"
 	"github.com/go-text/typesetting/opentype/tables"
 	tu "github.com/go-text/typesetting/opentype/testutils"
 )
@@ -74,3 +75,123 @@ func TestOTFeatureVariation(t *testing.T) {
 	tu.Assert(t, gsub.FindVariationIndex([]float32{0.8}) == 0)
 	tu.Assert(t, gsub.FindVariationIndex([]float32{0.4}) == -1)
 }
+
+// buildFeaturesLayout returns a GSUB table without lookups, with
+// the 'latn' script and the 'cv01', 'liga', 'size' and 'ss01' features.
+func buildFeaturesLayout() []byte {
+	u16 := func(dst []byte, vs ...uint16) []byte {
+		for _, v := range vs {
+			dst = append(dst, byte(v>>8), byte(v))
+		}
+		return dst
+	}
+	scriptList := u16(nil, 1)
+	scriptList = append(scriptList, "latn"...)
+	scriptList = u16(scriptList, 8)
+	scriptList = u16(scriptList, 10, 1) // defaultLangSysOffset, langSysCount
+	scriptList = append(scriptList, "TRK "...)
+	scriptList = u16(scriptList, 20)
+	scriptList = u16(scriptList, 0, 0xFFFF, 2, 0, 1) // default LangSys
+	scriptList = u16(scriptList, 0, 2, 1, 3)         // TRK LangSys
+
+	featureList := u16(nil, 4)
+	for i, tag := range []string{"cv01", "liga", "size", "ss01"} {
+		featureList = append(featureList, tag...)
+		featureList = u16(featureList, []uint16{26, 50, 54, 58}[i])
+	}
+	featureList = u16(featureList, 4, 0)                  // cv01
+	featureList = u16(featureList, 2, 3, 4, 5, 2, 256, 2) // cv01 params
+	featureList = append(featureList, 0, 0, 'a', 1, 0, 0) // cv01 characters
+	featureList = u16(featureList, 0, 0)                  // liga
+	featureList = u16(featureList, 66, 0)                 // size, with the offset from the FeatureList
+	featureList = u16(featureList, 4, 0)                  // ss01
+	featureList = u16(featureList, 0, 300)                // ss01 params
+	featureList = u16(featureList, 100, 1, 300, 80, 120)  // size params
+
+	out := u16(nil, 1, 0, 10, uint16(10+len(scriptList)), uint16(10+len(scriptList)+len(featureList)))
+	out = append(out, scriptList...)
+	out = append(out, featureList...)
+	return u16(out, 0) // empty lookup list
+}
+
+func TestLayoutFeatures(t *testing.T) {
+	raw := buildFeaturesLayout()
+	table, _, err := tables.ParseLayout(raw)
+	tu.AssertNoErr(t, err)
+	gsub, err := newGSUB(table)
+	tu.AssertNoErr(t, err)
+	gsub.setFeatureParams(table.FeatureParams(raw))
+
+	tu.Assert(t, len(gsub.Features) == 4)
+	tu.Assert(t, reflect.DeepEqual(gsub.Features[0].Params, tables.FeatureParamsCharacterVariants{
+		Format: 2, FeatUILabelNameID: 3, FeatUITooltipTextNameID: 4, SampleTextNameID: 5,
+		NumNamedParameters: 2, FirstParamUILabelNameID: 256, Characters: []rune{'a', 0x10000},
+	}))
+	tu.Assert(t, gsub.Features[1].Params == nil)
+	tu.Assert(t, gsub.Features[2].Params == tables.FeatureParamsSize{DesignSize: 100, SubfamilyID: 1, SubfamilyNameID: 300, RangeStart: 80, RangeEnd: 120})
+	tu.Assert(t, gsub.Features[3].Params == tables.FeatureParamsStylisticSet{UINameID: 300})
+
+	latn := loader.MustNewTag("latn")
+	tu.Assert(t, reflect.DeepEqual(gsub.LanguageSystems(), []LanguageSystem{
+		{Script: latn, Language: DefaultLanguage, RequiredFeature: -1, Features: []uint16{0, 1}},
+		{Script: latn, Language: loader.MustNewTag("TRK "), RequiredFeature: 2, Features: []uint16{3}},
+	}))
+
+	ft := Font{GSUB: gsub}
+	ft.GPOS.Layout = newLayout(table) // without params
+	tu.Assert(t, reflect.DeepEqual(ft.LayoutScripts(), []Tag{latn}))
+	features := ft.LayoutFeatures()
+	tu.Assert(t, len(features) == 4)
+	for i, feature := range features {
+		tu.Assert(t, feature.Tag == gsub.Features[i].Tag && feature.InGSUB && feature.InGPOS)
+		tu.Assert(t, reflect.DeepEqual(feature.Params, gsub.Features[i].Params))
+	}
+}
+
+func TestFeatureCoverage(t *testing.T) {
+	var (
+		latn, trk  = loader.MustNewTag("latn"), loader.MustNewTag("TRK ")
+		smcp, liga = loader.MustNewTag("smcp"), loader.MustNewTag("liga")
+	)
+	single := func(glyphs ...tables.GlyphID) tables.GSUBLookup {
+		return tables.SingleSubs{Data: tables.SingleSubstData1{Coverage: tables.Coverage1{Glyphs: glyphs}}}
+	}
+	var ft Font
+	ft.GSUB = GSUB{
+		Layout: Layout{
+			Scripts: []Script{{Tag: latn, Script: tables.Script{
+				DefaultLangSys: &tables.LangSys{RequiredFeatureIndex: 0xFFFF, FeatureIndices: []uint16{0, 1}},
+				LangSysRecords: []tables.TagOffsetRecord{{Tag: trk}},
+				LangSys:        []tables.LangSys{{RequiredFeatureIndex: 2, FeatureIndices: []uint16{1}}},
+			}}},
+			Features: []Feature{
+				{Tag: smcp, Feature: tables.Feature{LookupListIndices: []uint16{0}}},
+				{Tag: liga, Feature: tables.Feature{LookupListIndices: []uint16{1}}},
+				{Tag: smcp, Feature: tables.Feature{LookupListIndices: []uint16{2, 0}}},
+			},
+		},
+		Lookups: []GSUBLookup{
+			{Subtables: []tables.GSUBLookup{single(3, 5)}},
+			{Subtables: []tables.GSUBLookup{tables.LigatureSubs{Coverage: tables.Coverage2{Ranges: []tables.RangeRecord{{StartGlyphID: 10, EndGlyphID: 12}}}}}},
+			{Subtables: []tables.GSUBLookup{single(3, 7), single()}},
+		},
+	}
+
+	tu.Assert(t, reflect.DeepEqual(ft.GSUB.FeatureLookups(smcp, latn, DefaultLanguage), []uint16{0}))
+	tu.Assert(t, reflect.DeepEqual(ft.GSUB.FeatureLookups(smcp, latn, trk), []uint16{0, 2}))
+	tu.Assert(t, reflect.DeepEqual(ft.GSUB.FeatureLookups(smcp, loader.MustNewTag("grek"), 0), []uint16{0})) // fallback to latn
+
+	cov := ft.FeatureCoverage(smcp, latn, 0) // unknown language : default language system
+	tu.Assert(t, !cov.IsEmpty() && cov.Contains(3) && !cov.Contains(7))
+	tu.Assert(t, reflect.DeepEqual(cov.Glyphs(), []GID{3, 5}))
+
+	cov = ft.FeatureCoverage(smcp, latn, trk) // required feature
+	tu.Assert(t, reflect.DeepEqual(cov.Glyphs(), []GID{3, 5, 7}))
+	tu.Assert(t, cov.ContainsAny([]GID{1, 7}) && !cov.ContainsAny([]GID{1, 2}))
+
+	cov = ft.FeatureCoverage(liga, latn, trk)
+	tu.Assert(t, reflect.DeepEqual(cov.Glyphs(), []GID{10, 11, 12}))
+
+	cov = ft.FeatureCoverage(loader.MustNewTag("frac"), latn, trk)
+	tu.Assert(t, cov.IsEmpty() && len(cov.Glyphs()) == 0)
+}
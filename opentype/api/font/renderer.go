@@ -53,6 +53,60 @@ func (f *Face) GlyphData(gid GID) api.GlyphData {
 	return nil
 }
 
+// HasColorTables returns true if the font has a 'COLR', 'sbix', bitmap or 'SVG ' table,
+// that is if [HasColorGlyph] may return true. It is cheaper than [HasColorGlyph],
+// and may be used to skip the check for each glyph of the (many) fonts without color glyphs.
+func (f *Font) HasColorTables() bool {
+	return len(f.colr.baseGlyphs) != 0 || len(f.colr.basePaints) != 0 ||
+		len(f.sbix) != 0 || len(f.bitmap) != 0 || len(f.svg) != 0
+}
+
+// HasColorGlyph returns true if the font provides a color representation for [gid],
+// in its 'COLR', 'sbix', 'CBDT' (PNG images) or 'SVG ' tables, as emoji fonts do.
+// It does not depend on the resolution: all the strikes are considered.
+func (f *Font) HasColorGlyph(gid GID) bool {
+	if f.colr.hasGlyph(gID(gid)) {
+		return true
+	}
+	for i := range f.sbix {
+		if strikeGlyph(&f.sbix[i], gID(gid), 0).GraphicType != 0 {
+			return true
+		}
+	}
+	for i := range f.bitmap {
+		if subtable := f.bitmap[i].findTable(gID(gid)); subtable != nil {
+			switch subtable.imageFormat {
+			case 17, 18, 19: // PNG
+				return true
+			}
+		}
+	}
+	_, ok := f.svg.rawGlyphData(gID(gid))
+	return ok
+}
+
+// GlyphLayers returns the ordered list of layers to draw for [gid],
+// or nil if [gid] has no content.
+// It abstracts over the color formats supported by the font ('COLR' versions 0 and 1,
+// 'sbix', 'CBDT' and 'SVG '), so that renderers only have to handle the three kinds of
+// [api.GlyphData] and the [api.Paint] of outline layers:
+//   - 'COLR' glyphs are returned as one layer per paint layer, resolved for the
+//     current variable coordinates and using the first 'CPAL' palette,
+//   - bitmap and SVG glyphs are returned as one layer,
+//   - other glyphs are returned as one outline layer filled with the foreground color.
+func (f *Face) GlyphLayers(gid GID) []api.GlyphLayer {
+	if layers, ok := f.colrLayers(gID(gid)); ok {
+		return layers
+	}
+	switch data := f.GlyphData(gid).(type) {
+	case api.GlyphBitmap, api.GlyphSVG:
+		return []api.GlyphLayer{{Data: data}}
+	case api.GlyphOutline:
+		return []api.GlyphLayer{{Data: data, Paint: api.PaintSolid{Foreground: true, Color: [4]uint8{0, 0, 0, 0xFF}}}}
+	}
+	return nil
+}
+
 func (sb sbix) glyphData(gid gID, xPpem, yPpem uint16) (api.GlyphBitmap, error) {
 	st := sb.chooseStrike(xPpem, yPpem)
 	if st == nil {
@@ -519,6 +519,49 @@ func TestCblcGlyph(t *testing.T) {
 	}
 }
 
+func TestHasColorGlyph(t *testing.T) {
+	ft := loadFont(t, "toys/Sbix3.ttf")
+	tu.Assert(t, ft.HasColorGlyph(4))
+
+	for _, filename := range td.WithCBLC {
+		ft = loadFont(t, filename.Path)
+		tu.Assert(t, ft.HasColorGlyph(api.GID(filename.GlyphRange[0])))
+	}
+
+	ft = loadFont(t, "toys/chromacheck-svg.ttf")
+	iter := ft.Cmap.Iter()
+	hasColor := false
+	for iter.Next() {
+		_, g := iter.Char()
+		hasColor = hasColor || ft.HasColorGlyph(g)
+	}
+	tu.Assert(t, hasColor)
+
+	ft = loadFont(t, "common/Roboto-BoldItalic.ttf")
+	iter = ft.Cmap.Iter()
+	for iter.Next() {
+		_, g := iter.Char()
+		tu.Assert(t, !ft.HasColorGlyph(g))
+	}
+}
+
+func TestGlyphLayers(t *testing.T) {
+	ft := &Face{Font: loadFont(t, "toys/Sbix3.ttf")}
+	layers := ft.GlyphLayers(4)
+	tu.Assert(t, len(layers) == 1 && layers[0].Paint == nil)
+	_, ok := layers[0].Data.(api.GlyphBitmap)
+	tu.Assert(t, ok)
+
+	ft = &Face{Font: loadFont(t, "common/Roboto-BoldItalic.ttf")}
+	gid, _ := ft.NominalGlyph('a')
+	layers = ft.GlyphLayers(gid)
+	tu.Assert(t, len(layers) == 1 && layers[0].Paint == api.PaintSolid{Foreground: true, Color: [4]uint8{0, 0, 0, 0xFF}})
+	_, ok = layers[0].Data.(api.GlyphOutline)
+	tu.Assert(t, ok)
+
+	tu.Assert(t, ft.GlyphLayers(0xFFFF) == nil)
+}
+
 func TestEblcGlyph(t *testing.T) {
 	runess := [][]rune{
 		{1569, 1570, 1571, 1572, 1573, 1574, 1575, 1576, 1577, 1578, 1579},
@@ -2,6 +2,7 @@ package metadata
 
 import (
 	"strings"
+	"unicode"
 )
 
 // name values corresponding to the xxxConsts arrays
@@ -164,7 +165,7 @@ func (fd *fontDescriptor) aspect() Aspect {
 // usually called "style"
 // inferFromStyle scans such a string and fills the missing fields,
 func (as *Aspect) inferFromStyle(additionalStyle string) {
-	additionalStyle = normalizeFamily(additionalStyle)
+	additionalStyle = NormalizeFamily(additionalStyle)
 
 	if as.Style == 0 {
 		if index := stringContainsConst(additionalStyle, styleStrings[:]); index != -1 {
@@ -271,9 +272,24 @@ func (fd *fontDescriptor) rawAspect() Aspect {
 	return Aspect{style, weight, stretch}
 }
 
-var rp = strings.NewReplacer(" ", "", "\t", "")
-
-func normalizeFamily(s1 string) string { return rp.Replace(strings.ToLower(s1)) }
+// NormalizeFamily returns a canonical version of the given family name,
+// suitable for comparison : it is lower cased, full width ASCII variants
+// are mapped to their regular form, and white spaces, hyphens and underscores are removed.
+// For instance, "Noto Sans", "noto-sans" and "ＮＯＴＯ　ＳＡＮＳ" are all normalized to "notosans".
+func NormalizeFamily(family string) string {
+	var b strings.Builder
+	b.Grow(len(family))
+	for _, r := range family {
+		if 0xFF01 <= r && r <= 0xFF5E { // full width ASCII variants
+			r = r - 0xFF01 + 0x21
+		}
+		if unicode.IsSpace(r) || r == '-' || r == '_' {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
 
 // returns the index in `constants` of a constant contained in `str`,
 // or -1
@@ -285,3 +301,144 @@ func stringContainsConst(str string, constants []string) int {
 	}
 	return -1
 }
+
+// MatchAspect returns the index in [candidates] of the aspect best
+// matching [query], or -1 if [candidates] is empty.
+// It follows the font matching algorithm of the CSS Fonts specification
+// (see https://www.w3.org/TR/css-fonts-4/#font-style-matching) :
+// candidates are first narrowed by stretch, then by style and finally by weight.
+// Unset (zero) fields are interpreted as the default (regular) values.
+func MatchAspect(candidates []Aspect, query Aspect) int {
+	if len(candidates) == 0 {
+		return -1
+	}
+
+	query.setDefaults()
+	normalized := make([]Aspect, len(candidates))
+	indices := make([]int, len(candidates))
+	for i, as := range candidates {
+		as.setDefaults()
+		normalized[i] = as
+		indices[i] = i
+	}
+
+	indices = retainStretch(normalized, indices, query.Stretch)
+	indices = retainStyle(normalized, indices, query.Style)
+	indices = retainWeight(normalized, indices, query.Weight)
+
+	return indices[0]
+}
+
+// retainStretch keeps the candidates with the best stretch value,
+// preferring narrower widths for condensed and normal queries,
+// and wider widths for expanded queries.
+func retainStretch(candidates []Aspect, indices []int, query Stretch) []int {
+	var (
+		narrower, wider       Stretch // closest values on each side, 0 if not found
+		hasNarrower, hasWider bool
+	)
+	for _, index := range indices {
+		stretch := candidates[index].Stretch
+		if stretch == query {
+			return filterIndices(indices, func(i int) bool { return candidates[i].Stretch == query })
+		}
+		if stretch < query && (!hasNarrower || stretch > narrower) {
+			narrower, hasNarrower = stretch, true
+		} else if stretch > query && (!hasWider || stretch < wider) {
+			wider, hasWider = stretch, true
+		}
+	}
+
+	var best Stretch
+	if query <= StretchNormal {
+		best = wider
+		if hasNarrower {
+			best = narrower
+		}
+	} else {
+		best = narrower
+		if hasWider {
+			best = wider
+		}
+	}
+	return filterIndices(indices, func(i int) bool { return candidates[i].Stretch == best })
+}
+
+// retainStyle keeps the candidates with the queried style, if any.
+func retainStyle(candidates []Aspect, indices []int, query Style) []int {
+	for _, index := range indices {
+		if candidates[index].Style == query {
+			return filterIndices(indices, func(i int) bool { return candidates[i].Style == query })
+		}
+	}
+	// since only two styles are supported, all the remaining
+	// candidates share the same (other) style
+	return indices
+}
+
+// retainWeight keeps the candidates with the best weight value,
+// with the special rules of the CSS specification for queries
+// between 400 and 500.
+func retainWeight(candidates []Aspect, indices []int, query Weight) []int {
+	var (
+		lighter, heavier       Weight // closest values on each side
+		hasLighter, hasHeavier bool
+
+		// closest value in ]query, 500], only used when query is in [400, 500]
+		upTo500    Weight
+		hasUpTo500 bool
+	)
+	for _, index := range indices {
+		weight := candidates[index].Weight
+		if weight == query {
+			return filterIndices(indices, func(i int) bool { return candidates[i].Weight == query })
+		}
+		if weight < query && (!hasLighter || weight > lighter) {
+			lighter, hasLighter = weight, true
+		} else if weight > query {
+			if weight <= WeightMedium && (!hasUpTo500 || weight < upTo500) {
+				upTo500, hasUpTo500 = weight, true
+			}
+			if !hasHeavier || weight < heavier {
+				heavier, hasHeavier = weight, true
+			}
+		}
+	}
+
+	var best Weight
+	switch {
+	case WeightNormal <= query && query <= WeightMedium:
+		// weights up to 500 (ascending), then lighter weights (descending), then heavier weights (ascending)
+		if hasUpTo500 {
+			best = upTo500
+		} else if hasLighter {
+			best = lighter
+		} else {
+			best = heavier
+		}
+	case query < WeightNormal:
+		// lighter weights first, then heavier weights
+		best = heavier
+		if hasLighter {
+			best = lighter
+		}
+	default:
+		// heavier weights first, then lighter weights
+		best = lighter
+		if hasHeavier {
+			best = heavier
+		}
+	}
+	return filterIndices(indices, func(i int) bool { return candidates[i].Weight == best })
+}
+
+// filterIndices filters [indices] in place, keeping the one for which [keep] is true.
+func filterIndices(indices []int, keep func(index int) bool) []int {
+	out := indices[:0]
+	for _, index := range indices {
+		if keep(index) {
+			out = append(out, index)
+		}
+	}
+	return out
+}
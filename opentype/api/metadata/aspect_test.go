@@ -0,0 +1,161 @@
+package metadata
+
+import (
+	"fmt"
+	"testing"
+
+	tu "github.com/go-text/typesetting/opentype/testutils"
+)
+
+func TestMatchAspectWeight(t *testing.T) {
+	weights := func(ws ...Weight) []Aspect {
+		out := make([]Aspect, len(ws))
+		for i, w := range ws {
+			out[i] = Aspect{StyleNormal, w, StretchNormal}
+		}
+		return out
+	}
+	for _, test := range []struct {
+		available []Aspect
+		query     Weight
+		expected  Weight
+	}{
+		{weights(300, 600), 400, 300},      // lighter first
+		{weights(300, 600), 500, 300},      // lighter first
+		{weights(300, 500), 400, 500},      // up to 500
+		{weights(300, 450), 400, 450},      // up to 500
+		{weights(300, 600), 450, 300},      // lighter first
+		{weights(600, 700), 400, 600},      // then heavier
+		{weights(300, 600), 700, 600},      // lighter when no heavier
+		{weights(300, 600, 900), 700, 900}, // heavier first
+		{weights(300, 600), 200, 300},      // heavier when no lighter
+		{weights(100, 300, 600), 200, 100}, // lighter first
+		{weights(400), 900, 400},
+	} {
+		index := MatchAspect(test.available, Aspect{Weight: test.query})
+		got := test.available[index].Weight
+		tu.AssertC(t, got == test.expected, fmt.Sprintf("for %v in %v, expected %v, got %v", test.query, test.available, test.expected, got))
+	}
+}
+
+func TestMatchAspectStretch(t *testing.T) {
+	stretches := func(ss ...Stretch) []Aspect {
+		out := make([]Aspect, len(ss))
+		for i, s := range ss {
+			out[i] = Aspect{StyleNormal, WeightNormal, s}
+		}
+		return out
+	}
+	for _, test := range []struct {
+		available []Aspect
+		query     Stretch
+		expected  Stretch
+	}{
+		{stretches(0.75, 1.25), StretchNormal, 0.75},
+		{stretches(0.75, 1.25), StretchSemiCondensed, 0.75},
+		{stretches(0.5, 0.75, 1.25), StretchSemiCondensed, 0.75},
+		{stretches(1.125, 1.25), StretchCondensed, 1.125},
+		{stretches(0.75, 1.25), StretchSemiExpanded, 1.25},
+		{stretches(0.75, 1.5, 2), StretchExpanded, 1.5},
+		{stretches(0.75, 0.875), StretchExpanded, 0.875},
+	} {
+		index := MatchAspect(test.available, Aspect{Stretch: test.query})
+		got := test.available[index].Stretch
+		tu.AssertC(t, got == test.expected, fmt.Sprintf("for %v in %v, expected %v, got %v", test.query, test.available, test.expected, got))
+	}
+}
+
+func TestMatchAspectOrder(t *testing.T) {
+	available := []Aspect{
+		{StyleNormal, WeightBold, StretchNormal},
+		{StyleItalic, WeightNormal, StretchNormal},
+		{StyleItalic, WeightBold, StretchCondensed},
+	}
+	// stretch is considered before style, which is considered before weight
+	tu.Assert(t, MatchAspect(available, Aspect{StyleItalic, WeightBold, StretchNormal}) == 1)
+	tu.Assert(t, MatchAspect(available, Aspect{StyleItalic, WeightBold, StretchCondensed}) == 2)
+	tu.Assert(t, MatchAspect(available, Aspect{StyleNormal, WeightNormal, StretchNormal}) == 0)
+	// zero values are defaults
+	tu.Assert(t, MatchAspect(available, Aspect{}) == 0)
+	tu.Assert(t, MatchAspect(nil, Aspect{}) == -1)
+}
+
+func TestMatchAspectMatrix(t *testing.T) {
+	var (
+		allWeights   = []Weight{100, 200, 300, 400, 500, 600, 700, 800, 900}
+		allStretches = []Stretch{0.5, 0.625, 0.75, 0.875, 1, 1.125, 1.25, 1.5, 2}
+		all          []Aspect
+	)
+	for _, s := range allStretches {
+		for _, w := range allWeights {
+			for _, st := range []Style{StyleNormal, StyleItalic} {
+				all = append(all, Aspect{st, w, s})
+			}
+		}
+	}
+
+	// exact matches are always selected
+	for i, query := range all {
+		tu.AssertC(t, MatchAspect(all, query) == i, fmt.Sprint(query))
+	}
+
+	// with a single candidate, it is always selected
+	for _, query := range all {
+		tu.Assert(t, MatchAspect(all[3:4], query) == 0)
+	}
+
+	// with only the regular face and the extreme stretches and weights,
+	// the result is predictable
+	subset := []Aspect{
+		{StyleNormal, WeightThin, StretchUltraCondensed},
+		{StyleNormal, WeightNormal, StretchNormal},
+		{StyleNormal, WeightBlack, StretchUltraExpanded},
+	}
+	for _, query := range all {
+		got := subset[MatchAspect(subset, query)]
+		switch {
+		case query.Stretch < StretchNormal:
+			tu.Assert(t, got.Stretch == StretchUltraCondensed)
+		case query.Stretch == StretchNormal:
+			tu.Assert(t, got.Stretch == StretchNormal)
+		default:
+			tu.Assert(t, got.Stretch == StretchUltraExpanded)
+		}
+	}
+}
+
+func TestInstance(t *testing.T) {
+	static := Description{Aspect: Aspect{StyleNormal, WeightBold, StretchNormal}}
+	got, vars := static.Instance(Aspect{StyleItalic, WeightNormal, StretchCondensed})
+	tu.Assert(t, got == static.Aspect && vars == nil)
+
+	variable := Description{
+		Aspect: Aspect{StyleNormal, WeightNormal, StretchNormal},
+		Axes: []AxisRange{
+			{Tag: tagWght, Minimum: 100, Default: 400, Maximum: 700},
+			{Tag: tagWdth, Minimum: 75, Default: 100, Maximum: 100},
+			{Tag: tagSlnt, Minimum: -10, Default: 0, Maximum: 0},
+		},
+	}
+	got, vars = variable.Instance(Aspect{StyleItalic, WeightBlack, StretchCondensed})
+	tu.AssertC(t, got == Aspect{StyleItalic, WeightBold, StretchCondensed}, fmt.Sprint(got))
+	tu.AssertC(t, len(vars) == 3, fmt.Sprint(vars))
+	tu.Assert(t, vars[0].Tag == tagWght && vars[0].Value == 700)
+	tu.Assert(t, vars[1].Tag == tagWdth && vars[1].Value == 75)
+	tu.Assert(t, vars[2].Tag == tagSlnt && vars[2].Value == -10)
+
+	got, vars = variable.Instance(Aspect{})
+	tu.AssertC(t, got == Aspect{StyleNormal, WeightNormal, StretchNormal}, fmt.Sprint(got))
+	tu.Assert(t, vars[2].Tag == tagSlnt && vars[2].Value == 0)
+
+	// 'ital' is preferred over 'slnt'
+	variable.Axes = append(variable.Axes, AxisRange{Tag: tagItal, Minimum: 0, Default: 0, Maximum: 1})
+	_, vars = variable.Instance(Aspect{Style: StyleItalic})
+	tu.Assert(t, vars[2].Tag == tagItal && vars[2].Value == 1)
+
+	// variable instances compete with static faces
+	candidates := []Aspect{{StyleNormal, WeightBold, StretchNormal}}
+	instance, _ := variable.Instance(Aspect{StyleItalic, WeightBold, StretchNormal})
+	candidates = append(candidates, instance)
+	tu.Assert(t, MatchAspect(candidates, Aspect{StyleItalic, WeightBold, StretchNormal}) == 1)
+}
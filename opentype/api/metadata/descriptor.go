@@ -1,6 +1,8 @@
 package metadata
 
 import (
+	"unicode/utf8"
+
 	"github.com/go-text/typesetting/opentype/api"
 	"github.com/go-text/typesetting/opentype/api/font"
 	"github.com/go-text/typesetting/opentype/loader"
@@ -26,6 +28,7 @@ type fontDescriptor struct {
 	cmap    api.Cmap // optional
 	metrics tables.Hmtx
 	post    tables.Post
+	fvar    tables.Fvar // optional
 }
 
 func newFontDescriptor(ld *loader.Loader) *fontDescriptor {
@@ -56,6 +59,9 @@ func newFontDescriptor(ld *loader.Loader) *fontDescriptor {
 	maxp, _, _ := tables.ParseMaxp(raw)
 	_, out.metrics, _ = font.LoadHmtx(ld, int(maxp.NumGlyphs))
 
+	raw, _ = ld.RawTable(tagFvar)
+	out.fvar, _, _ = tables.ParseFvar(raw)
+
 	return &out
 }
 
@@ -78,6 +84,27 @@ func (fd *fontDescriptor) family() string {
 	return family
 }
 
+// localizedFamilies returns the family names defined
+// for other languages, excluding [family]
+func (fd *fontDescriptor) localizedFamilies(family string) []string {
+	var out []string
+	seen := map[string]bool{NormalizeFamily(family): true}
+	for _, id := range [...]tables.NameID{nameFontFamily, namePreferredFamily, nameWWSFamily} {
+		for _, name := range fd.names.LocalizedNames(id) {
+			if name.Value == "" || !utf8.ValidString(name.Value) {
+				continue
+			}
+			key := NormalizeFamily(name.Value)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			out = append(out, name.Value)
+		}
+	}
+	return out
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
@@ -138,11 +165,144 @@ func (fd *fontDescriptor) isMonospace() bool {
 	return true
 }
 
+// Capabilities is a set of flags summarizing the
+// rendering features supported by a font.
+type Capabilities uint16
+
+const (
+	// CapVariable is set for variable fonts, providing a 'fvar' table.
+	CapVariable Capabilities = 1 << iota
+	// CapColorCOLR is set for fonts with color layers ('COLR' table).
+	CapColorCOLR
+	// CapColorCBDT is set for fonts with color bitmaps ('CBDT' table).
+	CapColorCBDT
+	// CapColorSbix is set for fonts with Apple color bitmaps ('sbix' table).
+	CapColorSbix
+	// CapColorSVG is set for fonts with SVG glyphs ('SVG ' table).
+	CapColorSVG
+	// CapMonospace is set for fonts whose glyphs all have the same advance.
+	CapMonospace
+	// CapBitmapOnly is set for fonts providing bitmap glyphs but no outlines.
+	CapBitmapOnly
+)
+
+// CapColor groups all the color glyph formats.
+const CapColor = CapColorCOLR | CapColorCBDT | CapColorSbix | CapColorSVG
+
+// Has returns true if all the flags in [flags] are set.
+func (c Capabilities) Has(flags Capabilities) bool { return c&flags == flags }
+
+// HasAny returns true if at least one of the flags in [flags] is set.
+func (c Capabilities) HasAny(flags Capabilities) bool { return c&flags != 0 }
+
+var (
+	tagFvar = loader.MustNewTag("fvar")
+	tagCOLR = loader.MustNewTag("COLR")
+	tagCBDT = loader.MustNewTag("CBDT")
+	tagSbix = loader.MustNewTag("sbix")
+	tagSVG  = loader.MustNewTag("SVG ")
+	tagGlyf = loader.MustNewTag("glyf")
+	tagCFF  = loader.MustNewTag("CFF ")
+	tagCFF2 = loader.MustNewTag("CFF2")
+	tagEBDT = loader.MustNewTag("EBDT")
+	tagBdat = loader.MustNewTag("bdat")
+)
+
+// capabilities only checks for the presence of tables,
+// without parsing them (except for the monospace property)
+func (fd *fontDescriptor) capabilities(ld *loader.Loader) Capabilities {
+	var out Capabilities
+	if ld.HasTable(tagFvar) {
+		out |= CapVariable
+	}
+	if ld.HasTable(tagCOLR) {
+		out |= CapColorCOLR
+	}
+	if ld.HasTable(tagCBDT) {
+		out |= CapColorCBDT
+	}
+	if ld.HasTable(tagSbix) {
+		out |= CapColorSbix
+	}
+	if ld.HasTable(tagSVG) {
+		out |= CapColorSVG
+	}
+	if fd.isMonospace() {
+		out |= CapMonospace
+	}
+	hasOutlines := ld.HasTable(tagGlyf) || ld.HasTable(tagCFF) || ld.HasTable(tagCFF2)
+	hasBitmaps := ld.HasTable(tagCBDT) || ld.HasTable(tagEBDT) || ld.HasTable(tagBdat) || ld.HasTable(tagSbix)
+	if !hasOutlines && hasBitmaps {
+		out |= CapBitmapOnly
+	}
+	return out
+}
+
+// AxisRange describes one variation axis of a variable font,
+// with values expressed in design units.
+type AxisRange struct {
+	Tag                       loader.Tag
+	Minimum, Default, Maximum float32
+}
+
+// Contains returns true if [value] is in the inclusive range
+// covered by the axis.
+func (ar AxisRange) Contains(value float32) bool {
+	return ar.Minimum <= value && value <= ar.Maximum
+}
+
+// Clamp returns the closest value to [value] covered by the axis.
+func (ar AxisRange) Clamp(value float32) float32 {
+	if value < ar.Minimum {
+		return ar.Minimum
+	}
+	if value > ar.Maximum {
+		return ar.Maximum
+	}
+	return value
+}
+
+func (fd *fontDescriptor) axes() []AxisRange {
+	if len(fd.fvar.Axis) == 0 {
+		return nil
+	}
+	out := make([]AxisRange, len(fd.fvar.Axis))
+	for i, axis := range fd.fvar.Axis {
+		out[i] = AxisRange{Tag: axis.Tag, Minimum: axis.Minimum, Default: axis.Default, Maximum: axis.Maximum}
+	}
+	return out
+}
+
 // Description provides font metadata.
 type Description struct {
-	Family      string
-	Aspect      Aspect
-	IsMonospace bool
+	Family string
+	// LocalizedFamilies are the additional family names
+	// found in the font, typically for other languages.
+	// Use [NormalizeFamily] before comparing family names.
+	LocalizedFamilies []string
+
+	Aspect       Aspect
+	IsMonospace  bool
+	Capabilities Capabilities
+
+	// Axes lists the variation axes of a variable font,
+	// in the order defined by the 'fvar' table.
+	// It is empty for static fonts.
+	Axes []AxisRange
+
+	// Languages are the languages the font declares support for.
+	Languages Languages
+}
+
+// Axis returns the range of the axis identified by [tag],
+// or false if the font has no such axis.
+func (d Description) Axis(tag loader.Tag) (AxisRange, bool) {
+	for _, axis := range d.Axes {
+		if axis.Tag == tag {
+			return axis, true
+		}
+	}
+	return AxisRange{}, false
 }
 
 // Metadata queries the family and the aspect properties of the
@@ -153,7 +313,77 @@ func Metadata(font *loader.Loader) Description {
 	var out Description
 	out.Aspect = descriptor.aspect()
 	out.Family = descriptor.family()
-	out.IsMonospace = descriptor.isMonospace()
+	out.LocalizedFamilies = descriptor.localizedFamilies(out.Family)
+	out.Capabilities = descriptor.capabilities(font)
+	out.IsMonospace = out.Capabilities.Has(CapMonospace)
+	out.Axes = descriptor.axes()
+	out.Languages = descriptor.languages(font)
 
 	return out
 }
+
+var (
+	tagWght = loader.MustNewTag("wght")
+	tagWdth = loader.MustNewTag("wdth")
+	tagSlnt = loader.MustNewTag("slnt")
+	tagItal = loader.MustNewTag("ital")
+)
+
+// defaultObliqueAngle is the angle used for oblique styles
+// when synthesized from a 'slnt' axis, as defined in CSS.
+const defaultObliqueAngle = -14
+
+// Instance returns the aspect closest to [query] which may be provided by the font,
+// taking into account the variation axes 'wght', 'wdth', 'ital' and 'slnt'.
+// The returned variations, expressed in design units, should be applied to the face
+// (see [font.Face.SetVariations]) to obtain the returned aspect.
+// For static fonts, [Description.Aspect] and nil are returned.
+//
+// The returned aspect is typically used as candidate for [MatchAspect], so that
+// variable fonts are matched by the style they are able to produce.
+func (d Description) Instance(query Aspect) (Aspect, []font.Variation) {
+	out := d.Aspect
+	if len(d.Axes) == 0 {
+		return out, nil
+	}
+	query.setDefaults()
+
+	var variations []font.Variation
+	if axis, ok := d.Axis(tagWght); ok {
+		value := axis.Clamp(float32(query.Weight))
+		variations = append(variations, font.Variation{Tag: tagWght, Value: value})
+		out.Weight = Weight(value)
+	}
+	if axis, ok := d.Axis(tagWdth); ok {
+		// 'wdth' is expressed in percentage of the normal width
+		value := axis.Clamp(float32(query.Stretch) * 100)
+		variations = append(variations, font.Variation{Tag: tagWdth, Value: value})
+		out.Stretch = Stretch(value / 100)
+	}
+
+	ital, hasItal := d.Axis(tagItal)
+	slnt, hasSlnt := d.Axis(tagSlnt)
+	switch query.Style {
+	case StyleItalic:
+		if hasItal && ital.Contains(1) {
+			variations = append(variations, font.Variation{Tag: tagItal, Value: 1})
+			out.Style = StyleItalic
+		} else if hasSlnt && slnt.Minimum < 0 { // negative values lean to the right
+			variations = append(variations, font.Variation{Tag: tagSlnt, Value: slnt.Clamp(defaultObliqueAngle)})
+			out.Style = StyleItalic
+		}
+	case StyleNormal:
+		if hasItal && ital.Contains(0) {
+			variations = append(variations, font.Variation{Tag: tagItal, Value: 0})
+			out.Style = StyleNormal
+		}
+		if hasSlnt && slnt.Contains(0) {
+			variations = append(variations, font.Variation{Tag: tagSlnt, Value: 0})
+			if !hasItal {
+				out.Style = StyleNormal
+			}
+		}
+	}
+
+	return out, variations
+}
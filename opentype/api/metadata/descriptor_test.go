@@ -38,9 +38,19 @@ func TestMetadata(t *testing.T) {
 		got := Metadata(ld)
 		tu.AssertC(t, got.Aspect == test.aspect, fmt.Sprint(got.Aspect))
 		tu.AssertC(t, got.Family == test.family, got.Family)
+		tu.AssertC(t, got.Capabilities.Has(CapMonospace) == got.IsMonospace, fmt.Sprint(got.Capabilities))
+		tu.AssertC(t, !got.Capabilities.HasAny(CapColor|CapBitmapOnly), fmt.Sprint(got.Capabilities))
 	}
 }
 
+func TestCapabilities(t *testing.T) {
+	c := CapVariable | CapColorSbix
+	tu.Assert(t, c.Has(CapVariable))
+	tu.Assert(t, !c.Has(CapVariable|CapMonospace))
+	tu.Assert(t, c.HasAny(CapColor))
+	tu.Assert(t, !c.HasAny(CapBitmapOnly|CapMonospace))
+}
+
 func Test_isMonospace(t *testing.T) {
 	for _, file := range tu.Filenames(t, "common") {
 		f, err := td.Files.ReadFile(file)
@@ -55,3 +65,45 @@ func Test_isMonospace(t *testing.T) {
 
 	tu.Assert(t, !(&fontDescriptor{}).isMonospace()) // check it does not crash
 }
+
+func TestAxes(t *testing.T) {
+	wght := AxisRange{Tag: loader.MustNewTag("wght"), Minimum: 100, Default: 400, Maximum: 900}
+	tu.Assert(t, wght.Contains(700))
+	tu.Assert(t, !wght.Contains(950))
+	tu.Assert(t, wght.Clamp(50) == 100)
+	tu.Assert(t, wght.Clamp(950) == 900)
+	tu.Assert(t, wght.Clamp(500) == 500)
+
+	desc := Description{Axes: []AxisRange{wght}}
+	got, ok := desc.Axis(loader.MustNewTag("wght"))
+	tu.Assert(t, ok && got == wght)
+	_, ok = desc.Axis(loader.MustNewTag("wdth"))
+	tu.Assert(t, !ok)
+
+	// static fonts have no axes
+	for _, file := range tu.Filenames(t, "common") {
+		f, err := td.Files.ReadFile(file)
+		tu.AssertNoErr(t, err)
+
+		ld, err := loader.NewLoader(bytes.NewReader(f))
+		tu.AssertNoErr(t, err)
+
+		desc := Metadata(ld)
+		tu.AssertC(t, (len(desc.Axes) != 0) == desc.Capabilities.Has(CapVariable), file)
+	}
+}
+
+func TestNormalizeFamily(t *testing.T) {
+	for _, test := range []struct {
+		family, expected string
+	}{
+		{"Noto Sans", "notosans"},
+		{"noto-sans", "notosans"},
+		{"Noto_Sans\tMono", "notosansmono"},
+		{"ＮＯＴＯ　ＳＡＮＳ", "notosans"},
+		{"ＭＳ ゴシック", "msゴシック"},
+		{"", ""},
+	} {
+		tu.AssertC(t, NormalizeFamily(test.family) == test.expected, test.family)
+	}
+}
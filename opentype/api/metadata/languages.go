@@ -0,0 +1,248 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/loader"
+	"github.com/go-text/typesetting/opentype/tables"
+)
+
+var (
+	tagMeta = loader.MustNewTag("meta")
+	tagOs2  = loader.MustNewTag("OS/2")
+	tagDlng = loader.MustNewTag("dlng")
+	tagSlng = loader.MustNewTag("slng")
+)
+
+// Languages describes the languages a font is designed for,
+// as declared in its 'meta' and 'OS/2' tables.
+type Languages struct {
+	// Design are the ScriptLangTags (like "zh-Hant" or "Latn") the font is designed for,
+	// from the 'dlng' entry of the 'meta' table.
+	Design []string
+	// Supported are the ScriptLangTags the font is able to render,
+	// from the 'slng' entry of the 'meta' table.
+	Supported []string
+	// CodePages is the 'ulCodePageRange' bit field of the 'OS/2' table,
+	// or 0 if not available.
+	CodePages uint64
+}
+
+// parseMeta returns the design and supported languages
+// found in a 'meta' table, ignoring invalid data.
+func parseMeta(data []byte) (design, supported []string) {
+	// header : version, flags, reserved, dataMapsCount
+	if len(data) < 16 {
+		return nil, nil
+	}
+	count := int(binary.BigEndian.Uint32(data[12:]))
+	for i := 0; i < count; i++ {
+		entry := data[16+12*i:]
+		if len(entry) < 12 {
+			break
+		}
+		tag := loader.Tag(binary.BigEndian.Uint32(entry))
+		offset, length := binary.BigEndian.Uint32(entry[4:]), binary.BigEndian.Uint32(entry[8:])
+		if uint64(offset)+uint64(length) > uint64(len(data)) {
+			continue
+		}
+		value := string(data[offset : offset+length])
+		switch tag {
+		case tagDlng:
+			design = splitScriptLangTags(value)
+		case tagSlng:
+			supported = splitScriptLangTags(value)
+		}
+	}
+	return design, supported
+}
+
+// splitScriptLangTags splits a comma separated list of ScriptLangTags
+func splitScriptLangTags(list string) []string {
+	var out []string
+	for _, tag := range strings.Split(list, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			out = append(out, tag)
+		}
+	}
+	return out
+}
+
+func (fd *fontDescriptor) languages(ld *loader.Loader) Languages {
+	var out Languages
+	if raw, err := ld.RawTable(tagMeta); err == nil {
+		out.Design, out.Supported = parseMeta(raw)
+	}
+	raw, _ := ld.RawTable(tagOs2)
+	if os2, _, err := tables.ParseOs2(raw); err == nil && os2.Version >= 1 && len(os2.HigherVersionData) >= 8 {
+		range1 := binary.BigEndian.Uint32(os2.HigherVersionData)
+		range2 := binary.BigEndian.Uint32(os2.HigherVersionData[4:])
+		out.CodePages = uint64(range2)<<32 | uint64(range1)
+	}
+	return out
+}
+
+// LanguageSupport is the level of support of a font for a language,
+// returned by [Languages.Support]. Higher values indicate a better support.
+type LanguageSupport uint8
+
+const (
+	// LanguageUnknown is returned when the font does not declare support for the language
+	LanguageUnknown LanguageSupport = iota
+	// LanguageCodePage is returned when an 'OS/2' code page covering the language is declared
+	LanguageCodePage
+	// LanguageSupported is returned when the language is listed in the 'slng' entry
+	LanguageSupported
+	// LanguageDesigned is returned when the language is listed in the 'dlng' entry
+	LanguageDesigned
+)
+
+// Support returns how the font supports [lang].
+func (ls Languages) Support(lang language.Language) LanguageSupport {
+	primary, script := languageScript(lang)
+	for _, tag := range ls.Design {
+		if matchScriptLangTag(tag, primary, script) {
+			return LanguageDesigned
+		}
+	}
+	for _, tag := range ls.Supported {
+		if matchScriptLangTag(tag, primary, script) {
+			return LanguageSupported
+		}
+	}
+	if len(ls.Design) == 0 && len(ls.Supported) == 0 {
+		if bits := codePagesFor(primary, script); bits != 0 && ls.CodePages&bits != 0 {
+			return LanguageCodePage
+		}
+	}
+	return LanguageUnknown
+}
+
+// MatchLanguage returns the index in [candidates] of the first font
+// with the best support for [lang], or -1 if [candidates] is empty.
+// It is typically used to select among fonts with the same aspect,
+// for instance to prefer a Traditional Chinese design for "zh-Hant" text.
+func MatchLanguage(candidates []Languages, lang language.Language) int {
+	best, bestSupport := -1, LanguageUnknown
+	for i, ls := range candidates {
+		if support := ls.Support(lang); best == -1 || support > bestSupport {
+			best, bestSupport = i, support
+		}
+	}
+	return best
+}
+
+// languageScript returns the primary language and the script
+// (explicit or implied by the region) of [lang], as lower case strings.
+func languageScript(lang language.Language) (primary, script string) {
+	subtags := strings.Split(string(lang), "-")
+	primary = subtags[0]
+	region := ""
+	for _, subtag := range subtags[1:] {
+		switch len(subtag) {
+		case 4:
+			if script == "" {
+				script = subtag
+			}
+		case 2:
+			if region == "" {
+				region = subtag
+			}
+		}
+	}
+	if script != "" {
+		return primary, script
+	}
+	switch primary {
+	case "zh":
+		switch region {
+		case "tw", "hk", "mo":
+			script = "hant"
+		default:
+			script = "hans"
+		}
+	case "ja":
+		script = "jpan"
+	case "ko":
+		script = "kore"
+	}
+	return primary, script
+}
+
+// matchScriptLangTag returns true if the ScriptLangTag [tag]
+// covers the given language and script.
+func matchScriptLangTag(tag string, primary, script string) bool {
+	subtags := strings.Split(strings.ToLower(tag), "-")
+	tagLang, tagScript := subtags[0], ""
+	if len(tagLang) == 4 { // script only tag
+		tagLang, tagScript = "", tagLang
+	} else if len(subtags) > 1 && len(subtags[1]) == 4 {
+		tagScript = subtags[1]
+	}
+	if tagLang != "" && tagLang != primary {
+		return false
+	}
+	if tagScript == "" || script == "" {
+		return tagLang != ""
+	}
+	return tagScript == script ||
+		// Japanese and Korean use Han ideographs
+		(tagScript == "hani" && (script == "jpan" || script == "kore"))
+}
+
+// code page bits from the OS/2 'ulCodePageRange1' field
+const (
+	cpLatin1      = 1 << 0
+	cpLatin2      = 1 << 1
+	cpCyrillic    = 1 << 2
+	cpGreek       = 1 << 3
+	cpTurkish     = 1 << 4
+	cpHebrew      = 1 << 5
+	cpArabic      = 1 << 6
+	cpBaltic      = 1 << 7
+	cpVietnamese  = 1 << 8
+	cpThai        = 1 << 16
+	cpJapanese    = 1 << 17
+	cpSimplified  = 1 << 18
+	cpKorean      = 1 << 19
+	cpTraditional = 1 << 20
+)
+
+// codePagesFor returns the code pages covering the given language,
+// or 0 if unknown.
+func codePagesFor(primary, script string) uint64 {
+	switch primary {
+	case "zh":
+		if script == "hant" {
+			return cpTraditional
+		}
+		return cpSimplified
+	case "ja":
+		return cpJapanese
+	case "ko":
+		return cpKorean
+	case "th":
+		return cpThai
+	case "vi":
+		return cpVietnamese
+	case "ar", "fa", "ur":
+		return cpArabic
+	case "he", "yi":
+		return cpHebrew
+	case "el":
+		return cpGreek
+	case "tr", "az":
+		return cpTurkish
+	case "ru", "uk", "be", "bg", "sr", "mk":
+		return cpCyrillic
+	case "lt", "lv", "et":
+		return cpBaltic
+	case "cs", "pl", "hu", "sk", "sl", "hr", "ro":
+		return cpLatin2
+	case "en", "fr", "de", "es", "it", "pt", "nl", "da", "sv", "no", "nb", "fi", "is", "ca":
+		return cpLatin1
+	}
+	return 0
+}
@@ -0,0 +1,92 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/go-text/typesetting/language"
+)
+
+func buildMeta(entries map[string]string) []byte {
+	var tags []string
+	for tag := range entries {
+		tags = append(tags, tag)
+	}
+	data := make([]byte, 16+12*len(tags))
+	binary.BigEndian.PutUint32(data, 1)
+	binary.BigEndian.PutUint32(data[12:], uint32(len(tags)))
+	for i, tag := range tags {
+		entry := data[16+12*i:]
+		copy(entry, tag)
+		binary.BigEndian.PutUint32(entry[4:], uint32(len(data)))
+		binary.BigEndian.PutUint32(entry[8:], uint32(len(entries[tag])))
+		data = append(data, entries[tag]...)
+	}
+	return data
+}
+
+func TestParseMeta(t *testing.T) {
+	design, supported := parseMeta(buildMeta(map[string]string{
+		"dlng": "zh-Hant, Hani",
+		"slng": "Hani,Latn,Kana,Hira",
+		"appl": "ignored",
+	}))
+	if !reflect.DeepEqual(design, []string{"zh-Hant", "Hani"}) {
+		t.Errorf("unexpected design languages %v", design)
+	}
+	if !reflect.DeepEqual(supported, []string{"Hani", "Latn", "Kana", "Hira"}) {
+		t.Errorf("unexpected supported languages %v", supported)
+	}
+
+	// invalid data is ignored
+	if design, supported = parseMeta([]byte{1, 2, 3}); design != nil || supported != nil {
+		t.Errorf("unexpected languages %v %v", design, supported)
+	}
+	if design, _ = parseMeta(buildMeta(map[string]string{"dlng": "en"})[:29]); design != nil {
+		t.Errorf("unexpected languages %v", design)
+	}
+}
+
+func TestLanguagesSupport(t *testing.T) {
+	traditional := Languages{Design: []string{"zh-Hant"}, Supported: []string{"Hani", "Latn"}}
+	simplified := Languages{Design: []string{"zh-Hans"}, Supported: []string{"Hani", "Latn"}}
+	japanese := Languages{Design: []string{"ja"}, Supported: []string{"Hani", "Kana", "Hira"}}
+	legacyTraditional := Languages{CodePages: cpLatin1 | cpTraditional}
+	for _, test := range []struct {
+		ls       Languages
+		lang     string
+		expected LanguageSupport
+	}{
+		{traditional, "zh-Hant", LanguageDesigned},
+		{traditional, "zh-TW", LanguageDesigned},
+		{traditional, "zh-Hans", LanguageUnknown},
+		{traditional, "en", LanguageUnknown},
+		{simplified, "zh", LanguageDesigned},
+		{simplified, "zh-HK", LanguageUnknown},
+		{japanese, "ja-JP", LanguageDesigned},
+		{japanese, "ko", LanguageSupported},
+		{legacyTraditional, "zh-Hant", LanguageCodePage},
+		{legacyTraditional, "fr", LanguageCodePage},
+		{legacyTraditional, "ja", LanguageUnknown},
+		{Languages{}, "en", LanguageUnknown},
+	} {
+		if got := test.ls.Support(language.NewLanguage(test.lang)); got != test.expected {
+			t.Errorf("%v for %s: expected %d, got %d", test.ls, test.lang, test.expected, got)
+		}
+	}
+
+	candidates := []Languages{simplified, japanese, traditional}
+	if got := MatchLanguage(candidates, language.NewLanguage("zh-Hant-TW")); got != 2 {
+		t.Errorf("expected Traditional Chinese font, got %d", got)
+	}
+	if got := MatchLanguage(candidates, language.NewLanguage("ja")); got != 1 {
+		t.Errorf("expected Japanese font, got %d", got)
+	}
+	if got := MatchLanguage(candidates, language.NewLanguage("de")); got != 0 {
+		t.Errorf("expected first font, got %d", got)
+	}
+	if got := MatchLanguage(nil, language.NewLanguage("de")); got != -1 {
+		t.Errorf("expected -1, got %d", got)
+	}
+}
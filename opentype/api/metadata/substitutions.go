@@ -0,0 +1,76 @@
+package metadata
+
+// Substitutions maps a font family to the families which may replace it
+// when it is not installed, in preference order.
+// Family names are compared after normalization (see [NormalizeFamily]).
+type Substitutions map[string][]string
+
+// metricCompatible lists families designed with the same advances
+// as proprietary (or non free) ones, so that the layout of documents
+// is preserved when they are substituted.
+var metricCompatible = [...]struct {
+	family      string
+	substitutes []string
+}{
+	// Microsoft core fonts
+	{"Arial", []string{"Liberation Sans", "Arimo"}},
+	{"Arial Narrow", []string{"Liberation Sans Narrow"}},
+	{"Times New Roman", []string{"Liberation Serif", "Tinos"}},
+	{"Courier New", []string{"Liberation Mono", "Cousine"}},
+	{"Calibri", []string{"Carlito"}},
+	{"Cambria", []string{"Caladea"}},
+	{"Georgia", []string{"Gelasio"}},
+	// PostScript base fonts
+	{"Helvetica", []string{"Nimbus Sans", "TeX Gyre Heros", "Liberation Sans", "Arimo"}},
+	{"Helvetica Narrow", []string{"Nimbus Sans Narrow", "Liberation Sans Narrow"}},
+	{"Times", []string{"Nimbus Roman", "TeX Gyre Termes", "Liberation Serif", "Tinos"}},
+	{"Courier", []string{"Nimbus Mono PS", "TeX Gyre Cursor", "Liberation Mono", "Cousine"}},
+	{"Symbol", []string{"Standard Symbols PS"}},
+	{"Palatino", []string{"P052", "TeX Gyre Pagella"}},
+	{"Palatino Linotype", []string{"P052", "TeX Gyre Pagella"}},
+	{"Book Antiqua", []string{"P052", "TeX Gyre Pagella"}},
+	{"Bookman", []string{"URW Bookman", "TeX Gyre Bonum"}},
+	{"Bookman Old Style", []string{"URW Bookman", "TeX Gyre Bonum"}},
+	{"New Century Schoolbook", []string{"C059", "TeX Gyre Schola"}},
+	{"Century Schoolbook", []string{"C059", "TeX Gyre Schola"}},
+	{"Avant Garde", []string{"URW Gothic", "TeX Gyre Adventor"}},
+	{"ITC Avant Garde Gothic", []string{"URW Gothic", "TeX Gyre Adventor"}},
+	{"Zapf Chancery", []string{"Z003", "TeX Gyre Chorus"}},
+	{"ITC Zapf Chancery", []string{"Z003", "TeX Gyre Chorus"}},
+	{"Zapf Dingbats", []string{"D050000L"}},
+	// the free families are also compatible with each other
+	{"Liberation Sans", []string{"Arimo", "Arial"}},
+	{"Arimo", []string{"Liberation Sans", "Arial"}},
+	{"Liberation Serif", []string{"Tinos", "Times New Roman"}},
+	{"Tinos", []string{"Liberation Serif", "Times New Roman"}},
+	{"Liberation Mono", []string{"Cousine", "Courier New"}},
+	{"Cousine", []string{"Liberation Mono", "Courier New"}},
+	{"Carlito", []string{"Calibri"}},
+	{"Caladea", []string{"Cambria"}},
+}
+
+// MetricCompatibleSubstitutions returns a new table mapping common
+// proprietary families (like Arial, Times New Roman or Calibri) to the free
+// families with the same metrics (like Liberation Sans, Liberation Serif or Carlito),
+// so that documents keep their layout on systems where the former are not available.
+// The returned table may be modified with [Substitutions.Add].
+func MetricCompatibleSubstitutions() Substitutions {
+	out := make(Substitutions, len(metricCompatible))
+	for _, item := range metricCompatible {
+		out.Add(item.family, item.substitutes...)
+	}
+	return out
+}
+
+// Add registers [substitutes] for [family], with a lower
+// priority than the existing ones.
+func (s Substitutions) Add(family string, substitutes ...string) {
+	key := NormalizeFamily(family)
+	s[key] = append(s[key], substitutes...)
+}
+
+// Lookup returns the substitutes registered for [family],
+// in preference order, or nil if there is none.
+func (s Substitutions) Lookup(family string) []string {
+	return s[NormalizeFamily(family)]
+}
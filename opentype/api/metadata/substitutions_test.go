@@ -0,0 +1,34 @@
+package metadata
+
+import (
+	"reflect"
+	"testing"
+
+	tu "github.com/go-text/typesetting/opentype/testutils"
+)
+
+func TestMetricCompatibleSubstitutions(t *testing.T) {
+	subs := MetricCompatibleSubstitutions()
+	for _, test := range []struct {
+		family   string
+		expected []string
+	}{
+		{"Arial", []string{"Liberation Sans", "Arimo"}},
+		{"arial", []string{"Liberation Sans", "Arimo"}},
+		{"Times New Roman", []string{"Liberation Serif", "Tinos"}},
+		{"TimesNewRoman", []string{"Liberation Serif", "Tinos"}},
+		{"Calibri", []string{"Carlito"}},
+		{"Liberation Mono", []string{"Cousine", "Courier New"}},
+		{"Noto Sans", nil},
+	} {
+		tu.AssertC(t, reflect.DeepEqual(subs.Lookup(test.family), test.expected), test.family)
+	}
+
+	subs.Add("Calibri", "My Calibri")
+	subs.Add("Segoe UI", "Selawik")
+	tu.Assert(t, reflect.DeepEqual(subs.Lookup("calibri"), []string{"Carlito", "My Calibri"}))
+	tu.Assert(t, reflect.DeepEqual(subs.Lookup("Segoe UI"), []string{"Selawik"}))
+
+	// the tables are independent
+	tu.Assert(t, len(MetricCompatibleSubstitutions().Lookup("Calibri")) == 1)
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package api
+
+import (
+	"math"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// Scale converts distances expressed in font units to pixels
+// (or any other device unit), for a font with [Upem] units per em,
+// rendered at [Ppem] pixels per em.
+//
+// The zero value (or a zero [Upem]) maps every distance to 0.
+type Scale struct {
+	Upem uint16
+	Ppem float32
+	// Transform, if not nil, is applied to the scaled points
+	// returned by [Scale.Point], for instance to position a glyph.
+	Transform *Transform
+}
+
+// Factor returns the number of pixels per font unit, or 0 if Upem is 0.
+func (s Scale) Factor() float32 {
+	if s.Upem == 0 {
+		return 0
+	}
+	return s.Ppem / float32(s.Upem)
+}
+
+// Pixels converts [v] from font units to pixels.
+func (s Scale) Pixels(v float32) float32 {
+	if s.Upem == 0 {
+		return 0
+	}
+	return v * s.Ppem / float32(s.Upem)
+}
+
+// Fixed converts [v] from font units to pixels, rounded to the nearest 1/64 pixel.
+func (s Scale) Fixed(v float32) fixed.Int26_6 {
+	if s.Upem == 0 {
+		return 0
+	}
+	return fixed.Int26_6(math.Round(float64(v * (s.Ppem * 64) / float32(s.Upem))))
+}
+
+// FontUnits converts [v] from pixels to font units, or returns 0 if Ppem is 0.
+// It is the inverse of [Scale.Pixels].
+func (s Scale) FontUnits(v float32) float32 {
+	if s.Ppem == 0 {
+		return 0
+	}
+	return v * (float32(s.Upem) / s.Ppem)
+}
+
+// Matrix returns the transformation applied by [Scale.Point]:
+// the scaling, then [Transform], if any.
+func (s Scale) Matrix() Transform {
+	f := s.Factor()
+	out := Transform{A: f, D: f}
+	if s.Transform != nil {
+		out = s.Transform.Mul(out)
+	}
+	return out
+}
+
+// Point converts [p] from font units to pixels, and applies [Transform], if any.
+func (s Scale) Point(p SegmentPoint) SegmentPoint {
+	f := s.Factor()
+	p = SegmentPoint{X: p.X * f, Y: p.Y * f}
+	if s.Transform != nil {
+		p = s.Transform.Apply(p)
+	}
+	return p
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package api
+
+import (
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestScale(t *testing.T) {
+	s := Scale{Upem: 1000, Ppem: 12}
+	if f := s.Factor(); f != 0.012 {
+		t.Errorf("unexpected factor %f", f)
+	}
+	if v := s.Pixels(500); v != 6 {
+		t.Errorf("unexpected pixels %f", v)
+	}
+	if v := s.FontUnits(6); v != 500 {
+		t.Errorf("unexpected font units %f", v)
+	}
+	for _, test := range []struct {
+		v        float32
+		expected fixed.Int26_6
+	}{
+		{500, fixed.I(6)},
+		{-500, -fixed.I(6)},
+		{1, 1},     // 0.768 / 64 pixel
+		{0.5, 0},   // 0.384 / 64 pixel
+		{-1, -1},   // rounded to the nearest value
+		{556, 427}, // 427.008 / 64 pixels
+	} {
+		if got := s.Fixed(test.v); got != test.expected {
+			t.Errorf("Fixed(%f): expected %d, got %d", test.v, test.expected, got)
+		}
+	}
+
+	if p := s.Point(SegmentPoint{500, -1000}); p != (SegmentPoint{6, -12}) {
+		t.Errorf("unexpected point %v", p)
+	}
+	s.Transform = &Transform{A: 1, D: -1, E: 10, F: 20}
+	if p := s.Point(SegmentPoint{500, -1000}); p != (SegmentPoint{16, 32}) {
+		t.Errorf("unexpected point %v", p)
+	}
+	if p := s.Matrix().Apply(SegmentPoint{500, -1000}); p != (SegmentPoint{16, 32}) {
+		t.Errorf("unexpected point %v", p)
+	}
+
+	var zero Scale
+	if zero.Factor() != 0 || zero.Pixels(10) != 0 || zero.Fixed(10) != 0 || zero.FontUnits(10) != 0 {
+		t.Error("expected zero values for the zero Scale")
+	}
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package api
+
+import "strconv"
+
+// Transform is an affine transformation, mapping (x, y) to
+// (A*x + C*y + E, B*x + D*y + F), with the same convention as the
+// SVG 'matrix(a, b, c, d, e, f)' transform.
+// Note that the zero value maps every point to the origin; see [IdentityTransform].
+type Transform struct {
+	A, B, C, D, E, F float32
+}
+
+// IdentityTransform does not modify points.
+var IdentityTransform = Transform{A: 1, D: 1}
+
+// Apply returns the transformed point.
+func (t Transform) Apply(p SegmentPoint) SegmentPoint {
+	return SegmentPoint{
+		X: t.A*p.X + t.C*p.Y + t.E,
+		Y: t.B*p.X + t.D*p.Y + t.F,
+	}
+}
+
+// Mul returns the transformation applying [u], then [t].
+func (t Transform) Mul(u Transform) Transform {
+	return Transform{
+		A: t.A*u.A + t.C*u.B,
+		B: t.B*u.A + t.D*u.B,
+		C: t.A*u.C + t.C*u.D,
+		D: t.B*u.C + t.D*u.D,
+		E: t.A*u.E + t.C*u.F + t.E,
+		F: t.B*u.E + t.D*u.F + t.F,
+	}
+}
+
+// SVGPathOptions configures the conversion of outlines to SVG paths.
+type SVGPathOptions struct {
+	// FlipY negates the Y coordinates, before applying [Transform], so that
+	// the outlines (whose Y axis increases up) are displayed upright in the SVG coordinate
+	// system, whose Y axis increases down.
+	FlipY bool
+	// Transform is applied to each point. If nil, [IdentityTransform] is used.
+	Transform *Transform
+}
+
+// Combined returns the transformation applied to each point : the Y flip (if any), then [Transform].
+func (opts SVGPathOptions) Combined() Transform {
+	out := IdentityTransform
+	if opts.FlipY {
+		out.D = -1
+	}
+	if opts.Transform != nil {
+		out = opts.Transform.Mul(out)
+	}
+	return out
+}
+
+func (opts SVGPathOptions) apply(p SegmentPoint) SegmentPoint {
+	if opts.FlipY {
+		p.Y = -p.Y
+	}
+	if opts.Transform != nil {
+		p = opts.Transform.Apply(p)
+	}
+	return p
+}
+
+// AppendSVGPath appends to [dst] the SVG path data (the content of the "d" attribute)
+// describing [o], as a sequence of M, L, Q, C and Z commands, and returns the extended slice.
+// Each contour is closed.
+func (o GlyphOutline) AppendSVGPath(dst []byte, opts SVGPathOptions) []byte {
+	for i, seg := range o.Segments {
+		if seg.Op == SegmentOpMoveTo && i != 0 {
+			dst = append(dst, 'Z')
+		}
+		switch seg.Op {
+		case SegmentOpMoveTo:
+			dst = append(dst, 'M')
+		case SegmentOpLineTo:
+			dst = append(dst, 'L')
+		case SegmentOpQuadTo:
+			dst = append(dst, 'Q')
+		case SegmentOpCubeTo:
+			dst = append(dst, 'C')
+		}
+		for j, p := range seg.ArgsSlice() {
+			p = opts.apply(p)
+			if j != 0 {
+				dst = append(dst, ' ')
+			}
+			dst = appendSVGNumber(dst, p.X)
+			dst = append(dst, ' ')
+			dst = appendSVGNumber(dst, p.Y)
+		}
+	}
+	if len(o.Segments) != 0 {
+		dst = append(dst, 'Z')
+	}
+	return dst
+}
+
+// SVGPath returns the SVG path data describing [o].
+// See [GlyphOutline.AppendSVGPath] for more details.
+func (o GlyphOutline) SVGPath(opts SVGPathOptions) string {
+	return string(o.AppendSVGPath(nil, opts))
+}
+
+func appendSVGNumber(dst []byte, v float32) []byte {
+	if v == 0 { // avoid -0
+		return append(dst, '0')
+	}
+	return strconv.AppendFloat(dst, float64(v), 'f', -1, 32)
+}
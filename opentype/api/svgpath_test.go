@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package api
+
+import "testing"
+
+func TestSVGPath(t *testing.T) {
+	outline := GlyphOutline{Segments: []Segment{
+		{Op: SegmentOpMoveTo, Args: [3]SegmentPoint{{0, 0}}},
+		{Op: SegmentOpLineTo, Args: [3]SegmentPoint{{10, 0}}},
+		{Op: SegmentOpQuadTo, Args: [3]SegmentPoint{{15, 5}, {10, 10}}},
+		{Op: SegmentOpMoveTo, Args: [3]SegmentPoint{{2, 2}}},
+		{Op: SegmentOpCubeTo, Args: [3]SegmentPoint{{3, 3}, {4, 3}, {5.5, 2}}},
+	}}
+	for _, test := range []struct {
+		opts     SVGPathOptions
+		expected string
+	}{
+		{SVGPathOptions{}, "M0 0L10 0Q15 5 10 10ZM2 2C3 3 4 3 5.5 2Z"},
+		{SVGPathOptions{FlipY: true}, "M0 0L10 0Q15 -5 10 -10ZM2 -2C3 -3 4 -3 5.5 -2Z"},
+		{
+			SVGPathOptions{FlipY: true, Transform: &Transform{A: 2, D: 2, E: 1, F: 20}},
+			"M1 20L21 20Q31 10 21 0ZM5 16C7 14 9 14 12 16Z",
+		},
+	} {
+		if got := outline.SVGPath(test.opts); got != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, got)
+		}
+	}
+
+	if got := (GlyphOutline{}).SVGPath(SVGPathOptions{}); got != "" {
+		t.Errorf("expected empty path, got %s", got)
+	}
+
+	if p := IdentityTransform.Apply(SegmentPoint{3, 4}); p != (SegmentPoint{3, 4}) {
+		t.Errorf("unexpected point %v", p)
+	}
+
+	// composition
+	tr := Transform{A: 2, D: 2, E: 1, F: 20}
+	opts := SVGPathOptions{FlipY: true, Transform: &tr}
+	combined := opts.Combined()
+	for _, p := range []SegmentPoint{{0, 0}, {3, 4}, {-1, 7}} {
+		if got, expected := combined.Apply(p), opts.apply(p); got != expected {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	}
+	if got := (Transform{A: 1, D: 1, E: 2}).Mul(Transform{A: 3, D: 3}).Apply(SegmentPoint{1, 1}); got != (SegmentPoint{5, 3}) {
+		t.Errorf("unexpected point %v", got)
+	}
+}
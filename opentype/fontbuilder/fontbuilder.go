@@ -0,0 +1,615 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+// Package fontbuilder builds tiny, valid TrueType fonts in memory,
+// with a few glyphs, a character map, optional ligatures and raw tables.
+//
+// It is meant to test shaping and layout edge cases
+// without relying on binary font files.
+package fontbuilder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/opentype/api/font"
+	"github.com/go-text/typesetting/opentype/loader"
+)
+
+// Point is a point of a glyph contour, in font units.
+type Point struct{ X, Y int16 }
+
+// Glyph describes a glyph of the font.
+type Glyph struct {
+	// Advance is the horizontal advance, in font units.
+	Advance uint16
+	// Contours are the closed polygons of the glyph outline, whose
+	// points are all on the curve. By convention, outer contours
+	// are clockwise (see [Box]). An empty slice defines a blank glyph,
+	// like a space.
+	Contours [][]Point
+}
+
+// Box returns the clockwise rectangle contour with the given bounds.
+func Box(xMin, yMin, xMax, yMax int16) []Point {
+	return []Point{{xMin, yMin}, {xMin, yMax}, {xMax, yMax}, {xMax, yMin}}
+}
+
+type ligature struct {
+	glyph      api.GID
+	components []api.GID
+}
+
+// Builder accumulates the content of a font. Its zero value is
+// not valid: use [NewBuilder] instead.
+type Builder struct {
+	// Family is the family name of the font. If empty, "Test" is used.
+	Family string
+	// Upem is the number of font units per em. If zero, 1000 is used.
+	Upem uint16
+	// Ascent and Descent (usually negative) are the vertical
+	// metrics of the font. If both are zero, 80% and -20% of [Upem] are used.
+	Ascent, Descent int16
+
+	glyphs    []Glyph // without .notdef
+	cmap      map[rune]api.GID
+	ligatures []ligature
+	raw       map[loader.Tag][]byte // tables added with AddTable
+}
+
+// NewBuilder returns an empty builder. The glyph 0 (.notdef)
+// is always added by [Builder.Build], as a blank glyph of half an em.
+func NewBuilder() *Builder {
+	return &Builder{cmap: make(map[rune]api.GID)}
+}
+
+// AddGlyph adds [g] to the font, returning its glyph index.
+func (b *Builder) AddGlyph(g Glyph) api.GID {
+	b.glyphs = append(b.glyphs, g)
+	return api.GID(len(b.glyphs))
+}
+
+// Map adds a character map entry from [r] to [gid].
+func (b *Builder) Map(r rune, gid api.GID) { b.cmap[r] = gid }
+
+// AddRune is a convenience wrapper calling [Builder.AddGlyph] and [Builder.Map].
+func (b *Builder) AddRune(r rune, g Glyph) api.GID {
+	gid := b.AddGlyph(g)
+	b.Map(r, gid)
+	return gid
+}
+
+// AddLigature adds a rule replacing [components] by [glyph], which is
+// applied by the default 'liga' feature. At least two components are required.
+func (b *Builder) AddLigature(glyph api.GID, components ...api.GID) {
+	if len(components) < 2 {
+		return
+	}
+	b.ligatures = append(b.ligatures, ligature{glyph, append([]api.GID(nil), components...)})
+}
+
+// AddTable adds a table to the font, written as is, such as a color table.
+// It replaces the generated table with the same tag, if any.
+func (b *Builder) AddTable(tag loader.Tag, table []byte) {
+	if b.raw == nil {
+		b.raw = make(map[loader.Tag][]byte)
+	}
+	b.raw[tag] = table
+}
+
+// Face builds the font and parses it.
+func (b *Builder) Face() (*font.Face, error) {
+	ld, err := loader.NewLoader(bytes.NewReader(b.Build()))
+	if err != nil {
+		return nil, err
+	}
+	ft, err := font.NewFont(ld)
+	if err != nil {
+		return nil, err
+	}
+	return &font.Face{Font: ft}, nil
+}
+
+var (
+	tagHead = loader.MustNewTag("head")
+	tagHhea = loader.MustNewTag("hhea")
+	tagMaxp = loader.MustNewTag("maxp")
+	tagOS2  = loader.MustNewTag("OS/2")
+	tagHmtx = loader.MustNewTag("hmtx")
+	tagCmap = loader.MustNewTag("cmap")
+	tagLoca = loader.MustNewTag("loca")
+	tagGlyf = loader.MustNewTag("glyf")
+	tagName = loader.MustNewTag("name")
+	tagPost = loader.MustNewTag("post")
+	tagGSUB = loader.MustNewTag("GSUB")
+)
+
+// Build returns the content of the font file.
+func (b *Builder) Build() []byte {
+	upem := b.Upem
+	if upem == 0 {
+		upem = 1000
+	}
+	ascent, descent := b.Ascent, b.Descent
+	if ascent == 0 && descent == 0 {
+		ascent, descent = int16(upem*4/5), -int16(upem/5)
+	}
+	family := b.Family
+	if family == "" {
+		family = "Test"
+	}
+	glyphs := append([]Glyph{{Advance: upem / 2}}, b.glyphs...)
+
+	// outlines and metrics
+	var (
+		glyf, hmtx                []byte
+		loca                      = make([]byte, 4*(len(glyphs)+1))
+		fontBounds                bounds
+		maxPoints, maxContours    int
+		maxAdvance                uint16
+		advanceSum                int
+		minLsb, minRsb, maxExtent int16
+	)
+	fontBounds.init()
+	minLsb, minRsb = 0x7FFF, 0x7FFF
+	for i, g := range glyphs {
+		binary.BigEndian.PutUint32(loca[4*i:], uint32(len(glyf)))
+		var gb bounds
+		glyf, gb = appendGlyph(glyf, g.Contours)
+		hmtx = appendUint16(hmtx, g.Advance)
+		hmtx = appendUint16(hmtx, uint16(gb.xMin))
+
+		if g.Advance > maxAdvance {
+			maxAdvance = g.Advance
+		}
+		advanceSum += int(g.Advance)
+		if len(g.Contours) != 0 {
+			fontBounds.union(gb)
+			points := 0
+			for _, c := range g.Contours {
+				points += len(c)
+			}
+			maxPoints, maxContours = maxInt(maxPoints, points), maxInt(maxContours, len(g.Contours))
+			minLsb = minInt16(minLsb, gb.xMin)
+			minRsb = minInt16(minRsb, int16(g.Advance)-gb.xMax)
+			if gb.xMax > maxExtent {
+				maxExtent = gb.xMax
+			}
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*len(glyphs):], uint32(len(glyf)))
+	if fontBounds.xMin > fontBounds.xMax { // no outlines
+		fontBounds, minLsb, minRsb = bounds{}, 0, 0
+	}
+
+	// head
+	head := make([]byte, 54)
+	binary.BigEndian.PutUint32(head, 0x00010000)      // version
+	binary.BigEndian.PutUint32(head[4:], 0x00010000)  // fontRevision
+	binary.BigEndian.PutUint32(head[12:], 0x5F0F3CF5) // magicNumber
+	binary.BigEndian.PutUint16(head[16:], 0x000B)     // flags
+	binary.BigEndian.PutUint16(head[18:], upem)       // unitsPerEm
+	fontBounds.put(head[36:])                         // xMin, yMin, xMax, yMax
+	binary.BigEndian.PutUint16(head[46:], 8)          // lowestRecPPEM
+	binary.BigEndian.PutUint16(head[48:], 2)          // fontDirectionHint
+	binary.BigEndian.PutUint16(head[50:], 1)          // indexToLocFormat : long offsets
+
+	// hhea
+	hhea := make([]byte, 36)
+	binary.BigEndian.PutUint32(hhea, 0x00010000)
+	binary.BigEndian.PutUint16(hhea[4:], uint16(ascent))
+	binary.BigEndian.PutUint16(hhea[6:], uint16(descent))
+	binary.BigEndian.PutUint16(hhea[10:], maxAdvance)
+	binary.BigEndian.PutUint16(hhea[12:], uint16(minLsb))
+	binary.BigEndian.PutUint16(hhea[14:], uint16(minRsb))
+	binary.BigEndian.PutUint16(hhea[16:], uint16(maxExtent))
+	binary.BigEndian.PutUint16(hhea[18:], 1) // caretSlopeRise
+	binary.BigEndian.PutUint16(hhea[34:], uint16(len(glyphs)))
+
+	// maxp, version 1.0
+	maxp := make([]byte, 32)
+	binary.BigEndian.PutUint32(maxp, 0x00010000)
+	binary.BigEndian.PutUint16(maxp[4:], uint16(len(glyphs)))
+	binary.BigEndian.PutUint16(maxp[6:], uint16(maxPoints))
+	binary.BigEndian.PutUint16(maxp[8:], uint16(maxContours))
+	binary.BigEndian.PutUint16(maxp[14:], 2) // maxZones
+
+	// OS/2, version 4
+	runes := b.sortedRunes()
+	os2 := make([]byte, 96)
+	binary.BigEndian.PutUint16(os2, 4)
+	binary.BigEndian.PutUint16(os2[2:], uint16(advanceSum/len(glyphs))) // xAvgCharWidth
+	binary.BigEndian.PutUint16(os2[4:], 400)                            // usWeightClass
+	binary.BigEndian.PutUint16(os2[6:], 5)                              // usWidthClass
+	binary.BigEndian.PutUint16(os2[26:], upem/20)                       // yStrikeoutSize
+	binary.BigEndian.PutUint16(os2[28:], upem/4)                        // yStrikeoutPosition
+	copy(os2[58:], "NONE")                                              // achVendID
+	binary.BigEndian.PutUint16(os2[62:], 0x40|0x80)                     // fsSelection : REGULAR, USE_TYPO_METRICS
+	if len(runes) != 0 {
+		binary.BigEndian.PutUint16(os2[64:], uint16(minRune(runes[0], 0xFFFF)))
+		binary.BigEndian.PutUint16(os2[66:], uint16(minRune(runes[len(runes)-1], 0xFFFF)))
+	}
+	binary.BigEndian.PutUint16(os2[68:], uint16(ascent))
+	binary.BigEndian.PutUint16(os2[70:], uint16(descent))
+	binary.BigEndian.PutUint16(os2[74:], uint16(ascent))
+	binary.BigEndian.PutUint16(os2[76:], uint16(-descent))
+	binary.BigEndian.PutUint32(os2[78:], 1) // ulCodePageRange1 : Latin 1
+	binary.BigEndian.PutUint16(os2[94:], uint16(b.maxContext()))
+
+	// post, version 3 : no glyph names
+	post := make([]byte, 32)
+	binary.BigEndian.PutUint32(post, 0x00030000)
+	binary.BigEndian.PutUint16(post[8:], uint16(-int16(upem/10))) // underlinePosition
+	binary.BigEndian.PutUint16(post[10:], upem/20)                // underlineThickness
+
+	tables := map[loader.Tag][]byte{
+		tagHead: head,
+		tagHhea: hhea,
+		tagMaxp: maxp,
+		tagOS2:  os2,
+		tagHmtx: hmtx,
+		tagCmap: b.cmapTable(runes),
+		tagLoca: loca,
+		tagGlyf: glyf,
+		tagName: nameTable(family),
+		tagPost: post,
+	}
+	if len(b.ligatures) != 0 {
+		tables[tagGSUB] = b.gsubTable()
+	}
+	for tag, table := range b.raw {
+		tables[tag] = table
+	}
+
+	out := writeFont(tables)
+	// see the 'head' table specification
+	adjustment := 0xB1B0AFBA - checksum(out)
+	binary.BigEndian.PutUint32(out[tableOffset(out, tagHead)+8:], adjustment)
+	return out
+}
+
+// bounds is a bounding box in font units
+type bounds struct{ xMin, yMin, xMax, yMax int16 }
+
+func (b *bounds) init() {
+	*b = bounds{xMin: 0x7FFF, yMin: 0x7FFF, xMax: -0x8000, yMax: -0x8000}
+}
+
+func (b *bounds) add(p Point) {
+	b.xMin, b.xMax = minInt16(b.xMin, p.X), maxInt16(b.xMax, p.X)
+	b.yMin, b.yMax = minInt16(b.yMin, p.Y), maxInt16(b.yMax, p.Y)
+}
+
+func (b *bounds) union(o bounds) {
+	b.add(Point{o.xMin, o.yMin})
+	b.add(Point{o.xMax, o.yMax})
+}
+
+func (b bounds) put(dst []byte) {
+	binary.BigEndian.PutUint16(dst, uint16(b.xMin))
+	binary.BigEndian.PutUint16(dst[2:], uint16(b.yMin))
+	binary.BigEndian.PutUint16(dst[4:], uint16(b.xMax))
+	binary.BigEndian.PutUint16(dst[6:], uint16(b.yMax))
+}
+
+// appendGlyph appends the 'glyf' data of a simple glyph, padded to 4 bytes,
+// and returns its bounds. Blank glyphs have no data.
+func appendGlyph(dst []byte, contours [][]Point) ([]byte, bounds) {
+	var (
+		gb        bounds
+		numPoints int
+	)
+	gb.init()
+	for _, c := range contours {
+		for _, p := range c {
+			gb.add(p)
+		}
+		numPoints += len(c)
+	}
+	if numPoints == 0 {
+		return dst, bounds{}
+	}
+
+	var header [10]byte
+	binary.BigEndian.PutUint16(header[:], uint16(len(contours)))
+	gb.put(header[2:])
+	dst = append(dst, header[:]...)
+	end := -1
+	for _, c := range contours {
+		end += len(c)
+		dst = appendUint16(dst, uint16(end)) // endPtsOfContours
+	}
+	dst = appendUint16(dst, 0) // instructionLength
+	for i := 0; i < numPoints; i++ {
+		dst = append(dst, 0x01) // ON_CURVE_POINT, with 16 bits coordinates
+	}
+	// coordinates are stored as deltas
+	var prev Point
+	for _, c := range contours {
+		for _, p := range c {
+			dst = appendUint16(dst, uint16(p.X-prev.X))
+			prev.X = p.X
+		}
+	}
+	prev = Point{}
+	for _, c := range contours {
+		for _, p := range c {
+			dst = appendUint16(dst, uint16(p.Y-prev.Y))
+			prev.Y = p.Y
+		}
+	}
+	for len(dst)%4 != 0 {
+		dst = append(dst, 0)
+	}
+	return dst, gb
+}
+
+func (b *Builder) sortedRunes() []rune {
+	runes := make([]rune, 0, len(b.cmap))
+	for r := range b.cmap {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes
+}
+
+// cmapTable returns a 'cmap' table with one (Windows, Unicode full repertoire)
+// subtable in format 12, grouping the consecutive runes mapped to consecutive glyphs.
+func (b *Builder) cmapTable(runes []rune) []byte {
+	type group struct {
+		start, end rune
+		gid        api.GID
+	}
+	var groups []group
+	for _, r := range runes {
+		gid := b.cmap[r]
+		if n := len(groups); n != 0 && groups[n-1].end+1 == r &&
+			groups[n-1].gid+api.GID(r-groups[n-1].start) == gid {
+			groups[n-1].end = r
+			continue
+		}
+		groups = append(groups, group{r, r, gid})
+	}
+
+	out := []byte{
+		0, 0, 0, 1, // version, numTables
+		0, 3, 0, 10, 0, 0, 0, 12, // platformID, encodingID, offset
+		0, 12, 0, 0, // format, reserved
+	}
+	out = appendUint32(out, uint32(16+12*len(groups))) // length
+	out = appendUint32(out, 0)                         // language
+	out = appendUint32(out, uint32(len(groups)))
+	for _, g := range groups {
+		out = appendUint32(out, uint32(g.start))
+		out = appendUint32(out, uint32(g.end))
+		out = appendUint32(out, uint32(g.gid))
+	}
+	return out
+}
+
+// nameTable returns a 'name' table, in format 0, with Windows English records.
+func nameTable(family string) []byte {
+	names := []struct {
+		id    uint16
+		value string
+	}{
+		{1, family},                 // family
+		{2, "Regular"},              // subfamily
+		{4, family + " Regular"},    // full name
+		{6, postscriptName(family)}, // PostScript name
+	}
+	var (
+		records []byte
+		storage []byte
+	)
+	for _, name := range names {
+		value := utf16.Encode([]rune(name.value))
+		records = appendUint16(records, 3)     // platformID
+		records = appendUint16(records, 1)     // encodingID
+		records = appendUint16(records, 0x409) // languageID
+		records = appendUint16(records, name.id)
+		records = appendUint16(records, uint16(2*len(value)))
+		records = appendUint16(records, uint16(len(storage)))
+		for _, u := range value {
+			storage = appendUint16(storage, u)
+		}
+	}
+	out := appendUint16(nil, 0) // format
+	out = appendUint16(out, uint16(len(names)))
+	out = appendUint16(out, uint16(6+len(records))) // storageOffset
+	out = append(out, records...)
+	return append(out, storage...)
+}
+
+// postscriptName keeps the printable ASCII characters of [family],
+// except the ones forbidden in PostScript names.
+func postscriptName(family string) string {
+	var out []byte
+	for _, r := range family {
+		if r > 32 && r < 127 && !bytes.ContainsRune([]byte("[](){}<>/%"), r) {
+			out = append(out, byte(r))
+		}
+	}
+	return string(out) + "-Regular"
+}
+
+// maxContext returns the maximum number of glyphs
+// in the ligature rules.
+func (b *Builder) maxContext() int {
+	out := 0
+	for _, lig := range b.ligatures {
+		out = maxInt(out, len(lig.components))
+	}
+	return out
+}
+
+// gsubTable returns a 'GSUB' table with one ligature substitution lookup,
+// activated by the 'liga' feature of the default script.
+func (b *Builder) gsubTable() []byte {
+	// group the ligatures by first component, preferring the longest rules
+	sets := make(map[api.GID][]ligature)
+	var firsts []api.GID
+	for _, lig := range b.ligatures {
+		first := lig.components[0]
+		if _, has := sets[first]; !has {
+			firsts = append(firsts, first)
+		}
+		sets[first] = append(sets[first], lig)
+	}
+	sort.Slice(firsts, func(i, j int) bool { return firsts[i] < firsts[j] })
+
+	// LigatureSubstFormat1 subtable, with its coverage and ligature sets
+	subtableHeader := 6 + 2*len(firsts)
+	coverage := appendUint16(nil, 1) // format
+	coverage = appendUint16(coverage, uint16(len(firsts)))
+	for _, gid := range firsts {
+		coverage = appendUint16(coverage, uint16(gid))
+	}
+	var setsData, setOffsets []byte
+	offset := subtableHeader + len(coverage)
+	for _, first := range firsts {
+		set := sets[first]
+		sort.SliceStable(set, func(i, j int) bool { return len(set[i].components) > len(set[j].components) })
+		setOffsets = appendUint16(setOffsets, uint16(offset+len(setsData)))
+
+		setData := appendUint16(nil, uint16(len(set)))
+		ligOffset := 2 + 2*len(set)
+		var ligData []byte
+		for _, lig := range set {
+			setData = appendUint16(setData, uint16(ligOffset+len(ligData)))
+			ligData = appendUint16(ligData, uint16(lig.glyph))
+			ligData = appendUint16(ligData, uint16(len(lig.components)))
+			for _, gid := range lig.components[1:] {
+				ligData = appendUint16(ligData, uint16(gid))
+			}
+		}
+		setsData = append(setsData, setData...)
+		setsData = append(setsData, ligData...)
+	}
+	subtable := appendUint16(nil, 1)                          // substFormat
+	subtable = appendUint16(subtable, uint16(subtableHeader)) // coverageOffset
+	subtable = appendUint16(subtable, uint16(len(firsts)))
+	subtable = append(subtable, setOffsets...)
+	subtable = append(subtable, coverage...)
+	subtable = append(subtable, setsData...)
+
+	var (
+		scriptList = []byte{
+			0, 1, 'D', 'F', 'L', 'T', 0, 8, // scriptCount, scriptRecord
+			0, 4, 0, 0, // Script : defaultLangSysOffset, langSysCount
+			0, 0, 0xFF, 0xFF, 0, 1, 0, 0, // LangSys : lookupOrderOffset, requiredFeatureIndex, featureIndices
+		}
+		featureList = []byte{
+			0, 1, 'l', 'i', 'g', 'a', 0, 8, // featureCount, featureRecord
+			0, 0, 0, 1, 0, 0, // Feature : featureParamsOffset, lookupIndices
+		}
+		lookupList = []byte{
+			0, 1, 0, 4, // lookupCount, lookupOffsets
+			0, 4, 0, 0, 0, 1, 0, 8, // Lookup : type 4, lookupFlag, subtableOffsets
+		}
+	)
+	out := []byte{0, 1, 0, 0} // version 1.0
+	out = appendUint16(out, 10)
+	out = appendUint16(out, uint16(10+len(scriptList)))
+	out = appendUint16(out, uint16(10+len(scriptList)+len(featureList)))
+	out = append(out, scriptList...)
+	out = append(out, featureList...)
+	out = append(out, lookupList...)
+	return append(out, subtable...)
+}
+
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// writeFont serializes the given tables in an Opentype file,
+// with the TrueType outlines signature.
+func writeFont(tables map[loader.Tag][]byte) []byte {
+	tags := make([]loader.Tag, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+
+	numTables := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= numTables {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	headerSize := 12 + 16*numTables
+	out := make([]byte, headerSize)
+	binary.BigEndian.PutUint32(out, 0x00010000)
+	binary.BigEndian.PutUint16(out[4:], uint16(numTables))
+	binary.BigEndian.PutUint16(out[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(out[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(out[10:], uint16(16*numTables-searchRange))
+	for i, tag := range tags {
+		table := tables[tag]
+		record := out[12+16*i:]
+		binary.BigEndian.PutUint32(record, uint32(tag))
+		binary.BigEndian.PutUint32(record[4:], checksum(table))
+		binary.BigEndian.PutUint32(record[8:], uint32(len(out)))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(table)))
+		out = append(out, table...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+	return out
+}
+
+// tableOffset returns the offset of the given table, written by [writeFont]
+func tableOffset(font []byte, tag loader.Tag) int {
+	numTables := int(binary.BigEndian.Uint16(font[4:]))
+	for i := 0; i < numTables; i++ {
+		record := font[12+16*i:]
+		if loader.Tag(binary.BigEndian.Uint32(record)) == tag {
+			return int(binary.BigEndian.Uint32(record[8:]))
+		}
+	}
+	return -1
+}
+
+func appendUint16(dst []byte, v uint16) []byte { return append(dst, byte(v>>8), byte(v)) }
+
+func appendUint32(dst []byte, v uint32) []byte {
+	return append(dst, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func minInt16(a, b int16) int16 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt16(a, b int16) int16 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minRune(a, b rune) rune {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package fontbuilder
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/opentype/api/font"
+	"github.com/go-text/typesetting/opentype/api/metadata"
+	"github.com/go-text/typesetting/opentype/loader"
+	tu "github.com/go-text/typesetting/opentype/testutils"
+	"github.com/go-text/typesetting/shaping"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestBuild(t *testing.T) {
+	b := NewBuilder()
+	b.Family = "Tiny Test"
+	gA := b.AddRune('a', Glyph{Advance: 500, Contours: [][]Point{Box(50, 0, 450, 500)}})
+	gB := b.AddRune('b', Glyph{Advance: 600, Contours: [][]Point{Box(50, 0, 550, 700), Box(150, 100, 450, 600)}})
+	space := b.AddRune(' ', Glyph{Advance: 250})
+	b.Map('A', gA)
+	b.Map(0x1F600, gB)
+
+	face, err := b.Face()
+	tu.AssertNoErr(t, err)
+
+	for r, expected := range map[rune]api.GID{'a': gA, 'b': gB, ' ': space, 'A': gA, 0x1F600: gB} {
+		gid, ok := face.NominalGlyph(r)
+		tu.AssertC(t, ok && gid == expected, string(r))
+	}
+	_, ok := face.NominalGlyph('c')
+	tu.Assert(t, !ok)
+
+	tu.Assert(t, face.Upem() == 1000)
+	tu.Assert(t, face.HorizontalAdvance(0) == 500)
+	tu.Assert(t, face.HorizontalAdvance(gA) == 500)
+	tu.Assert(t, face.HorizontalAdvance(gB) == 600)
+	tu.Assert(t, face.HorizontalAdvance(space) == 250)
+	extents, ok := face.FontHExtents()
+	tu.Assert(t, ok && extents.Ascender == 800 && extents.Descender == -200)
+
+	ge, ok := face.GlyphExtents(gB)
+	tu.Assert(t, ok && ge.XBearing == 50 && ge.YBearing == 700 && ge.Width == 500 && ge.Height == -700)
+
+	outline, ok := face.GlyphData(gB).(api.GlyphOutline)
+	tu.Assert(t, ok)
+	tu.Assert(t, len(outline.Segments) == 10)
+	tu.Assert(t, outline.Segments[0].Op == api.SegmentOpMoveTo && outline.Segments[0].Args[0] == api.SegmentPoint{X: 50, Y: 0})
+	tu.Assert(t, outline.Segments[5].Op == api.SegmentOpMoveTo && outline.Segments[5].Args[0] == api.SegmentPoint{X: 150, Y: 100})
+
+	ld, err := loader.NewLoader(bytes.NewReader(b.Build()))
+	tu.AssertNoErr(t, err)
+	desc := metadata.Metadata(ld)
+	tu.Assert(t, desc.Family == "Tiny Test")
+}
+
+func TestLigature(t *testing.T) {
+	b := NewBuilder()
+	f := b.AddRune('f', Glyph{Advance: 300, Contours: [][]Point{Box(50, 0, 250, 700)}})
+	i := b.AddRune('i', Glyph{Advance: 200, Contours: [][]Point{Box(50, 0, 150, 500)}})
+	fi := b.AddGlyph(Glyph{Advance: 450, Contours: [][]Point{Box(50, 0, 400, 700)}})
+	ffi := b.AddGlyph(Glyph{Advance: 700, Contours: [][]Point{Box(50, 0, 650, 700)}})
+	b.AddLigature(fi, f, i)
+	b.AddLigature(ffi, f, f, i)
+
+	face, err := b.Face()
+	tu.AssertNoErr(t, err)
+
+	text := []rune("fiffif")
+	var shaper shaping.HarfbuzzShaper
+	out := shaper.Shape(shaping.Input{
+		Text: text, RunEnd: len(text),
+		Direction: di.DirectionLTR,
+		Face:      face,
+		Size:      fixed.I(1000),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	})
+	var gids []api.GID
+	for _, g := range out.Glyphs {
+		gids = append(gids, g.GlyphID)
+	}
+	tu.Assert(t, len(gids) == 3 && gids[0] == fi && gids[1] == ffi && gids[2] == f)
+	tu.Assert(t, out.Advance == fixed.I(450+700+300))
+}
+
+func TestAddTable(t *testing.T) {
+	b := NewBuilder()
+	square := b.AddGlyph(Glyph{Advance: 500, Contours: [][]Point{Box(0, 0, 500, 500)}})
+	dot := b.AddGlyph(Glyph{Advance: 500, Contours: [][]Point{Box(200, 200, 300, 300)}})
+	smiley := b.AddRune(0x263A, Glyph{Advance: 500})
+	// a red square with a dot using the text color
+	b.AddTable(loader.MustNewTag("COLR"), []byte{
+		0, 0, 0, 1, 0, 0, 0, 14, 0, 0, 0, 20, 0, 2, // version 0 header
+		0, byte(smiley), 0, 0, 0, 2, // base glyph
+		0, byte(square), 0, 0, 0, byte(dot), 0xFF, 0xFF, // layers
+	})
+	b.AddTable(loader.MustNewTag("CPAL"), []byte{
+		0, 0, 0, 1, 0, 1, 0, 1, 0, 0, 0, 14, 0, 0, // header
+		0, 0, 0xFF, 0xFF, // red, as BGRA
+	})
+
+	ld, err := loader.NewLoader(bytes.NewReader(b.Build()))
+	tu.AssertNoErr(t, err)
+	ft, errs := font.NewFontBestEffort(ld)
+	tu.Assert(t, len(errs) == 0)
+	face := &font.Face{Font: ft}
+
+	layers := face.GlyphLayers(smiley)
+	tu.Assert(t, len(layers) == 2)
+	tu.Assert(t, reflect.DeepEqual(layers[0].Data, face.GlyphData(square)))
+	tu.Assert(t, layers[0].Paint == api.PaintSolid{Color: [4]uint8{0xFF, 0, 0, 0xFF}})
+	tu.Assert(t, reflect.DeepEqual(layers[1].Data, face.GlyphData(dot)))
+	tu.Assert(t, layers[1].Paint == api.PaintSolid{Foreground: true, Color: [4]uint8{0, 0, 0, 0xFF}})
+	outline, _ := layers[1].Data.(api.GlyphOutline)
+	tu.Assert(t, len(outline.Segments) == 5)
+}
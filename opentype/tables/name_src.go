@@ -129,6 +129,32 @@ func (names Name) Name(name NameID) string {
 	return ""
 }
 
+// LocalizedName is one entry of the 'name' table, decoded as UTF-8 when possible.
+// Language identifiers are platform specific: they are LCID values for [PlatformMicrosoft],
+// and Macintosh language codes for [PlatformMac].
+type LocalizedName struct {
+	Value    string
+	Platform PlatformID
+	Language LanguageID
+}
+
+// LocalizedNames returns all the entries at [name], for every platform and language
+// provided by the font, in the order they are stored.
+func (names Name) LocalizedNames(name NameID) []LocalizedName {
+	var out []LocalizedName
+	for _, rec := range names.nameRecords {
+		if rec.nameID != name || rec.length == 0 {
+			continue
+		}
+		out = append(out, LocalizedName{
+			Value:    names.decodeRecord(rec),
+			Platform: rec.platformID,
+			Language: rec.languageID,
+		})
+	}
+	return out
+}
+
 // decode is a best-effort attempt to get an UTF-8 encoded version of
 // Value. Only MicrosoftUnicode (3,1 ,X), MacRomain (1,0,X) and Unicode platform
 // strings are supported.
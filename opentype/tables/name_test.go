@@ -95,6 +95,13 @@ func TestFamilyNames(t *testing.T) {
 	tu.AssertNoErr(t, err)
 	// NameFontFamily
 	tu.Assert(t, names.Name(1) == "Roboto")
+
+	localized := names.LocalizedNames(1)
+	tu.Assert(t, len(localized) != 0)
+	for _, name := range localized {
+		tu.Assert(t, name.Value == "Roboto")
+	}
+	tu.Assert(t, len(names.LocalizedNames(0xFFFF)) == 0)
 }
 
 func TestNames(t *testing.T) {
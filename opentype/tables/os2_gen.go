@@ -28,7 +28,7 @@ func ParseOs2(src []byte) (Os2, int, error) {
 	item.YSuperscriptXSize = int16(binary.BigEndian.Uint16(src[18:]))
 	item.YSuperscriptYSize = int16(binary.BigEndian.Uint16(src[20:]))
 	item.YSuperscriptXOffset = int16(binary.BigEndian.Uint16(src[22:]))
-	item.ySuperscriptYOffset = int16(binary.BigEndian.Uint16(src[24:]))
+	item.YSuperscriptYOffset = int16(binary.BigEndian.Uint16(src[24:]))
 	item.YStrikeoutSize = int16(binary.BigEndian.Uint16(src[26:]))
 	item.YStrikeoutPosition = int16(binary.BigEndian.Uint16(src[28:]))
 	item.sFamilyClass = int16(binary.BigEndian.Uint16(src[30:]))
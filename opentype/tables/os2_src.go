@@ -17,7 +17,7 @@ type Os2 struct {
 	YSuperscriptXSize   int16
 	YSuperscriptYSize   int16
 	YSuperscriptXOffset int16
-	ySuperscriptYOffset int16
+	YSuperscriptYOffset int16
 	YStrikeoutSize      int16
 	YStrikeoutPosition  int16
 	sFamilyClass        int16
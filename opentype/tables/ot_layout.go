@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"math/bits"
+
+	"github.com/go-text/typesetting/opentype/loader"
 )
 
 // The following are types shared by GSUB and GPOS tables
@@ -812,3 +814,152 @@ func ParseUint16s(src []byte, count int) ([]uint16, error) {
 	}
 	return out, nil
 }
+
+// ------------------------ feature parameters ------------------------
+
+// FeatureParams is the optional data attached to some features.
+// Its type depends on the feature tag : it is either a [FeatureParamsSize] ('size'),
+// a [FeatureParamsStylisticSet] ('ss01' to 'ss20') or a
+// [FeatureParamsCharacterVariants] ('cv01' to 'cv99').
+//
+// See https://learn.microsoft.com/typography/opentype/spec/chapter2#featureparams
+type FeatureParams interface {
+	isFeatureParams()
+}
+
+func (FeatureParamsSize) isFeatureParams()              {}
+func (FeatureParamsStylisticSet) isFeatureParams()      {}
+func (FeatureParamsCharacterVariants) isFeatureParams() {}
+
+// FeatureParamsSize is the data of the 'size' feature.
+type FeatureParamsSize struct {
+	DesignSize      uint16 // The design size in 720/inch units (decipoints).
+	SubfamilyID     uint16 // Identifies the font as a member of a family of fonts differing only in optical size, or 0.
+	SubfamilyNameID NameID // The 'name' table entry of the subfamily, used in font menus.
+	RangeStart      uint16 // Small end of the recommended usage range (exclusive), in decipoints.
+	RangeEnd        uint16 // Large end of the recommended usage range (inclusive), in decipoints.
+}
+
+// FeatureParamsStylisticSet is the data of the 'ssXX' features.
+type FeatureParamsStylisticSet struct {
+	Version  uint16
+	UINameID NameID // The 'name' table entry of the user-interface string for this feature, or 0xFFFF
+}
+
+// FeatureParamsCharacterVariants is the data of the 'cvXX' features.
+type FeatureParamsCharacterVariants struct {
+	Format                  uint16
+	FeatUILabelNameID       NameID // The 'name' table entry of the user-interface label for this feature, or 0.
+	FeatUITooltipTextNameID NameID // The 'name' table entry of the tooltip text for this feature, or 0.
+	SampleTextNameID        NameID // The 'name' table entry of a sample text illustrating this feature, or 0.
+	NumNamedParameters      uint16 // The number of named parameters, whose labels are consecutive 'name' entries.
+	FirstParamUILabelNameID NameID // The first 'name' table entry of the labels of the named parameters, or 0.
+	Characters              []rune // The Unicode code points for which this feature provides glyph variants.
+}
+
+// FeatureParams returns the parameters of the features of [lt], with the same
+// length as lt.FeatureList.Features, with nil entries for the features without parameters.
+// [src] is the table [lt] has been parsed from, either GSUB or GPOS.
+// Invalid parameters are ignored.
+func (lt *Layout) FeatureParams(src []byte) []FeatureParams {
+	out := make([]FeatureParams, len(lt.FeatureList.Features))
+	if len(src) < 8 {
+		return out
+	}
+	featureList := int(binary.BigEndian.Uint16(src[6:]))
+	for i, feature := range lt.FeatureList.Features {
+		if feature.featureParamsOffset == 0 || i >= len(lt.FeatureList.Records) {
+			continue
+		}
+		record := lt.FeatureList.Records[i]
+		offset := featureList + int(record.Offset) + int(feature.featureParamsOffset)
+		params, err := parseFeatureParams(record.Tag, src, offset)
+		if record.Tag == tagSize && (err != nil || !params.(FeatureParamsSize).isValid()) {
+			// some old fonts use an offset from the start of the FeatureList
+			params, err = parseFeatureParams(record.Tag, src, featureList+int(feature.featureParamsOffset))
+			if err != nil || !params.(FeatureParamsSize).isValid() {
+				continue
+			}
+		}
+		if err != nil {
+			continue
+		}
+		out[i] = params
+	}
+	return out
+}
+
+var tagSize = loader.MustNewTag("size")
+
+// isValid applies the heuristic used by harfbuzz to detect
+// 'size' parameters stored with a wrong offset.
+func (fp FeatureParamsSize) isValid() bool {
+	if fp.DesignSize == 0 {
+		return false
+	}
+	if fp.SubfamilyID == 0 && fp.SubfamilyNameID == 0 && fp.RangeStart == 0 && fp.RangeEnd == 0 {
+		return true
+	}
+	return fp.DesignSize >= fp.RangeStart && fp.DesignSize <= fp.RangeEnd &&
+		fp.SubfamilyNameID >= 256 && fp.SubfamilyNameID <= 32767
+}
+
+// parseFeatureParams parses the parameters at [offset] in [src],
+// according to the feature [tag]. It returns nil for unsupported features.
+func parseFeatureParams(tag Tag, src []byte, offset int) (FeatureParams, error) {
+	if L := len(src); offset > L {
+		return nil, fmt.Errorf("reading FeatureParams: EOF: expected length: %d, got %d", offset, L)
+	}
+	src = src[offset:]
+	switch {
+	case tag == tagSize:
+		if L := len(src); L < 10 {
+			return nil, fmt.Errorf("reading FeatureParamsSize: EOF: expected length: 10, got %d", L)
+		}
+		return FeatureParamsSize{
+			DesignSize:      binary.BigEndian.Uint16(src),
+			SubfamilyID:     binary.BigEndian.Uint16(src[2:]),
+			SubfamilyNameID: NameID(binary.BigEndian.Uint16(src[4:])),
+			RangeStart:      binary.BigEndian.Uint16(src[6:]),
+			RangeEnd:        binary.BigEndian.Uint16(src[8:]),
+		}, nil
+	case isTagWithNumber(tag, 's', 's'):
+		if L := len(src); L < 4 {
+			return nil, fmt.Errorf("reading FeatureParamsStylisticSet: EOF: expected length: 4, got %d", L)
+		}
+		return FeatureParamsStylisticSet{
+			Version:  binary.BigEndian.Uint16(src),
+			UINameID: NameID(binary.BigEndian.Uint16(src[2:])),
+		}, nil
+	case isTagWithNumber(tag, 'c', 'v'):
+		if L := len(src); L < 14 {
+			return nil, fmt.Errorf("reading FeatureParamsCharacterVariants: EOF: expected length: 14, got %d", L)
+		}
+		out := FeatureParamsCharacterVariants{
+			Format:                  binary.BigEndian.Uint16(src),
+			FeatUILabelNameID:       NameID(binary.BigEndian.Uint16(src[2:])),
+			FeatUITooltipTextNameID: NameID(binary.BigEndian.Uint16(src[4:])),
+			SampleTextNameID:        NameID(binary.BigEndian.Uint16(src[6:])),
+			NumNamedParameters:      binary.BigEndian.Uint16(src[8:]),
+			FirstParamUILabelNameID: NameID(binary.BigEndian.Uint16(src[10:])),
+		}
+		count := int(binary.BigEndian.Uint16(src[12:]))
+		if L := len(src); L < 14+3*count {
+			return nil, fmt.Errorf("reading FeatureParamsCharacterVariants: EOF: expected length: %d, got %d", 14+3*count, L)
+		}
+		out.Characters = make([]rune, count)
+		for i := range out.Characters {
+			b := src[14+3*i:]
+			out.Characters[i] = rune(b[0])<<16 | rune(b[1])<<8 | rune(b[2])
+		}
+		return out, nil
+	}
+	return nil, nil
+}
+
+// isTagWithNumber returns true for tags made of the two given letters,
+// followed by two digits, like 'ss01' or 'cv42'
+func isTagWithNumber(tag Tag, a, b byte) bool {
+	c0, c1, c2, c3 := byte(tag>>24), byte(tag>>16), byte(tag>>8), byte(tag)
+	return c0 == a && c1 == b && '0' <= c2 && c2 <= '9' && '0' <= c3 && c3 <= '9'
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+// Package outline implements geometric operations on glyph outlines,
+// like stroking, working on their flattened contours.
+package outline
+
+import (
+	"math"
+
+	"github.com/go-text/typesetting/opentype/api"
+)
+
+// DefaultTolerance is the flattening tolerance, in font units,
+// used when a non positive tolerance is given.
+const DefaultTolerance = 1
+
+// Contour is a closed polygon : its last point is implicitly
+// connected to its first point.
+type Contour []api.SegmentPoint
+
+// Area returns the signed area of the contour, which is positive
+// for counter-clockwise contours, since the Y axis of outlines increases up.
+func (c Contour) Area() float32 {
+	var area float32
+	for i, p := range c {
+		q := c[(i+1)%len(c)]
+		area += p.X*q.Y - q.X*p.Y
+	}
+	return area / 2
+}
+
+// Flatten approximates the curves of [outline] by line segments, so that
+// the distance between the curves and the segments is at most [tolerance]
+// (expressed in font units), returning one contour for each MoveTo operation.
+// Consecutive duplicate points are removed, as the closing point
+// if it repeats the first one.
+func Flatten(outline api.GlyphOutline, tolerance float32) []Contour {
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	var (
+		out     []Contour
+		current Contour
+	)
+	closeContour := func() {
+		if n := len(current); n > 1 && current[n-1] == current[0] {
+			current = current[:n-1]
+		}
+		if len(current) != 0 {
+			out = append(out, current)
+		}
+		current = nil
+	}
+	lineTo := func(p api.SegmentPoint) {
+		if len(current) == 0 || current[len(current)-1] != p {
+			current = append(current, p)
+		}
+	}
+	for _, seg := range outline.Segments {
+		switch seg.Op {
+		case api.SegmentOpMoveTo:
+			closeContour()
+			current = Contour{seg.Args[0]}
+		case api.SegmentOpLineTo:
+			lineTo(seg.Args[0])
+		case api.SegmentOpQuadTo:
+			if len(current) == 0 {
+				continue
+			}
+			p0, p1, p2 := current[len(current)-1], seg.Args[0], seg.Args[1]
+			// the deviation from a line is bounded by |p0 - 2p1 + p2| / 4
+			dev := length(p0.X-2*p1.X+p2.X, p0.Y-2*p1.Y+p2.Y) / 4
+			n := 1 + int(math.Sqrt(float64(dev/tolerance)))
+			for i := 1; i < n; i++ {
+				t := float32(i) / float32(n)
+				lineTo(lerp(t, lerp(t, p0, p1), lerp(t, p1, p2)))
+			}
+			lineTo(p2)
+		case api.SegmentOpCubeTo:
+			if len(current) == 0 {
+				continue
+			}
+			p0, p1, p2, p3 := current[len(current)-1], seg.Args[0], seg.Args[1], seg.Args[2]
+			// the deviation from a line is bounded by 3/4 of the maximum second difference
+			dev := 0.75 * max32(
+				length(p0.X-2*p1.X+p2.X, p0.Y-2*p1.Y+p2.Y),
+				length(p1.X-2*p2.X+p3.X, p1.Y-2*p2.Y+p3.Y),
+			)
+			n := 1 + int(math.Sqrt(float64(dev/tolerance)))
+			for i := 1; i < n; i++ {
+				t := float32(i) / float32(n)
+				q0, q1, q2 := lerp(t, p0, p1), lerp(t, p1, p2), lerp(t, p2, p3)
+				lineTo(lerp(t, lerp(t, q0, q1), lerp(t, q1, q2)))
+			}
+			lineTo(p3)
+		}
+	}
+	closeContour()
+	return out
+}
+
+// FromContours returns the outline made of [contours],
+// using line segments.
+func FromContours(contours []Contour) api.GlyphOutline {
+	var out api.GlyphOutline
+	for _, c := range contours {
+		if len(c) == 0 {
+			continue
+		}
+		out.Segments = append(out.Segments, api.Segment{Op: api.SegmentOpMoveTo, Args: [3]api.SegmentPoint{c[0]}})
+		for _, p := range c[1:] {
+			out.Segments = append(out.Segments, api.Segment{Op: api.SegmentOpLineTo, Args: [3]api.SegmentPoint{p}})
+		}
+	}
+	return out
+}
+
+func lerp(t float32, p0, p1 api.SegmentPoint) api.SegmentPoint {
+	return api.SegmentPoint{X: p0.X + t*(p1.X-p0.X), Y: p0.Y + t*(p1.Y-p0.Y)}
+}
+
+func length(dx, dy float32) float32 {
+	return float32(math.Sqrt(float64(dx*dx + dy*dy)))
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package outline
+
+import (
+	"math"
+
+	"github.com/go-text/typesetting/opentype/api"
+)
+
+// Join specifies the shape of the stroke at the corners of the contours.
+type Join uint8
+
+const (
+	// JoinMiter extends the edges until they meet, or uses
+	// [JoinBevel] if the miter is longer than the miter limit.
+	JoinMiter Join = iota
+	// JoinRound uses a circular arc.
+	JoinRound
+	// JoinBevel connects the edges with a straight line.
+	JoinBevel
+)
+
+// Cap specifies the shape of the stroke at the ends of open contours.
+type Cap uint8
+
+const (
+	// CapButt ends the stroke at the end point.
+	CapButt Cap = iota
+	// CapRound adds a half circle.
+	CapRound
+	// CapSquare adds a half square.
+	CapSquare
+)
+
+// StrokeOptions configures [Stroke].
+type StrokeOptions struct {
+	// Width is the width of the stroke, in font units,
+	// centered on the contours.
+	Width float32
+	Join  Join
+	Cap   Cap
+	// MiterLimit is the maximum ratio between the miter length
+	// and the stroke width. If zero, 4 is used (as in SVG).
+	MiterLimit float32
+	// Tolerance is the flattening tolerance, in font units.
+	// If zero, [DefaultTolerance] is used.
+	Tolerance float32
+	// OpenContours, if true, does not connect the last point of each
+	// contour to its first point, and applies [Cap] at both ends.
+	// By default, contours are closed, as in glyph outlines.
+	OpenContours bool
+}
+
+// Stroke returns the outline of the stroke of [outline], made of line
+// segments, which should be filled with the non-zero winding rule.
+// All the contours of the stroke are counter-clockwise (in the Y up
+// coordinate system of outlines) or holes inside them, so that overlapping
+// strokes do not cancel each other.
+func Stroke(outline api.GlyphOutline, opts StrokeOptions) api.GlyphOutline {
+	return FromContours(strokeContours(Flatten(outline, opts.Tolerance), opts, 1))
+}
+
+// Embolden returns [outline], made bolder by [strength] font units
+// on each side of its contours, which is useful to synthesize bold faces.
+// The result is made of line segments, and should be filled
+// with the non-zero winding rule.
+func Embolden(outline api.GlyphOutline, strength float32) api.GlyphOutline {
+	contours := Flatten(outline, DefaultTolerance)
+	// the strokes must have the same orientation as the outer
+	// contours, so that they add to the glyph fill
+	var (
+		sign    float32 = 1
+		maxArea float32
+	)
+	for _, c := range contours {
+		if area := c.Area(); abs32(area) > maxArea {
+			maxArea = abs32(area)
+			sign = 1
+			if area < 0 {
+				sign = -1
+			}
+		}
+	}
+	strokes := strokeContours(contours, StrokeOptions{Width: 2 * strength, Join: JoinMiter}, sign)
+	return FromContours(append(contours, strokes...))
+}
+
+func abs32(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+type vector = api.SegmentPoint
+
+// stroker builds the offset polylines of a contour
+type stroker struct {
+	opts     StrokeOptions
+	halfW    float32
+	miterMax float32 // squared miter limit
+}
+
+// strokeContours returns the stroke of [contours], whose
+// filled region has the orientation [sign] (+1 for counter-clockwise).
+func strokeContours(contours []Contour, opts StrokeOptions, sign float32) []Contour {
+	if opts.Width <= 0 {
+		return nil
+	}
+	if opts.MiterLimit == 0 {
+		opts.MiterLimit = 4
+	}
+	if opts.Tolerance <= 0 {
+		opts.Tolerance = DefaultTolerance
+	}
+	st := stroker{opts: opts, halfW: opts.Width / 2, miterMax: opts.MiterLimit * opts.MiterLimit}
+	var out []Contour
+	for _, c := range contours {
+		if opts.OpenContours {
+			out = append(out, st.strokeOpen(c, sign)...)
+		} else {
+			out = append(out, st.strokeClosed(c, sign)...)
+		}
+	}
+	return out
+}
+
+// normal returns the unit left normal of the segment [p0, p1]
+func normal(p0, p1 vector) vector {
+	dx, dy := p1.X-p0.X, p1.Y-p0.Y
+	l := length(dx, dy)
+	return vector{X: -dy / l, Y: dx / l}
+}
+
+func offset(p vector, n vector, d float32) vector {
+	return vector{X: p.X + n.X*d, Y: p.Y + n.Y*d}
+}
+
+// join appends to [dst] the points of the offset line (at distance [d], signed)
+// around the vertex [p], between the edges with normals [n0] and [n1].
+func (st *stroker) join(dst Contour, p, n0, n1 vector, d float32) Contour {
+	cross := n0.X*n1.Y - n0.Y*n1.X // same sign as the turn
+	if dot := n0.X*n1.X + n0.Y*n1.Y; abs32(cross) < 1e-6 && dot > 0 {
+		// no turn
+		return append(dst, offset(p, n0, d))
+	}
+	if cross*d >= 0 {
+		// inner side of the turn : go through the vertex, so that
+		// the winding stays consistent
+		return append(dst, offset(p, n0, d), p, offset(p, n1, d))
+	}
+	switch st.opts.Join {
+	case JoinRound:
+		return st.arc(dst, p, n0, n1, d)
+	case JoinMiter:
+		dot := n0.X*n1.X + n0.Y*n1.Y
+		// the miter length ratio is 1 / cos(theta / 2), with cos²(theta / 2) = (1 + dot) / 2
+		if 1+dot > 0 && 2/(1+dot) <= st.miterMax {
+			k := d / (1 + dot)
+			return append(dst, vector{X: p.X + (n0.X+n1.X)*k, Y: p.Y + (n0.Y+n1.Y)*k})
+		}
+	}
+	return append(dst, offset(p, n0, d), offset(p, n1, d))
+}
+
+// arc appends the points of the circular arc of center [p] and radius |d|,
+// on the side given by the sign of [d], from the normal [n0] to [n1].
+// The arc goes clockwise on the left side (d > 0), which is the outer side
+// of a right turn, and counter-clockwise on the right side.
+func (st *stroker) arc(dst Contour, p, n0, n1 vector, d float32) Contour {
+	if d < 0 {
+		n0, n1 = vector{X: -n0.X, Y: -n0.Y}, vector{X: -n1.X, Y: -n1.Y}
+	}
+	a0 := math.Atan2(float64(n0.Y), float64(n0.X))
+	a1 := math.Atan2(float64(n1.Y), float64(n1.X))
+	delta := a1 - a0
+	if d > 0 {
+		for delta > 0 {
+			delta -= 2 * math.Pi
+		}
+	} else {
+		for delta < 0 {
+			delta += 2 * math.Pi
+		}
+	}
+	return st.arcPoints(dst, p, float64(abs32(d)), a0, delta)
+}
+
+// arcPoints appends the points of the circular arc of center [p] and radius [r],
+// from the angle [a0] to [a0] + [delta], both included.
+func (st *stroker) arcPoints(dst Contour, p vector, r, a0, delta float64) Contour {
+	step := math.Pi / 2
+	if tol := float64(st.opts.Tolerance); tol < r {
+		step = 2 * math.Acos(1-tol/r)
+	}
+	n := 1 + int(math.Abs(delta)/step)
+	for i := 0; i <= n; i++ {
+		a := a0 + delta*float64(i)/float64(n)
+		dst = append(dst, vector{X: p.X + float32(r*math.Cos(a)), Y: p.Y + float32(r*math.Sin(a))})
+	}
+	return dst
+}
+
+// strokeClosed returns the two offset contours of [c], oriented so that
+// the stroke has the orientation [sign].
+func (st *stroker) strokeClosed(c Contour, sign float32) []Contour {
+	if len(c) < 2 {
+		return st.dot(c, sign)
+	}
+	var left, right Contour
+	for i, p := range c {
+		prev, next := c[(i+len(c)-1)%len(c)], c[(i+1)%len(c)]
+		n0, n1 := normal(prev, p), normal(p, next)
+		left = st.join(left, p, n0, n1, st.halfW)
+		right = st.join(right, p, n0, n1, -st.halfW)
+	}
+	// both offsets have the orientation of the contour, and the left one
+	// is the inner one for counter-clockwise contours, the outer one otherwise :
+	// reversing the left offset always gives a counter-clockwise stroke
+	if sign > 0 {
+		reverse(left)
+	} else {
+		reverse(right)
+	}
+	return []Contour{left, right}
+}
+
+// strokeOpen returns the outline of the stroke of the polyline [c].
+func (st *stroker) strokeOpen(c Contour, sign float32) []Contour {
+	if len(c) < 2 {
+		return st.dot(c, sign)
+	}
+	var left, right Contour
+	n := normal(c[0], c[1])
+	left = append(left, offset(c[0], n, st.halfW))
+	right = append(right, offset(c[0], n, -st.halfW))
+	for i := 1; i < len(c)-1; i++ {
+		n0, n1 := normal(c[i-1], c[i]), normal(c[i], c[i+1])
+		left = st.join(left, c[i], n0, n1, st.halfW)
+		right = st.join(right, c[i], n0, n1, -st.halfW)
+	}
+	last := len(c) - 1
+	n = normal(c[last-1], c[last])
+	left = append(left, offset(c[last], n, st.halfW))
+	right = append(right, offset(c[last], n, -st.halfW))
+
+	// left side, end cap, right side backward, start cap
+	out := left
+	out = st.cap(out, c[last], n, c[last].X-c[last-1].X, c[last].Y-c[last-1].Y)
+	reverse(right)
+	out = append(out, right...)
+	n = normal(c[0], c[1])
+	out = st.cap(out, c[0], vector{X: -n.X, Y: -n.Y}, c[0].X-c[1].X, c[0].Y-c[1].Y)
+	if (out.Area() < 0) != (sign < 0) {
+		reverse(out)
+	}
+	return []Contour{out}
+}
+
+// cap appends the cap at the end point [p], going from the left side (of normal [n])
+// to the right side, where (dx, dy) is the direction of the stroke.
+func (st *stroker) cap(dst Contour, p, n vector, dx, dy float32) Contour {
+	switch st.opts.Cap {
+	case CapRound:
+		return st.arc(dst, p, n, vector{X: -n.X, Y: -n.Y}, st.halfW)
+	case CapSquare:
+		l := length(dx, dy)
+		ext := vector{X: p.X + dx/l*st.halfW, Y: p.Y + dy/l*st.halfW}
+		return append(dst, offset(ext, n, st.halfW), offset(ext, n, -st.halfW))
+	default:
+		return dst
+	}
+}
+
+// dot returns the stroke of a single point, which is only
+// visible with round and square caps.
+func (st *stroker) dot(c Contour, sign float32) []Contour {
+	if len(c) == 0 || st.opts.Cap == CapButt {
+		return nil
+	}
+	p, h := c[0], st.halfW
+	var out Contour
+	if st.opts.Cap == CapRound {
+		out = st.arcPoints(nil, p, float64(h), 0, 2*math.Pi)
+		out = out[:len(out)-1] // the last point repeats the first one
+	} else {
+		out = Contour{{X: p.X - h, Y: p.Y - h}, {X: p.X + h, Y: p.Y - h}, {X: p.X + h, Y: p.Y + h}, {X: p.X - h, Y: p.Y + h}}
+	}
+	if sign < 0 {
+		reverse(out)
+	}
+	return []Contour{out}
+}
+
+func reverse(c Contour) {
+	for i, j := 0, len(c)-1; i < j; i, j = i+1, j-1 {
+		c[i], c[j] = c[j], c[i]
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package outline
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/raster"
+)
+
+// polygon returns an outline made of one contour per slice of points
+func polygon(contours ...[]api.SegmentPoint) api.GlyphOutline {
+	var cs []Contour
+	for _, c := range contours {
+		cs = append(cs, c)
+	}
+	return FromContours(cs)
+}
+
+var (
+	square     = []api.SegmentPoint{{X: 0, Y: 0}, {X: 100, Y: 0}, {X: 100, Y: 100}, {X: 0, Y: 100}}
+	squareCW   = []api.SegmentPoint{{X: 0, Y: 0}, {X: 0, Y: 100}, {X: 100, Y: 100}, {X: 100, Y: 0}}
+	squareHole = []api.SegmentPoint{{X: 30, Y: 30}, {X: 30, Y: 70}, {X: 70, Y: 70}, {X: 70, Y: 30}}
+)
+
+// filledArea returns the area covered by [outline], using the non-zero winding rule.
+func filledArea(outline api.GlyphOutline) float64 {
+	img := raster.Rasterize(outline, 1, 1)
+	var sum float64
+	for _, v := range img.Pix {
+		sum += float64(v)
+	}
+	return sum / 255
+}
+
+func assertArea(t *testing.T, outline api.GlyphOutline, expected float64) {
+	t.Helper()
+	if got := filledArea(outline); math.Abs(got-expected) > expected/100 {
+		t.Errorf("expected area %f, got %f", expected, got)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	circle := api.GlyphOutline{Segments: []api.Segment{
+		{Op: api.SegmentOpMoveTo, Args: [3]api.SegmentPoint{{X: 100, Y: 0}}},
+		{Op: api.SegmentOpQuadTo, Args: [3]api.SegmentPoint{{X: 100, Y: 100}, {X: 0, Y: 100}}},
+		{Op: api.SegmentOpLineTo, Args: [3]api.SegmentPoint{{X: 0, Y: 100}}}, // duplicate
+		{Op: api.SegmentOpCubeTo, Args: [3]api.SegmentPoint{{X: -50, Y: 100}, {X: -50, Y: 0}, {X: 100, Y: 0}}},
+	}}
+	contours := Flatten(circle, 0.5)
+	if len(contours) != 1 || len(contours[0]) < 10 {
+		t.Fatalf("unexpected contours %v", contours)
+	}
+	c := contours[0]
+	for i, p := range c {
+		if p == c[(i+1)%len(c)] {
+			t.Errorf("duplicate point %v", p)
+		}
+	}
+	if c.Area() <= 0 {
+		t.Errorf("expected a counter-clockwise contour")
+	}
+	if a := (Contour(square)).Area(); a != 10000 {
+		t.Errorf("unexpected area %f", a)
+	}
+}
+
+func TestStroke(t *testing.T) {
+	for _, points := range [][]api.SegmentPoint{square, squareCW} {
+		outline := polygon(points)
+		assertArea(t, Stroke(outline, StrokeOptions{Width: 10}), 110*110-90*90)
+		assertArea(t, Stroke(outline, StrokeOptions{Width: 10, Join: JoinBevel}), 12050-90*90)
+		assertArea(t, Stroke(outline, StrokeOptions{Width: 10, Join: JoinRound, Tolerance: 0.1}), 10000+2000+25*math.Pi-90*90)
+	}
+
+	// the strokes of the outer contour and the hole do not cancel
+	outline := polygon(square, squareHole)
+	assertArea(t, Stroke(outline, StrokeOptions{Width: 10}), (110*110-90*90)+(50*50-30*30))
+	// with a low miter limit, bevels are used
+	assertArea(t, Stroke(outline, StrokeOptions{Width: 10, MiterLimit: 1}), (12050-90*90)+(50*50-30*30-50))
+
+	line := polygon([]api.SegmentPoint{{X: 0, Y: 0}, {X: 100, Y: 0}})
+	assertArea(t, Stroke(line, StrokeOptions{Width: 10, OpenContours: true}), 1000)
+	assertArea(t, Stroke(line, StrokeOptions{Width: 10, OpenContours: true, Cap: CapSquare}), 1100)
+	assertArea(t, Stroke(line, StrokeOptions{Width: 10, OpenContours: true, Cap: CapRound, Tolerance: 0.1}), 1000+25*math.Pi)
+	polyline := polygon([]api.SegmentPoint{{X: 0, Y: 0}, {X: 100, Y: 0}, {X: 100, Y: 100}})
+	assertArea(t, Stroke(polyline, StrokeOptions{Width: 10, OpenContours: true}), 2000)
+
+	if s := Stroke(line, StrokeOptions{}); len(s.Segments) != 0 {
+		t.Errorf("expected empty stroke, got %v", s)
+	}
+}
+
+func TestEmbolden(t *testing.T) {
+	for _, outer := range [][]api.SegmentPoint{square, squareCW} {
+		// the hole has the opposite orientation
+		hole := append([]api.SegmentPoint(nil), squareHole...)
+		if Contour(outer).Area() < 0 {
+			reverse(hole)
+		}
+		assertArea(t, polygon(outer, hole), 10000-1600)
+		assertArea(t, Embolden(polygon(outer, hole), 5), 110*110-30*30)
+	}
+}
@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package outline
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-text/typesetting/opentype/api"
+)
+
+// Union returns the region filled by [outline] (using the non-zero winding rule),
+// as an outline made of line segments without overlapping or self-intersecting contours.
+// The outer contours of the result are counter-clockwise (in the Y up coordinate
+// system of outlines) and the holes are clockwise, so that it may be filled with both the
+// non-zero and the even-odd rules.
+//
+// To merge several glyphs, concatenate their (positioned) segments before calling Union.
+func Union(outline api.GlyphOutline, tolerance float32) api.GlyphOutline {
+	return FromContours(UnionContours(Flatten(outline, tolerance)))
+}
+
+// UnionContours is the same as [Union], working on polygons.
+// Coordinates are rounded to 1/256 unit.
+func UnionContours(contours []Contour) []Contour {
+	edges := splitEdges(polygonEdges(contours))
+
+	// group the overlapping edges, which are identical once split
+	groups := make(map[edge][]int)
+	for i, e := range edges {
+		key := e
+		if key.b.x < key.a.x || (key.b.x == key.a.x && key.b.y < key.a.y) {
+			key.a, key.b = key.b, key.a
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	swapped := make([]edge, len(edges))
+	for i, e := range edges {
+		swapped[i] = e.swap()
+	}
+	// the boundary of the filled region is made of the edges
+	// with a filled side and an empty side
+	var boundary []edge
+	for key, group := range groups {
+		if e, ok := boundaryEdge(edges, swapped, key, group); ok {
+			boundary = append(boundary, e)
+		}
+	}
+	// make the output deterministic
+	sort.Slice(boundary, func(i, j int) bool { return boundary[i].less(boundary[j]) })
+
+	return linkEdges(boundary)
+}
+
+// boundaryEdge returns [e] oriented so that the filled region is on
+// its left, or false if both sides are filled or empty.
+// [group] are the indices of the edges overlapping [e], and [swapped]
+// are the [edges] with the X and Y coordinates swapped.
+func boundaryEdge(edges, swapped []edge, e edge, group []int) (edge, bool) {
+	// cast a ray from the middle of the edge, along the X axis,
+	// or the Y axis for horizontal edges
+	swap := e.a.y == e.b.y
+	if swap {
+		edges, e = swapped, e.swap()
+	}
+	mid := point{(e.a.x + e.b.x) / 2, (e.a.y + e.b.y) / 2}
+	// the winding number after the edge, along the ray, and before it
+	after := 0
+	for i, f := range edges {
+		if !contains(group, i) {
+			after += f.crossing(mid)
+		}
+	}
+	before := after
+	for _, i := range group {
+		if edges[i].a.y < edges[i].b.y {
+			before++
+		} else {
+			before--
+		}
+	}
+	if (before != 0) == (after != 0) {
+		return edge{}, false
+	}
+
+	// orient the edge upward : the side before the ray is on its left
+	if e.a.y > e.b.y {
+		e.a, e.b = e.b, e.a
+	}
+	// swapping the coordinates mirrors the orientation
+	if (before != 0) == swap {
+		e.a, e.b = e.b, e.a
+	}
+	if swap {
+		e = e.swap()
+	}
+	return e, true
+}
+
+func contains(indices []int, i int) bool {
+	for _, j := range indices {
+		if i == j {
+			return true
+		}
+	}
+	return false
+}
+
+// point is a vertex of the edges used by [UnionContours]
+type point struct{ x, y float64 }
+
+const gridScale = 256
+
+func snap(p api.SegmentPoint) point {
+	return point{
+		x: math.Round(float64(p.X)*gridScale) / gridScale,
+		y: math.Round(float64(p.Y)*gridScale) / gridScale,
+	}
+}
+
+func cross(ax, ay, bx, by float64) float64 { return ax*by - ay*bx }
+
+// edge is an oriented segment; the winding of the contours
+// is preserved by the split edges
+type edge struct {
+	a, b point
+}
+
+func (e edge) swap() edge { return edge{point{e.a.y, e.a.x}, point{e.b.y, e.b.x}} }
+
+func (e edge) less(f edge) bool {
+	if e.a != f.a {
+		return e.a.x < f.a.x || (e.a.x == f.a.x && e.a.y < f.a.y)
+	}
+	return e.b.x < f.b.x || (e.b.x == f.b.x && e.b.y < f.b.y)
+}
+
+// crossing returns the contribution of the edge to the winding number
+// around [p], using an horizontal ray from [p] toward positive X.
+func (e edge) crossing(p point) int {
+	if e.a.y <= p.y {
+		if e.b.y > p.y && cross(e.b.x-e.a.x, e.b.y-e.a.y, p.x-e.a.x, p.y-e.a.y) > 0 {
+			return 1
+		}
+	} else if e.b.y <= p.y && cross(e.b.x-e.a.x, e.b.y-e.a.y, p.x-e.a.x, p.y-e.a.y) < 0 {
+		return -1
+	}
+	return 0
+}
+
+// polygonEdges returns the non empty edges of [contours].
+func polygonEdges(contours []Contour) []edge {
+	var out []edge
+	for _, c := range contours {
+		for i := range c {
+			e := edge{snap(c[i]), snap(c[(i+1)%len(c)])}
+			if e.a != e.b {
+				out = append(out, e)
+			}
+		}
+	}
+	return out
+}
+
+// splitPoint is an intersection on an edge, at the parameter t
+type splitPoint struct {
+	t float64
+	p point
+}
+
+// splitEdges splits [edges] at their intersections, so that the returned
+// edges only meet at their end points. Overlapping edges are kept,
+// so that the winding numbers are not modified.
+func splitEdges(edges []edge) []edge {
+	splits := make([][]splitPoint, len(edges))
+	for i, e := range edges {
+		for j := i + 1; j < len(edges); j++ {
+			f := edges[j]
+			intersect(e, f, &splits[i], &splits[j])
+		}
+	}
+
+	var out []edge
+	for i, e := range edges {
+		sp := splits[i]
+		sort.Slice(sp, func(i, j int) bool { return sp[i].t < sp[j].t })
+		start := e.a
+		for _, s := range sp {
+			if s.p == start || s.p == e.b {
+				continue
+			}
+			out = append(out, edge{start, s.p})
+			start = s.p
+		}
+		out = append(out, edge{start, e.b})
+	}
+	return out
+}
+
+// param returns the position of [p] on the segment [e],
+// assuming it is on its line.
+func (e edge) param(p point) float64 {
+	dx, dy := e.b.x-e.a.x, e.b.y-e.a.y
+	return ((p.x-e.a.x)*dx + (p.y-e.a.y)*dy) / (dx*dx + dy*dy)
+}
+
+// intersect adds the intersections of [e] and [f] to [se] and [sf]
+func intersect(e, f edge, se, sf *[]splitPoint) {
+	const eps = 1e-9
+	rx, ry := e.b.x-e.a.x, e.b.y-e.a.y
+	sx, sy := f.b.x-f.a.x, f.b.y-f.a.y
+	qx, qy := f.a.x-e.a.x, f.a.y-e.a.y
+	denom := cross(rx, ry, sx, sy)
+	if math.Abs(denom) < eps {
+		if math.Abs(cross(qx, qy, rx, ry)) > eps*math.Hypot(rx, ry) {
+			return // parallel
+		}
+		// collinear : split each edge at the end points of the other
+		for _, p := range [2]point{f.a, f.b} {
+			if t := e.param(p); t > 0 && t < 1 {
+				*se = append(*se, splitPoint{t, p})
+			}
+		}
+		for _, p := range [2]point{e.a, e.b} {
+			if u := f.param(p); u > 0 && u < 1 {
+				*sf = append(*sf, splitPoint{u, p})
+			}
+		}
+		return
+	}
+	t := cross(qx, qy, sx, sy) / denom
+	u := cross(qx, qy, rx, ry) / denom
+	if t < -eps || t > 1+eps || u < -eps || u > 1+eps {
+		return
+	}
+	// use the exact end points when possible
+	var p point
+	switch {
+	case t <= eps:
+		p = e.a
+	case t >= 1-eps:
+		p = e.b
+	case u <= eps:
+		p = f.a
+	case u >= 1-eps:
+		p = f.b
+	default:
+		p = point{e.a.x + t*rx, e.a.y + t*ry}
+	}
+	*se = append(*se, splitPoint{t, p})
+	*sf = append(*sf, splitPoint{u, p})
+}
+
+// linkEdges connects the boundary edges into contours
+func linkEdges(edges []edge) []Contour {
+	outgoing := make(map[point][]int)
+	for i, e := range edges {
+		outgoing[e.a] = append(outgoing[e.a], i)
+	}
+	used := make([]bool, len(edges))
+	next := func(p point) int {
+		for _, i := range outgoing[p] {
+			if !used[i] {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var out []Contour
+	for i := range edges {
+		if used[i] {
+			continue
+		}
+		start := edges[i].a
+		var c []point
+		for j := i; j != -1; j = next(edges[j].b) {
+			used[j] = true
+			c = append(c, edges[j].a)
+			if edges[j].b == start {
+				break
+			}
+		}
+		out = appendLoops(out, c)
+	}
+	return out
+}
+
+// appendLoops splits the closed path [c] at the points it visits
+// several times, and appends the resulting contours to [dst].
+func appendLoops(dst []Contour, c []point) []Contour {
+	emit := func(loop []point) {
+		if loop = removeCollinear(loop); len(loop) < 3 {
+			return
+		}
+		contour := make(Contour, len(loop))
+		for k, p := range loop {
+			contour[k] = api.SegmentPoint{X: float32(p.x), Y: float32(p.y)}
+		}
+		dst = append(dst, contour)
+	}
+	var stack []point
+	indices := make(map[point]int)
+	for _, p := range c {
+		k, ok := indices[p]
+		if !ok {
+			indices[p] = len(stack)
+			stack = append(stack, p)
+			continue
+		}
+		emit(append([]point(nil), stack[k:]...))
+		for _, q := range stack[k+1:] {
+			delete(indices, q)
+		}
+		stack = stack[:k+1]
+	}
+	emit(stack)
+	return dst
+}
+
+// removeCollinear removes the points of [c] on a straight line
+// between their neighbours.
+func removeCollinear(c []point) []point {
+	out := c[:0:0]
+	for i, p := range c {
+		prev, next := c[(i+len(c)-1)%len(c)], c[(i+1)%len(c)]
+		dx0, dy0, dx1, dy1 := p.x-prev.x, p.y-prev.y, next.x-p.x, next.y-p.y
+		if cross(dx0, dy0, dx1, dy1) == 0 && dx0*dx1+dy0*dy1 >= 0 {
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package outline
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// assertSimple checks that [contours] are not overlapping, by comparing
+// the sum of their signed areas with the filled area.
+func assertSimple(t *testing.T, contours []Contour, expected float64) {
+	t.Helper()
+	var area float64
+	for _, c := range contours {
+		area += float64(c.Area())
+	}
+	filled := filledArea(FromContours(contours))
+	if math.Abs(area-expected) > expected/100 || math.Abs(filled-expected) > expected/100 {
+		t.Errorf("expected area %f, got %f (filled %f)", expected, area, filled)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	square2 := []api.SegmentPoint{{X: 50, Y: 50}, {X: 150, Y: 50}, {X: 150, Y: 150}, {X: 50, Y: 150}}
+	bowtie := []api.SegmentPoint{{X: 0, Y: 0}, {X: 100, Y: 100}, {X: 100, Y: 0}, {X: 0, Y: 100}}
+	for _, test := range []struct {
+		outline  api.GlyphOutline
+		contours int
+		area     float64
+	}{
+		{polygon(square), 1, 10000},
+		{polygon(squareCW), 1, 10000},
+		{polygon(square, square2), 1, 20000 - 2500},
+		{polygon(square, squareCW), 0, 0},              // opposite orientations cancel
+		{polygon(square, square), 1, 10000},            // duplicated contour
+		{polygon(square, squareHole), 2, 10000 - 1600}, // hole
+		{polygon(square, square2, squareHole), 2, 20000 - 2500 - 1600 + 20*20},
+		{polygon(bowtie), 2, 5000},
+		{Stroke(polygon(square), StrokeOptions{Width: 10}), 2, 110*110 - 90*90},
+		{Embolden(polygon(square, squareHole), 5), 2, 110*110 - 30*30},
+		{polygon([]api.SegmentPoint{{X: 0, Y: 0}, {X: 100, Y: 0}}), 0, 0}, // no area
+	} {
+		contours := UnionContours(Flatten(test.outline, 0))
+		if len(contours) != test.contours {
+			t.Errorf("expected %d contours, got %v", test.contours, contours)
+			continue
+		}
+		if test.contours != 0 {
+			assertSimple(t, contours, test.area)
+		}
+	}
+
+	// the collinear points are removed
+	if u := UnionContours([]Contour{square, square2}); len(u[0]) != 8 {
+		t.Errorf("unexpected union %v", u)
+	}
+}
+
+func TestUnionGlyphs(t *testing.T) {
+	face, err := font.ParseTTF(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range "aBg&%" {
+		gid, _ := face.NominalGlyph(r)
+		glyph := face.GlyphData(gid).(api.GlyphOutline)
+		for _, outline := range []api.GlyphOutline{
+			glyph,
+			Stroke(glyph, StrokeOptions{Width: 40, Join: JoinRound}),
+			Embolden(glyph, 30),
+		} {
+			expected := filledArea(FromContours(Flatten(outline, 0)))
+			assertSimple(t, UnionContours(Flatten(outline, 0)), expected)
+		}
+	}
+}
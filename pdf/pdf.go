@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+// Package pdf provides the artifacts required to embed a font
+// in a PDF document, as a CIDFontType2 font (see the section 9.7 of
+// the PDF 1.7 specification).
+//
+// The glyphs used in the document are collected from the shaped outputs,
+// and are written in the content streams with the Identity-H encoding, using their
+// glyph index in the original font as CID (on two bytes), that is :
+//
+//	<< /Type /Font /Subtype /Type0 /BaseFont /<tag+name> /Encoding /Identity-H
+//	   /DescendantFonts [<< /Type /Font /Subtype /CIDFontType2 /BaseFont /<tag+name>
+//	                        /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >>
+//	                        /W <Widths> /CIDToGIDMap <CIDToGIDMap stream>
+//	                        /FontDescriptor << ... /FontFile2 <FontProgram stream> >> >>]
+//	   /ToUnicode <ToUnicode stream> >>
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/opentype/loader"
+	"github.com/go-text/typesetting/shaping"
+)
+
+// FontSubset collects the glyphs of a font used in a document,
+// and the text they represent.
+type FontSubset struct {
+	font *trueTypeFont
+
+	// used glyphs, with the text they represent (possibly empty)
+	glyphs map[api.GID][]rune
+}
+
+// NewFontSubset parses the given font file, which must be a TrueType
+// font (with a 'glyf' table), not a font collection.
+func NewFontSubset(file loader.Resource) (*FontSubset, error) {
+	ld, err := loader.NewLoader(file)
+	if err != nil {
+		return nil, err
+	}
+	ft, err := parseTrueType(ld)
+	if err != nil {
+		return nil, err
+	}
+	// .notdef is always included
+	return &FontSubset{font: ft, glyphs: map[api.GID][]rune{0: nil}}, nil
+}
+
+// AddGlyph marks [gid] as used, representing [text].
+// If the glyph was already added with a non empty text, [text] is ignored.
+func (fs *FontSubset) AddGlyph(gid api.GID, text []rune) {
+	if existing := fs.glyphs[gid]; len(existing) != 0 {
+		return
+	}
+	fs.glyphs[gid] = append([]rune(nil), text...)
+}
+
+// AddOutput marks the glyphs of [out] as used. [text] is the text
+// used to shape [out], that is the Text field of [shaping.Input] : the runes
+// of each cluster are attributed to its first glyph.
+// [out] must have been shaped with the font of [fs].
+func (fs *FontSubset) AddOutput(out shaping.Output, text []rune) {
+	previousCluster := -1
+	for _, g := range out.Glyphs {
+		var runes []rune
+		if g.ClusterIndex != previousCluster && g.ClusterIndex+g.RuneCount <= len(text) {
+			runes = text[g.ClusterIndex : g.ClusterIndex+g.RuneCount]
+		}
+		previousCluster = g.ClusterIndex
+		fs.AddGlyph(g.GlyphID, runes)
+	}
+}
+
+// sortedGlyphs returns the used glyphs, in increasing order.
+func (fs *FontSubset) sortedGlyphs() []api.GID {
+	out := make([]api.GID, 0, len(fs.glyphs))
+	for gid := range fs.glyphs {
+		out = append(out, gid)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// Tag returns the six uppercase letters identifying the subset,
+// which should prefix the PostScript name of the font, as in "ABCDEF+Go-Regular".
+// It only depends on the glyphs used.
+func (fs *FontSubset) Tag() string {
+	h := fnv.New32a()
+	for _, gid := range fs.sortedGlyphs() {
+		h.Write([]byte{byte(gid >> 8), byte(gid)})
+	}
+	sum := h.Sum32()
+	var tag [6]byte
+	for i := range tag {
+		tag[i] = 'A' + byte(sum%26)
+		sum /= 26
+	}
+	return string(tag[:])
+}
+
+// Widths returns the /W array of the CIDFont dictionary, giving the advance
+// of each used glyph, in thousandths of em.
+func (fs *FontSubset) Widths() string {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	glyphs := fs.sortedGlyphs()
+	for i := 0; i < len(glyphs); {
+		// group consecutive glyphs
+		j := i + 1
+		for j < len(glyphs) && glyphs[j] == glyphs[j-1]+1 {
+			j++
+		}
+		if i != 0 {
+			buf.WriteByte(' ')
+		}
+		fmt.Fprintf(&buf, "%d [", glyphs[i])
+		for k, gid := range glyphs[i:j] {
+			if k != 0 {
+				buf.WriteByte(' ')
+			}
+			advance := float64(fs.font.advance(gid)) * 1000 / float64(fs.font.upem)
+			fmt.Fprintf(&buf, "%d", int(math.Round(advance)))
+		}
+		buf.WriteByte(']')
+		i = j
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// subsetGlyphs returns the glyphs included in the subset, which are the used glyphs
+// and the components of the composite glyphs, in increasing order.
+func (fs *FontSubset) subsetGlyphs() []api.GID {
+	all := make(map[api.GID]bool, len(fs.glyphs))
+	var add func(gid api.GID, depth int)
+	add = func(gid api.GID, depth int) {
+		if all[gid] || int(gid) >= fs.font.numGlyphs || depth > maxCompositeDepth {
+			return
+		}
+		all[gid] = true
+		for _, component := range compositeComponents(fs.font.glyphData(gid)) {
+			add(component, depth+1)
+		}
+	}
+	for gid := range fs.glyphs {
+		add(gid, 0)
+	}
+	out := make([]api.GID, 0, len(all))
+	for gid := range all {
+		out = append(out, gid)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// CIDToGIDMap returns the content of the /CIDToGIDMap stream, mapping each
+// CID (the glyph index in the original font) to the glyph index in the
+// font returned by [FontSubset.FontProgram].
+func (fs *FontSubset) CIDToGIDMap() []byte {
+	subset := fs.subsetGlyphs()
+	maxCID := subset[len(subset)-1]
+	out := make([]byte, 2*(int(maxCID)+1))
+	for newGID, gid := range subset {
+		if _, used := fs.glyphs[gid]; used {
+			out[2*int(gid)] = byte(newGID >> 8)
+			out[2*int(gid)+1] = byte(newGID)
+		}
+	}
+	return out
+}
+
+// ToUnicode returns the content of the /ToUnicode stream, a CMap mapping
+// each used glyph to the text it represents, so that text may be extracted from
+// the PDF document. Glyphs without text are omitted.
+func (fs *FontSubset) ToUnicode() []byte {
+	var entries []string
+	for _, gid := range fs.sortedGlyphs() {
+		text := fs.glyphs[gid]
+		if len(text) == 0 {
+			continue
+		}
+		var entry bytes.Buffer
+		fmt.Fprintf(&entry, "<%04X> <", gid)
+		for _, u := range utf16.Encode(text) {
+			fmt.Fprintf(&entry, "%04X", u)
+		}
+		entry.WriteByte('>')
+		entries = append(entries, entry.String())
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`/CIDInit /ProcSet findresource begin
+12 dict begin
+begincmap
+/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def
+/CMapName /Adobe-Identity-UCS def
+/CMapType 2 def
+1 begincodespacerange
+<0000> <FFFF>
+endcodespacerange
+`)
+	// a bfchar block is limited to 100 entries
+	for len(entries) != 0 {
+		n := len(entries)
+		if n > 100 {
+			n = 100
+		}
+		fmt.Fprintf(&buf, "%d beginbfchar\n", n)
+		for _, entry := range entries[:n] {
+			buf.WriteString(entry)
+			buf.WriteByte('\n')
+		}
+		buf.WriteString("endbfchar\n")
+		entries = entries[n:]
+	}
+	buf.WriteString(`endcmap
+CMapName currentdict /CMap defineresource pop
+end
+end
+`)
+	return buf.Bytes()
+}
+
+// FontProgram returns a TrueType font containing only the used glyphs
+// (and their components), suitable for the /FontFile2 stream.
+// The glyphs are renumbered : see [FontSubset.CIDToGIDMap].
+func (fs *FontSubset) FontProgram() []byte {
+	return fs.font.subset(fs.subsetGlyphs())
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package pdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/shaping"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/math/fixed"
+)
+
+func shape(t *testing.T, face font.Face, text []rune) shaping.Output {
+	t.Helper()
+	var shaper shaping.HarfbuzzShaper
+	return shaper.Shape(shaping.Input{
+		Text:      text,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      face,
+		Size:      fixed.I(12),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	})
+}
+
+func TestFontSubset(t *testing.T) {
+	face, err := font.ParseTTF(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs, err := NewFontSubset(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := []rune("Hé, ok")
+	out := shape(t, face, text)
+	fs.AddOutput(out, text)
+
+	if len(fs.glyphs) != 7 { // .notdef and 6 distinct glyphs
+		t.Fatalf("unexpected glyphs %v", fs.glyphs)
+	}
+
+	// the font program is a valid font, with the same outlines
+	subset, err := font.ParseTTF(bytes.NewReader(fs.FontProgram()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cidToGID := fs.CIDToGIDMap()
+	for _, g := range out.Glyphs {
+		newGID := api.GID(binary.BigEndian.Uint16(cidToGID[2*int(g.GlyphID):]))
+		if newGID == 0 {
+			t.Fatalf("glyph %d not mapped", g.GlyphID)
+		}
+		if got, exp := subset.GlyphData(newGID), face.GlyphData(g.GlyphID); !reflect.DeepEqual(got, exp) {
+			t.Errorf("glyph %d: outlines differ", g.GlyphID)
+		}
+		if got, exp := subset.HorizontalAdvance(newGID), face.HorizontalAdvance(g.GlyphID); got != exp {
+			t.Errorf("glyph %d: expected advance %g, got %g", g.GlyphID, exp, got)
+		}
+	}
+
+	// the text is recovered
+	toUnicode := string(fs.ToUnicode())
+	for i, r := range text {
+		entry := fmt.Sprintf("<%04X> <%04X>", out.Glyphs[i].GlyphID, r)
+		if !strings.Contains(toUnicode, entry) {
+			t.Errorf("missing ToUnicode entry %s in\n%s", entry, toUnicode)
+		}
+	}
+
+	if tag := fs.Tag(); len(tag) != 6 || strings.ToUpper(tag) != tag {
+		t.Errorf("invalid tag %s", tag)
+	}
+}
+
+func TestWidths(t *testing.T) {
+	fs, err := NewFontSubset(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs.AddGlyph(3, []rune{' '})
+	fs.AddGlyph(4, nil)
+	fs.AddGlyph(10, nil)
+	advance := func(gid api.GID) int {
+		return int(float64(fs.font.advance(gid))*1000/float64(fs.font.upem) + 0.5)
+	}
+	expected := fmt.Sprintf("[0 [%d] 3 [%d %d] 10 [%d]]", advance(0), advance(3), advance(4), advance(10))
+	if got := fs.Widths(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	// the text of a glyph is not overridden
+	fs.AddGlyph(3, []rune{'x'})
+	if !reflect.DeepEqual(fs.glyphs[3], []rune{' '}) {
+		t.Errorf("unexpected text %q", string(fs.glyphs[3]))
+	}
+}
+
+func TestCompositeComponents(t *testing.T) {
+	// a composite glyph with two components
+	data := []byte{
+		0xFF, 0xFF, 0, 0, 0, 0, 0, 0, 0, 0, // header
+		0, arg1And2AreWords | moreComponents, 0, 5, 0, 1, 0, 2, // words
+		0, weHaveAScale, 0, 7, 1, 2, 0x40, 0, // bytes and scale
+	}
+	if got := compositeComponents(data); !reflect.DeepEqual(got, []api.GID{5, 7}) {
+		t.Errorf("unexpected components %v", got)
+	}
+	// simple glyph
+	if got := compositeComponents([]byte{0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}); got != nil {
+		t.Errorf("unexpected components %v", got)
+	}
+}
@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-text/typesetting/opentype/api"
+	"github.com/go-text/typesetting/opentype/loader"
+)
+
+var errNoGlyf = errors.New("unsupported font: only TrueType outlines ('glyf' table) are supported")
+
+var (
+	tagHead = loader.MustNewTag("head")
+	tagHhea = loader.MustNewTag("hhea")
+	tagMaxp = loader.MustNewTag("maxp")
+	tagHmtx = loader.MustNewTag("hmtx")
+	tagLoca = loader.MustNewTag("loca")
+	tagGlyf = loader.MustNewTag("glyf")
+	tagCvt  = loader.MustNewTag("cvt ")
+	tagFpgm = loader.MustNewTag("fpgm")
+	tagPrep = loader.MustNewTag("prep")
+	tagPost = loader.MustNewTag("post")
+	tagCmap = loader.MustNewTag("cmap")
+)
+
+// maxCompositeDepth limits the nesting of composite glyphs
+const maxCompositeDepth = 8
+
+// trueTypeFont stores the raw tables required to subset a font.
+type trueTypeFont struct {
+	head, hhea, maxp, hmtx, glyf []byte
+	// hinting tables, copied as is (possibly nil)
+	cvt, fpgm, prep []byte
+	post            []byte
+
+	loca        []uint32 // with length numGlyphs + 1
+	numGlyphs   int
+	numHMetrics int
+	upem        uint16
+}
+
+func parseTrueType(ld *loader.Loader) (*trueTypeFont, error) {
+	if !ld.HasTable(tagGlyf) {
+		return nil, errNoGlyf
+	}
+	var (
+		out trueTypeFont
+		err error
+	)
+	for _, table := range []struct {
+		tag     loader.Tag
+		dst     *[]byte
+		minSize int
+	}{
+		{tagHead, &out.head, 54},
+		{tagHhea, &out.hhea, 36},
+		{tagMaxp, &out.maxp, 6},
+		{tagHmtx, &out.hmtx, 0},
+		{tagGlyf, &out.glyf, 0},
+	} {
+		*table.dst, err = ld.RawTable(table.tag)
+		if err != nil {
+			return nil, err
+		}
+		if len(*table.dst) < table.minSize {
+			return nil, fmt.Errorf("invalid '%s' table (EOF)", table.tag)
+		}
+	}
+	// optional tables
+	out.cvt, _ = ld.RawTable(tagCvt)
+	out.fpgm, _ = ld.RawTable(tagFpgm)
+	out.prep, _ = ld.RawTable(tagPrep)
+	out.post, _ = ld.RawTable(tagPost)
+
+	out.upem = binary.BigEndian.Uint16(out.head[18:])
+	if out.upem == 0 {
+		return nil, errors.New("invalid 'head' table (zero units per em)")
+	}
+	out.numGlyphs = int(binary.BigEndian.Uint16(out.maxp[4:]))
+	out.numHMetrics = int(binary.BigEndian.Uint16(out.hhea[34:]))
+	if out.numHMetrics == 0 || len(out.hmtx) < 4*out.numHMetrics {
+		return nil, errors.New("invalid 'hmtx' table (EOF)")
+	}
+
+	loca, err := ld.RawTable(tagLoca)
+	if err != nil {
+		return nil, err
+	}
+	isLong := binary.BigEndian.Uint16(out.head[50:]) == 1
+	out.loca = make([]uint32, out.numGlyphs+1)
+	if isLong {
+		if len(loca) < 4*len(out.loca) {
+			return nil, errors.New("invalid 'loca' table (EOF)")
+		}
+		for i := range out.loca {
+			out.loca[i] = binary.BigEndian.Uint32(loca[4*i:])
+		}
+	} else {
+		if len(loca) < 2*len(out.loca) {
+			return nil, errors.New("invalid 'loca' table (EOF)")
+		}
+		for i := range out.loca {
+			out.loca[i] = 2 * uint32(binary.BigEndian.Uint16(loca[2*i:]))
+		}
+	}
+	return &out, nil
+}
+
+// advance returns the horizontal advance of the glyph, in font units
+func (ft *trueTypeFont) advance(gid api.GID) uint16 {
+	index := int(gid)
+	if index >= ft.numHMetrics {
+		index = ft.numHMetrics - 1
+	}
+	return binary.BigEndian.Uint16(ft.hmtx[4*index:])
+}
+
+// leftSideBearing returns the left side bearing of the glyph, in font units
+func (ft *trueTypeFont) leftSideBearing(gid api.GID) uint16 {
+	if index := int(gid); index < ft.numHMetrics {
+		return binary.BigEndian.Uint16(ft.hmtx[4*index+2:])
+	}
+	offset := 4*ft.numHMetrics + 2*(int(gid)-ft.numHMetrics)
+	if offset+2 > len(ft.hmtx) {
+		return 0
+	}
+	return binary.BigEndian.Uint16(ft.hmtx[offset:])
+}
+
+// glyphData returns the raw data of the glyph in the 'glyf' table,
+// or nil for empty or invalid glyphs
+func (ft *trueTypeFont) glyphData(gid api.GID) []byte {
+	if int(gid) >= ft.numGlyphs {
+		return nil
+	}
+	start, end := ft.loca[gid], ft.loca[gid+1]
+	if start >= end || int(end) > len(ft.glyf) {
+		return nil
+	}
+	return ft.glyf[start:end]
+}
+
+// flags of the composite glyph components
+const (
+	arg1And2AreWords = 0x0001
+	weHaveAScale     = 0x0008
+	moreComponents   = 0x0020
+	weHaveXYScale    = 0x0040
+	weHaveTwoByTwo   = 0x0080
+)
+
+// walkComponents calls [fn] with the offset of the glyph index
+// of each component of a composite glyph. It does nothing for simple glyphs.
+func walkComponents(data []byte, fn func(offset int)) {
+	if len(data) < 10 || int16(binary.BigEndian.Uint16(data)) >= 0 {
+		return
+	}
+	for offset := 10; offset+4 <= len(data); {
+		flags := binary.BigEndian.Uint16(data[offset:])
+		fn(offset + 2)
+		offset += 4
+		if flags&arg1And2AreWords != 0 {
+			offset += 4
+		} else {
+			offset += 2
+		}
+		switch {
+		case flags&weHaveAScale != 0:
+			offset += 2
+		case flags&weHaveXYScale != 0:
+			offset += 4
+		case flags&weHaveTwoByTwo != 0:
+			offset += 8
+		}
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+}
+
+// compositeComponents returns the glyphs used by a composite glyph
+func compositeComponents(data []byte) []api.GID {
+	var out []api.GID
+	walkComponents(data, func(offset int) {
+		out = append(out, api.GID(binary.BigEndian.Uint16(data[offset:])))
+	})
+	return out
+}
+
+// subset returns a font file containing only [glyphs],
+// which must be sorted and contain the components of the composite glyphs.
+func (ft *trueTypeFont) subset(glyphs []api.GID) []byte {
+	newGIDs := make(map[api.GID]uint16, len(glyphs))
+	for i, gid := range glyphs {
+		newGIDs[gid] = uint16(i)
+	}
+
+	var (
+		glyf []byte
+		loca = make([]byte, 4*(len(glyphs)+1))
+		hmtx = make([]byte, 4*len(glyphs))
+	)
+	for i, gid := range glyphs {
+		binary.BigEndian.PutUint32(loca[4*i:], uint32(len(glyf)))
+		binary.BigEndian.PutUint16(hmtx[4*i:], ft.advance(gid))
+		binary.BigEndian.PutUint16(hmtx[4*i+2:], ft.leftSideBearing(gid))
+
+		start := len(glyf)
+		glyf = append(glyf, ft.glyphData(gid)...)
+		// update the components
+		data := glyf[start:]
+		walkComponents(data, func(offset int) {
+			component := api.GID(binary.BigEndian.Uint16(data[offset:]))
+			binary.BigEndian.PutUint16(data[offset:], newGIDs[component])
+		})
+		// glyphs are aligned on 4 bytes
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*len(glyphs):], uint32(len(glyf)))
+
+	head := append([]byte(nil), ft.head...)
+	binary.BigEndian.PutUint32(head[8:], 0)  // checkSumAdjustment, updated below
+	binary.BigEndian.PutUint16(head[50:], 1) // long loca offsets
+	hhea := append([]byte(nil), ft.hhea...)
+	binary.BigEndian.PutUint16(hhea[34:], uint16(len(glyphs)))
+	maxp := append([]byte(nil), ft.maxp...)
+	binary.BigEndian.PutUint16(maxp[4:], uint16(len(glyphs)))
+
+	tables := map[loader.Tag][]byte{
+		tagHead: head,
+		tagHhea: hhea,
+		tagMaxp: maxp,
+		tagHmtx: hmtx,
+		tagLoca: loca,
+		tagGlyf: glyf,
+	}
+	for tag, table := range map[loader.Tag][]byte{tagCvt: ft.cvt, tagFpgm: ft.fpgm, tagPrep: ft.prep} {
+		if len(table) != 0 {
+			tables[tag] = table
+		}
+	}
+	// glyphs are accessed by index, but some readers require a 'cmap' table
+	tables[tagCmap] = emptyCmap
+	if len(ft.post) >= 32 {
+		// version 3 : no glyph names
+		post := append([]byte(nil), ft.post[:32]...)
+		binary.BigEndian.PutUint32(post, 0x00030000)
+		tables[tagPost] = post
+	}
+
+	out := writeFont(tables)
+	// see the 'head' table specification
+	adjustment := 0xB1B0AFBA - checksum(out)
+	headOffset := tableOffset(out, tagHead)
+	binary.BigEndian.PutUint32(out[headOffset+8:], adjustment)
+	return out
+}
+
+// emptyCmap is a 'cmap' table with one (Windows, Unicode BMP)
+// subtable in format 4, mapping no characters
+var emptyCmap = []byte{
+	0, 0, 0, 1, // version, numTables
+	0, 3, 0, 1, 0, 0, 0, 12, // platformID, encodingID, offset
+	0, 4, 0, 24, 0, 0, // format, length, language
+	0, 2, 0, 2, 0, 0, 0, 0, // segCountX2, searchRange, entrySelector, rangeShift
+	0xFF, 0xFF, 0, 0, // endCode, reservedPad
+	0xFF, 0xFF, 0, 1, 0, 0, // startCode, idDelta, idRangeOffset
+}
+
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// writeFont serializes the given tables in an Opentype file,
+// with the TrueType outlines signature.
+func writeFont(tables map[loader.Tag][]byte) []byte {
+	tags := make([]loader.Tag, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+
+	numTables := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= numTables {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	headerSize := 12 + 16*numTables
+	out := make([]byte, headerSize)
+	binary.BigEndian.PutUint32(out, 0x00010000)
+	binary.BigEndian.PutUint16(out[4:], uint16(numTables))
+	binary.BigEndian.PutUint16(out[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(out[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(out[10:], uint16(16*numTables-searchRange))
+	for i, tag := range tags {
+		table := tables[tag]
+		record := out[12+16*i:]
+		binary.BigEndian.PutUint32(record, uint32(tag))
+		binary.BigEndian.PutUint32(record[4:], checksum(table))
+		binary.BigEndian.PutUint32(record[8:], uint32(len(out)))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(table)))
+		out = append(out, table...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+	return out
+}
+
+// tableOffset returns the offset of the given table, written by [writeFont]
+func tableOffset(font []byte, tag loader.Tag) int {
+	numTables := int(binary.BigEndian.Uint16(font[4:]))
+	for i := 0; i < numTables; i++ {
+		record := font[12+16*i:]
+		if loader.Tag(binary.BigEndian.Uint32(record)) == tag {
+			return int(binary.BigEndian.Uint32(record[8:]))
+		}
+	}
+	return -1
+}
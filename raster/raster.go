@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+// Package raster implements an anti-aliased rasterizer for glyph outlines,
+// producing coverage masks without external dependencies.
+//
+// The algorithm accumulates the signed area covered by each line segment
+// in every pixel, as described in https://medium.com/@raphlinus/inside-the-fastest-font-renderer-in-the-world-75ae5270c445.
+// Curves are first flattened into line segments.
+//
+// The package also computes signed distance fields ([SDF] and [MSDF]),
+// as used by GPU text renderers.
+package raster
+
+import (
+	"image"
+	"math"
+
+	"github.com/go-text/typesetting/opentype/api"
+)
+
+type point struct {
+	x, y float32
+}
+
+func lerp(t float32, p0, p1 point) point {
+	return point{p0.x + t*(p1.x-p0.x), p0.y + t*(p1.y-p0.y)}
+}
+
+// Rasterizer converts glyph outlines to coverage masks.
+// Its zero value is ready to use, and a Rasterizer may be reused
+// to avoid allocations.
+type Rasterizer struct {
+	// accumulation buffer, with length width * height + 2
+	area          []float32
+	width, height int
+	// origin of the image, in pixels, relative to the glyph origin,
+	// with the Y axis pointing down
+	minX, minY int
+	scale      float32
+
+	// first and current points of the current contour
+	start, current point
+}
+
+// Rasterize returns the coverage mask of [outline], where a font unit is
+// scaled by [scale] pixels. For a font size expressed in pixels per em, [scale]
+// is given by ppem / upem.
+//
+// The bounds of the returned image are expressed in pixels, relative to
+// the glyph origin, with the Y axis pointing down, so that the image may be
+// drawn at (dot.X + Rect.Min.X, dot.Y + Rect.Min.Y).
+// The non-zero winding rule is used.
+func (r *Rasterizer) Rasterize(outline api.GlyphOutline, scale float32) *image.Alpha {
+	if len(outline.Segments) == 0 {
+		return image.NewAlpha(image.Rectangle{})
+	}
+
+	// compute the bounding box (in pixels, Y up), using the control points
+	minX, minY := float32(math.Inf(+1)), float32(math.Inf(+1))
+	maxX, maxY := float32(math.Inf(-1)), float32(math.Inf(-1))
+	for i := range outline.Segments {
+		for _, p := range outline.Segments[i].ArgsSlice() {
+			x, y := p.X*scale, p.Y*scale
+			minX, maxX = min32(minX, x), max32(maxX, x)
+			minY, maxY = min32(minY, y), max32(maxY, y)
+		}
+	}
+	bounds := image.Rect(
+		int(math.Floor(float64(minX))), -int(math.Ceil(float64(maxY))),
+		int(math.Ceil(float64(maxX))), -int(math.Floor(float64(minY))),
+	)
+
+	r.reset(bounds, scale)
+	for i := range outline.Segments {
+		seg := &outline.Segments[i]
+		switch seg.Op {
+		case api.SegmentOpMoveTo:
+			r.closeContour()
+			r.start = r.toPixels(seg.Args[0])
+			r.current = r.start
+		case api.SegmentOpLineTo:
+			r.lineTo(r.toPixels(seg.Args[0]))
+		case api.SegmentOpQuadTo:
+			r.quadTo(r.toPixels(seg.Args[0]), r.toPixels(seg.Args[1]))
+		case api.SegmentOpCubeTo:
+			r.cubeTo(r.toPixels(seg.Args[0]), r.toPixels(seg.Args[1]), r.toPixels(seg.Args[2]))
+		}
+	}
+	r.closeContour()
+
+	img := image.NewAlpha(bounds)
+	r.accumulate(img.Pix)
+	return img
+}
+
+// Rasterize is a convenience function rasterizing [outline]
+// for a font with [upem] units per em, at [ppem] pixels per em.
+// See [Rasterizer.Rasterize] for more details.
+func Rasterize(outline api.GlyphOutline, upem uint16, ppem float32) *image.Alpha {
+	var r Rasterizer
+	return r.Rasterize(outline, api.Scale{Upem: upem, Ppem: ppem}.Factor())
+}
+
+func (r *Rasterizer) reset(bounds image.Rectangle, scale float32) {
+	r.width, r.height = bounds.Dx(), bounds.Dy()
+	r.minX, r.minY = bounds.Min.X, bounds.Min.Y
+	r.scale = scale
+	// add some padding so that the last cell always has a valid successor
+	n := r.width*r.height + 2
+	if cap(r.area) < n {
+		r.area = make([]float32, n)
+	} else {
+		r.area = r.area[:n]
+		for i := range r.area {
+			r.area[i] = 0
+		}
+	}
+}
+
+// toPixels converts from font units (Y up) to the image space (Y down)
+func (r *Rasterizer) toPixels(p api.SegmentPoint) point {
+	return point{p.X*r.scale - float32(r.minX), -p.Y*r.scale - float32(r.minY)}
+}
+
+func (r *Rasterizer) closeContour() {
+	if r.current != r.start {
+		r.lineTo(r.start)
+	}
+}
+
+// flattening tolerance, in pixels
+const tolerance = 0.1
+
+func (r *Rasterizer) quadTo(p1, p2 point) {
+	p0 := r.current
+	// the deviation from a line is bounded by |p0 - 2p1 + p2| / 4
+	dx, dy := p0.x-2*p1.x+p2.x, p0.y-2*p1.y+p2.y
+	dev := float32(math.Sqrt(float64(dx*dx+dy*dy))) / 4
+	n := 1 + int(math.Sqrt(float64(dev/tolerance)))
+	for i := 1; i < n; i++ {
+		t := float32(i) / float32(n)
+		r.lineTo(lerp(t, lerp(t, p0, p1), lerp(t, p1, p2)))
+	}
+	r.lineTo(p2)
+}
+
+func (r *Rasterizer) cubeTo(p1, p2, p3 point) {
+	p0 := r.current
+	// the deviation from a line is bounded by 3/4 of the maximum second difference
+	dx0, dy0 := p0.x-2*p1.x+p2.x, p0.y-2*p1.y+p2.y
+	dx1, dy1 := p1.x-2*p2.x+p3.x, p1.y-2*p2.y+p3.y
+	dev := 0.75 * float32(math.Sqrt(float64(max32(dx0*dx0+dy0*dy0, dx1*dx1+dy1*dy1))))
+	n := 1 + int(math.Sqrt(float64(dev/tolerance)))
+	for i := 1; i < n; i++ {
+		t := float32(i) / float32(n)
+		q0, q1, q2 := lerp(t, p0, p1), lerp(t, p1, p2), lerp(t, p2, p3)
+		r.lineTo(lerp(t, lerp(t, q0, q1), lerp(t, q1, q2)))
+	}
+	r.lineTo(p3)
+}
+
+// lineTo accumulates the signed area covered by the segment
+// from the current point to [p1], in each pixel.
+func (r *Rasterizer) lineTo(p1 point) {
+	p0 := r.current
+	r.current = p1
+	if p0.y == p1.y {
+		return
+	}
+	dir := float32(1)
+	if p0.y > p1.y {
+		dir = -1
+		p0, p1 = p1, p0
+	}
+	dxdy := (p1.x - p0.x) / (p1.y - p0.y)
+	x := p0.x
+	if p0.y < 0 {
+		x -= p0.y * dxdy
+	}
+	yEnd := int(math.Ceil(float64(p1.y)))
+	if yEnd > r.height {
+		yEnd = r.height
+	}
+	for y := maxInt(0, int(p0.y)); y < yEnd; y++ {
+		lineStart := y * r.width
+		dy := min32(float32(y+1), p1.y) - max32(float32(y), p0.y)
+		xNext := x + dxdy*dy
+		d := dy * dir
+		x0, x1 := x, xNext
+		if x0 > x1 {
+			x0, x1 = x1, x0
+		}
+		x0Floor := float32(math.Floor(float64(x0)))
+		x0i := int(x0Floor)
+		x1Ceil := float32(math.Ceil(float64(x1)))
+		x1i := int(x1Ceil)
+		if x1i <= x0i+1 {
+			// the segment is inside one pixel
+			xm := 0.5*(x+xNext) - x0Floor
+			r.add(lineStart+x0i, d-d*xm)
+			r.add(lineStart+x0i+1, d*xm)
+		} else {
+			s := 1 / (x1 - x0)
+			x0f := x0 - x0Floor
+			a0 := 0.5 * s * (1 - x0f) * (1 - x0f)
+			x1f := x1 - x1Ceil + 1
+			am := 0.5 * s * x1f * x1f
+			r.add(lineStart+x0i, d*a0)
+			if x1i == x0i+2 {
+				r.add(lineStart+x0i+1, d*(1-a0-am))
+			} else {
+				a1 := s * (1.5 - x0f)
+				r.add(lineStart+x0i+1, d*(a1-a0))
+				for xi := x0i + 2; xi < x1i-1; xi++ {
+					r.add(lineStart+xi, d*s)
+				}
+				a2 := a1 + float32(x1i-x0i-3)*s
+				r.add(lineStart+x1i-1, d*(1-a2-am))
+			}
+			r.add(lineStart+x1i, d*am)
+		}
+		x = xNext
+	}
+}
+
+// add ignores the (rounding) errors outside the buffer
+func (r *Rasterizer) add(index int, v float32) {
+	if 0 <= index && index < len(r.area) {
+		r.area[index] += v
+	}
+}
+
+// accumulate converts the signed areas into coverage values
+func (r *Rasterizer) accumulate(dst []uint8) {
+	var acc float32
+	for i := range dst {
+		acc += r.area[i]
+		a := acc
+		if a < 0 {
+			a = -a
+		}
+		if a > 1 {
+			a = 1
+		}
+		dst[i] = uint8(a*255 + 0.5)
+	}
+}
+
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package raster
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/vector"
+)
+
+func rect(x0, y0, x1, y1 float32) api.GlyphOutline {
+	return api.GlyphOutline{Segments: []api.Segment{
+		{Op: api.SegmentOpMoveTo, Args: [3]api.SegmentPoint{{X: x0, Y: y0}}},
+		{Op: api.SegmentOpLineTo, Args: [3]api.SegmentPoint{{X: x1, Y: y0}}},
+		{Op: api.SegmentOpLineTo, Args: [3]api.SegmentPoint{{X: x1, Y: y1}}},
+		{Op: api.SegmentOpLineTo, Args: [3]api.SegmentPoint{{X: x0, Y: y1}}},
+	}}
+}
+
+func TestRasterizeRect(t *testing.T) {
+	img := Rasterize(rect(0, 0, 1000, 500), 1000, 10)
+	if expected := image.Rect(0, -5, 10, 0); img.Rect != expected {
+		t.Fatalf("expected bounds %v, got %v", expected, img.Rect)
+	}
+	for i, a := range img.Pix {
+		if a != 0xFF {
+			t.Fatalf("expected full coverage at %d, got %d", i, a)
+		}
+	}
+
+	// half covered pixels on the borders
+	img = Rasterize(rect(50, 50, 250, 250), 100, 1)
+	if expected := image.Rect(0, -3, 3, 0); img.Rect != expected {
+		t.Fatalf("expected bounds %v, got %v", expected, img.Rect)
+	}
+	expected := []uint8{
+		0x40, 0x80, 0x40,
+		0x80, 0xFF, 0x80,
+		0x40, 0x80, 0x40,
+	}
+	for i, a := range img.Pix {
+		if d := int(a) - int(expected[i]); d < -1 || d > 1 {
+			t.Errorf("at %d, expected %d, got %d", i, expected[i], a)
+		}
+	}
+
+	if img := Rasterize(api.GlyphOutline{}, 1000, 10); !img.Rect.Empty() {
+		t.Errorf("expected empty image, got %v", img.Rect)
+	}
+}
+
+func TestRasterizeGlyph(t *testing.T) {
+	face, err := font.ParseTTF(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gid, _ := face.NominalGlyph('o')
+	outline := face.GlyphData(gid).(api.GlyphOutline)
+
+	var r Rasterizer
+	img := r.Rasterize(outline, 100/float32(face.Upem()))
+	if img.Rect.Dx() < 40 || img.Rect.Dy() < 40 || img.Rect.Max.Y > 2 {
+		t.Fatalf("unexpected bounds %v", img.Rect)
+	}
+	center := img.Rect.Min.Add(img.Rect.Max).Div(2)
+	if a := img.AlphaAt(center.X, center.Y).A; a != 0 {
+		t.Errorf("expected empty counter, got %d", a)
+	}
+	if a := img.AlphaAt(img.Rect.Min.X+2, center.Y).A; a != 0xFF {
+		t.Errorf("expected filled stem, got %d", a)
+	}
+	if a := img.AlphaAt(img.Rect.Min.X, img.Rect.Min.Y).A; a != 0 {
+		t.Errorf("expected empty corner, got %d", a)
+	}
+
+	// reuse the rasterizer
+	img2 := r.Rasterize(outline, 100/float32(face.Upem()))
+	if !bytes.Equal(img.Pix, img2.Pix) {
+		t.Error("rasterizer reuse changed the output")
+	}
+}
+
+func TestAddOutline(t *testing.T) {
+	face, err := font.ParseTTF(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gid, _ := face.NominalGlyph('g')
+	outline := face.GlyphData(gid).(api.GlyphOutline)
+	scale := 40 / float32(face.Upem())
+
+	// compare with the builtin rasterizer
+	ref := Rasterize(outline, face.Upem(), 40)
+	bounds := ref.Rect
+	z := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+	AddOutline(z, outline, scale, -float32(bounds.Min.X), -float32(bounds.Min.Y))
+	dst := image.NewAlpha(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	z.Draw(dst, dst.Bounds(), image.Opaque, image.Point{})
+
+	// the curves are flattened differently, so only check
+	// that the images are close
+	var diff, sum, sumRef int
+	for i := range dst.Pix {
+		d := int(dst.Pix[i]) - int(ref.Pix[i])
+		if d < -48 || d > 48 {
+			diff++
+		}
+		sum += int(dst.Pix[i])
+		sumRef += int(ref.Pix[i])
+	}
+	if diff != 0 {
+		t.Errorf("%d pixels differ", diff)
+	}
+	if d := sum - sumRef; d*100 > sumRef || -d*100 > sumRef {
+		t.Errorf("total coverage differ: %d %d", sum, sumRef)
+	}
+}
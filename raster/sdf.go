@@ -0,0 +1,448 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package raster
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/go-text/typesetting/opentype/api"
+)
+
+// SDF returns the signed distance field of [outline], where a font unit is
+// scaled by [scale] pixels (see [Rasterizer.Rasterize]).
+//
+// Each pixel stores the distance between its center and the closest point of the
+// outline, with a positive sign inside the glyph (using the non-zero winding rule).
+// The distances are mapped linearly from [-spread, spread] (in pixels) to [0, 255], so
+// that the edge of the glyph is at 127.5. The glyph bounds are padded by [spread].
+//
+// The bounds of the returned image follow the convention of [Rasterizer.Rasterize].
+func SDF(outline api.GlyphOutline, scale, spread float32) *image.Alpha {
+	df := newDistanceField(outline, scale, spread)
+	img := image.NewAlpha(df.bounds)
+	for y := 0; y < df.bounds.Dy(); y++ {
+		for x := 0; x < df.bounds.Dx(); x++ {
+			d := df.distance(point{float32(x) + 0.5, float32(y) + 0.5})
+			img.Pix[y*img.Stride+x] = df.encode(d)
+		}
+	}
+	return img
+}
+
+// MSDF returns the multi-channel signed distance field of [outline], which
+// preserves the sharp corners of the glyph when magnified. The edges of
+// the outline are distributed among the red, green and blue channels,
+// and the renderer should use the median of the three channels as the
+// distance (the alpha channel is always opaque).
+//
+// See [SDF] for the meaning of [scale] and [spread], and the encoding of the distances.
+func MSDF(outline api.GlyphOutline, scale, spread float32) *image.RGBA {
+	df := newDistanceField(outline, scale, spread)
+	df.colorEdges()
+	img := image.NewRGBA(df.bounds)
+	for y := 0; y < df.bounds.Dy(); y++ {
+		for x := 0; x < df.bounds.Dx(); x++ {
+			p := point{float32(x) + 0.5, float32(y) + 0.5}
+			r, g, b := df.pseudoDistances(p)
+			// fix the pixels whose median has the wrong sign,
+			// which happens when channels clash
+			if d := df.distance(p); (median(r, g, b) > 0) != (d > 0) {
+				r, g, b = d, d, d
+			}
+			img.SetRGBA(df.bounds.Min.X+x, df.bounds.Min.Y+y, color.RGBA{R: df.encode(r), G: df.encode(g), B: df.encode(b), A: 0xFF})
+		}
+	}
+	return img
+}
+
+// channel masks used by MSDF
+const (
+	red   = 1
+	green = 2
+	blue  = 4
+
+	white   = red | green | blue
+	cyan    = green | blue
+	magenta = red | blue
+	yellow  = red | green
+)
+
+// dfSegment is a line segment of a flattened outline
+type dfSegment struct {
+	a, b point
+	// color is the set of channels the segment contributes to
+	color uint8
+	// cornerStart and cornerEnd are true if the ends of the
+	// segment are corners of the outline
+	cornerStart, cornerEnd bool
+}
+
+// dfEdge is one segment of the outline, flattened
+type dfEdge struct {
+	// start and end are the tangent directions at the ends of the segment
+	start, end point
+	segments   []dfSegment
+}
+
+type distanceField struct {
+	contours [][]dfEdge
+	segments []dfSegment // all the segments, once colored
+	bounds   image.Rectangle
+	spread   float32
+	// orientation is 1 if the filled regions are on the left
+	// of the contours, -1 otherwise
+	orientation float32
+}
+
+func newDistanceField(outline api.GlyphOutline, scale, spread float32) *distanceField {
+	df := &distanceField{spread: spread}
+	if len(outline.Segments) == 0 {
+		return df
+	}
+
+	minX, minY := float32(math.Inf(+1)), float32(math.Inf(+1))
+	maxX, maxY := float32(math.Inf(-1)), float32(math.Inf(-1))
+	for i := range outline.Segments {
+		for _, p := range outline.Segments[i].ArgsSlice() {
+			x, y := p.X*scale, p.Y*scale
+			minX, maxX = min32(minX, x), max32(maxX, x)
+			minY, maxY = min32(minY, y), max32(maxY, y)
+		}
+	}
+	df.bounds = image.Rect(
+		int(math.Floor(float64(minX-spread))), -int(math.Ceil(float64(maxY+spread))),
+		int(math.Ceil(float64(maxX+spread))), -int(math.Floor(float64(minY-spread))),
+	)
+	toPixels := func(p api.SegmentPoint) point {
+		return point{p.X*scale - float32(df.bounds.Min.X), -p.Y*scale - float32(df.bounds.Min.Y)}
+	}
+
+	var (
+		contour          []dfEdge
+		start, current   point
+		maxArea, largest float32
+	)
+	closeContour := func() {
+		if current != start {
+			contour = append(contour, lineEdge(current, start))
+		}
+		if len(contour) == 0 {
+			return
+		}
+		var area float32
+		for _, e := range contour {
+			for _, s := range e.segments {
+				area += s.a.x*s.b.y - s.b.x*s.a.y
+			}
+		}
+		if abs32(area) > maxArea {
+			maxArea, largest = abs32(area), area
+		}
+		df.contours = append(df.contours, contour)
+		contour = nil
+	}
+	for i := range outline.Segments {
+		seg := &outline.Segments[i]
+		switch seg.Op {
+		case api.SegmentOpMoveTo:
+			closeContour()
+			start = toPixels(seg.Args[0])
+			current = start
+		case api.SegmentOpLineTo:
+			p1 := toPixels(seg.Args[0])
+			contour = append(contour, lineEdge(current, p1))
+			current = p1
+		case api.SegmentOpQuadTo:
+			p1, p2 := toPixels(seg.Args[0]), toPixels(seg.Args[1])
+			contour = append(contour, quadEdge(current, p1, p2))
+			current = p2
+		case api.SegmentOpCubeTo:
+			p1, p2, p3 := toPixels(seg.Args[0]), toPixels(seg.Args[1]), toPixels(seg.Args[2])
+			contour = append(contour, cubeEdge(current, p1, p2, p3))
+			current = p3
+		}
+	}
+	closeContour()
+
+	// the largest contour is assumed to be an outer contour
+	df.orientation = 1
+	if largest < 0 {
+		df.orientation = -1
+	}
+	for _, c := range df.contours {
+		for _, e := range c {
+			df.segments = append(df.segments, e.segments...)
+		}
+	}
+	return df
+}
+
+func sub(a, b point) point { return point{a.x - b.x, a.y - b.y} }
+
+// direction returns the first non zero vector
+func direction(vectors ...point) point {
+	for _, v := range vectors {
+		if v != (point{}) {
+			return v
+		}
+	}
+	return point{}
+}
+
+func lineEdge(p0, p1 point) dfEdge {
+	d := sub(p1, p0)
+	var segments []dfSegment
+	if d != (point{}) {
+		segments = []dfSegment{{a: p0, b: p1}}
+	}
+	return dfEdge{start: d, end: d, segments: segments}
+}
+
+// flattenEdge returns an edge made of the [points], starting from [p0]
+func flattenEdge(p0 point, points []point, start, end point) dfEdge {
+	e := dfEdge{start: start, end: end}
+	for _, p := range points {
+		if p != p0 {
+			e.segments = append(e.segments, dfSegment{a: p0, b: p})
+			p0 = p
+		}
+	}
+	return e
+}
+
+func quadEdge(p0, p1, p2 point) dfEdge {
+	dx, dy := p0.x-2*p1.x+p2.x, p0.y-2*p1.y+p2.y
+	dev := float32(math.Sqrt(float64(dx*dx+dy*dy))) / 4
+	n := 1 + int(math.Sqrt(float64(dev/tolerance)))
+	points := make([]point, 0, n)
+	for i := 1; i < n; i++ {
+		t := float32(i) / float32(n)
+		points = append(points, lerp(t, lerp(t, p0, p1), lerp(t, p1, p2)))
+	}
+	points = append(points, p2)
+	return flattenEdge(p0, points, direction(sub(p1, p0), sub(p2, p0)), direction(sub(p2, p1), sub(p2, p0)))
+}
+
+func cubeEdge(p0, p1, p2, p3 point) dfEdge {
+	dx0, dy0 := p0.x-2*p1.x+p2.x, p0.y-2*p1.y+p2.y
+	dx1, dy1 := p1.x-2*p2.x+p3.x, p1.y-2*p2.y+p3.y
+	dev := 0.75 * float32(math.Sqrt(float64(max32(dx0*dx0+dy0*dy0, dx1*dx1+dy1*dy1))))
+	n := 1 + int(math.Sqrt(float64(dev/tolerance)))
+	points := make([]point, 0, n)
+	for i := 1; i < n; i++ {
+		t := float32(i) / float32(n)
+		q0, q1, q2 := lerp(t, p0, p1), lerp(t, p1, p2), lerp(t, p2, p3)
+		points = append(points, lerp(t, lerp(t, q0, q1), lerp(t, q1, q2)))
+	}
+	points = append(points, p3)
+	return flattenEdge(p0, points,
+		direction(sub(p1, p0), sub(p2, p0), sub(p3, p0)),
+		direction(sub(p3, p2), sub(p3, p1), sub(p3, p0)))
+}
+
+// isCorner returns true if the directions [u] and [v] make an angle
+// larger than about 8 degrees.
+func isCorner(u, v point) bool {
+	lu, lv := float32(math.Hypot(float64(u.x), float64(u.y))), float32(math.Hypot(float64(v.x), float64(v.y)))
+	if lu == 0 || lv == 0 {
+		return false
+	}
+	dot := (u.x*v.x + u.y*v.y) / (lu * lv)
+	cross := (u.x*v.y - u.y*v.x) / (lu * lv)
+	return dot <= 0 || abs32(cross) > 0.14 // sin(3 radians)
+}
+
+// colorEdges assigns the channels of the segments, so that the
+// two sides of each corner share exactly one channel.
+func (df *distanceField) colorEdges() {
+	df.segments = df.segments[:0]
+	for _, contour := range df.contours {
+		var corners []int // index of the edge starting at the corner
+		for i, e := range contour {
+			prev := contour[(i+len(contour)-1)%len(contour)]
+			if isCorner(prev.end, e.start) {
+				corners = append(corners, i)
+			}
+		}
+
+		// flatten the segments, starting at the first corner
+		var segments []dfSegment
+		first := 0
+		if len(corners) != 0 {
+			first = corners[0]
+		}
+		for k := range contour {
+			i := (first + k) % len(contour)
+			segs := contour[i].segments
+			if len(segs) == 0 {
+				continue
+			}
+			start := len(segments)
+			segments = append(segments, segs...)
+			if len(corners) != 0 && isCorner(contour[(i+len(contour)-1)%len(contour)].end, contour[i].start) {
+				segments[start].cornerStart = true
+				if start != 0 {
+					segments[start-1].cornerEnd = true
+				}
+			}
+		}
+		if len(segments) == 0 {
+			continue
+		}
+		if segments[0].cornerStart {
+			segments[len(segments)-1].cornerEnd = true
+		}
+
+		switch len(corners) {
+		case 0: // smooth contour
+			for i := range segments {
+				segments[i].color = white
+			}
+		case 1: // teardrop : split the contour in three parts
+			for i := range segments {
+				segments[i].color = [3]uint8{magenta, white, yellow}[3*i/len(segments)]
+			}
+		default:
+			cycle := [3]uint8{cyan, magenta, yellow}
+			spline := 0
+			for i := range segments {
+				if i != 0 && segments[i].cornerStart {
+					spline++
+				}
+				segments[i].color = cycle[spline%3]
+			}
+			// the last spline is followed by the first one
+			if spline%3 == 0 {
+				for i := len(segments) - 1; i >= 0; i-- {
+					segments[i].color = cycle[1]
+					if segments[i].cornerStart {
+						break
+					}
+				}
+			}
+		}
+		df.segments = append(df.segments, segments...)
+	}
+}
+
+// encode maps the distance [d] to [0, 255]
+func (df *distanceField) encode(d float32) uint8 {
+	v := 0.5 + d/(2*df.spread)
+	if v < 0 {
+		v = 0
+	} else if v > 1 {
+		v = 1
+	}
+	return uint8(v*255 + 0.5)
+}
+
+// segmentDistance returns the distance between [p] and [s],
+// the parameter of the closest point, and its side (positive inside).
+func (df *distanceField) segmentDistance(s dfSegment, p point) (dist, t, side float32) {
+	d, ap := sub(s.b, s.a), sub(p, s.a)
+	t = (ap.x*d.x + ap.y*d.y) / (d.x*d.x + d.y*d.y)
+	tc := min32(1, max32(0, t))
+	q := sub(p, lerp(tc, s.a, s.b))
+	dist = float32(math.Hypot(float64(q.x), float64(q.y)))
+	side = df.orientation
+	if d.x*ap.y-d.y*ap.x < 0 {
+		side = -side
+	}
+	return dist, t, side
+}
+
+// distance returns the signed distance between [p] and the outline
+func (df *distanceField) distance(p point) float32 {
+	minDist := float32(math.Inf(+1))
+	winding := 0
+	for _, s := range df.segments {
+		if dist, _, _ := df.segmentDistance(s, p); dist < minDist {
+			minDist = dist
+		}
+		if s.a.y <= p.y {
+			if s.b.y > p.y && (s.b.x-s.a.x)*(p.y-s.a.y)-(s.b.y-s.a.y)*(p.x-s.a.x) > 0 {
+				winding++
+			}
+		} else if s.b.y <= p.y && (s.b.x-s.a.x)*(p.y-s.a.y)-(s.b.y-s.a.y)*(p.x-s.a.x) < 0 {
+			winding--
+		}
+	}
+	if winding == 0 {
+		return -minDist
+	}
+	return minDist
+}
+
+// pseudoDistances returns the signed distance between [p] and the segments
+// of each channel, where the segments ending at corners are extended
+// by their tangent lines.
+func (df *distanceField) pseudoDistances(p point) (r, g, b float32) {
+	var out [3]float32
+	for c, mask := range [3]uint8{red, green, blue} {
+		var (
+			best                         *dfSegment
+			minDist, bestOrtho, bestT, s float32
+		)
+		minDist = float32(math.Inf(+1))
+		for i := range df.segments {
+			seg := &df.segments[i]
+			if seg.color&mask == 0 {
+				continue
+			}
+			dist, t, side := df.segmentDistance(*seg, p)
+			if dist > minDist+1e-5 {
+				continue
+			}
+			// break ties (shared end points) with the most orthogonal segment
+			ortho := orthogonality(*seg, p, t)
+			if dist >= minDist-1e-5 && ortho <= bestOrtho {
+				continue
+			}
+			best, minDist, bestOrtho, bestT, s = seg, dist, ortho, t, side
+		}
+		if best == nil {
+			out[c] = -df.spread
+			continue
+		}
+		d := minDist * s
+		if (bestT < 0 && best.cornerStart) || (bestT > 1 && best.cornerEnd) {
+			if pd := df.lineDistance(*best, p); abs32(pd) <= minDist {
+				d = pd
+			}
+		}
+		out[c] = d
+	}
+	return out[0], out[1], out[2]
+}
+
+// orthogonality returns the sine of the angle between [s]
+// and the vector from its closest point to [p].
+func orthogonality(s dfSegment, p point, t float32) float32 {
+	d := sub(s.b, s.a)
+	q := sub(p, lerp(min32(1, max32(0, t)), s.a, s.b))
+	l := float32(math.Hypot(float64(d.x), float64(d.y)) * math.Hypot(float64(q.x), float64(q.y)))
+	if l == 0 {
+		return 1
+	}
+	return abs32(d.x*q.y-d.y*q.x) / l
+}
+
+// lineDistance returns the signed distance between [p] and the line supporting [s]
+func (df *distanceField) lineDistance(s dfSegment, p point) float32 {
+	d, ap := sub(s.b, s.a), sub(p, s.a)
+	l := float32(math.Hypot(float64(d.x), float64(d.y)))
+	return df.orientation * (d.x*ap.y - d.y*ap.x) / l
+}
+
+func median(a, b, c float32) float32 {
+	return max32(min32(a, b), min32(max32(a, b), c))
+}
+
+func abs32(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package raster
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// decode returns the distance encoded in [v]
+func decode(v uint8, spread float32) float32 { return (float32(v)/255 - 0.5) * 2 * spread }
+
+func TestSDFRect(t *testing.T) {
+	const spread = 4
+	img := SDF(rect(0, 0, 20, 10), 1, spread)
+	if expected := image.Rect(-4, -14, 24, 4); img.Rect != expected {
+		t.Fatalf("expected bounds %v, got %v", expected, img.Rect)
+	}
+	for _, test := range []struct {
+		x, y     int
+		expected float32
+	}{
+		{0, -1, 0.5}, // inside, close to the edges
+		{9, -5, 4},   // center, clamped
+		{-4, -5, -3.5},
+		{-1, -5, -0.5},    // outside
+		{-3, -5, -2.5},    // outside
+		{-3, -13, -3.536}, // diagonal of the corner
+		{21, 1, -2.121},
+	} {
+		got := decode(img.AlphaAt(test.x, test.y).A, spread)
+		if d := got - test.expected; d < -0.05 || d > 0.05 {
+			t.Errorf("at (%d, %d), expected %g, got %g", test.x, test.y, test.expected, got)
+		}
+	}
+
+	if img := SDF(api.GlyphOutline{}, 1, spread); !img.Rect.Empty() {
+		t.Errorf("expected empty image, got %v", img.Rect)
+	}
+}
+
+func TestMSDFRect(t *testing.T) {
+	const spread = 4
+	for _, outline := range []api.GlyphOutline{
+		rect(0, 0, 20, 10),
+		rect(0, 10, 20, 0), // clockwise
+	} {
+		img := MSDF(outline, 1, spread)
+		at := func(x, y int) float32 {
+			c := img.RGBAAt(x, y)
+			return median(decode(c.R, spread), decode(c.G, spread), decode(c.B, spread))
+		}
+		// the corners are preserved : the distance is the one to the tangent lines
+		for _, test := range []struct {
+			x, y     int
+			expected float32
+		}{
+			{21, 1, -1.5},
+			{-3, 1, -2.5},
+			{-3, -13, -2.5},
+			{21, -13, -2.5},
+			{9, -5, 4},
+			{-1, -5, -0.5},
+		} {
+			if got := at(test.x, test.y); got-test.expected < -0.05 || got-test.expected > 0.05 {
+				t.Errorf("at (%d, %d), expected %g, got %g", test.x, test.y, test.expected, got)
+			}
+		}
+	}
+}
+
+func TestDistanceFieldGlyph(t *testing.T) {
+	face, err := font.ParseTTF(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const spread = 3
+	for _, r := range "aBg&%" {
+		gid, _ := face.NominalGlyph(r)
+		glyph := face.GlyphData(gid).(api.GlyphOutline)
+		scale := api.Scale{Upem: face.Upem(), Ppem: 40}.Factor()
+		coverage := Rasterize(glyph, face.Upem(), 40)
+		sdf, msdf := SDF(glyph, scale, spread), MSDF(glyph, scale, spread)
+		// the inside of the glyph matches the coverage mask
+		for y := coverage.Rect.Min.Y; y < coverage.Rect.Max.Y; y++ {
+			for x := coverage.Rect.Min.X; x < coverage.Rect.Max.X; x++ {
+				a := coverage.AlphaAt(x, y).A
+				if a != 0 && a != 0xFF {
+					continue // edge pixel
+				}
+				inside := a == 0xFF
+				if d := sdf.AlphaAt(x, y).A; (d >= 128) != inside {
+					t.Errorf("%c: at (%d, %d), unexpected distance %d", r, x, y, d)
+				}
+				c := msdf.RGBAAt(x, y)
+				if d := median(float32(c.R), float32(c.G), float32(c.B)); (d >= 128) != inside {
+					t.Errorf("%c: at (%d, %d), unexpected MSDF distance %v", r, x, y, c)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package raster
+
+import (
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/vector"
+)
+
+// AddOutline adds the contours of [outline] to [z], so that it may be drawn
+// with the golang.org/x/image/vector package. It is an alternative to [Rasterizer].
+//
+// Font units are scaled by [scale] pixels (ppem / upem), the Y axis is flipped
+// to match the image coordinate system, and the glyph origin is placed at
+// ([originX], [originY]), in pixels, which is typically the dot position on the baseline.
+// Each contour is closed.
+func AddOutline(z *vector.Rasterizer, outline api.GlyphOutline, scale, originX, originY float32) {
+	toPixels := func(p api.SegmentPoint) (x, y float32) {
+		return originX + p.X*scale, originY - p.Y*scale
+	}
+	for i, seg := range outline.Segments {
+		switch seg.Op {
+		case api.SegmentOpMoveTo:
+			if i != 0 {
+				z.ClosePath()
+			}
+			z.MoveTo(toPixels(seg.Args[0]))
+		case api.SegmentOpLineTo:
+			z.LineTo(toPixels(seg.Args[0]))
+		case api.SegmentOpQuadTo:
+			bx, by := toPixels(seg.Args[0])
+			cx, cy := toPixels(seg.Args[1])
+			z.QuadTo(bx, by, cx, cy)
+		case api.SegmentOpCubeTo:
+			bx, by := toPixels(seg.Args[0])
+			cx, cy := toPixels(seg.Args[1])
+			dx, dy := toPixels(seg.Args[2])
+			z.CubeTo(bx, by, cx, cy, dx, dy)
+		}
+	}
+	if len(outline.Segments) != 0 {
+		z.ClosePath()
+	}
+}
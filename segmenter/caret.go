@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package segmenter
+
+// IsCaretPosition returns true if the caret may be placed
+// before the rune at index [pos], that is if [pos] is a grapheme
+// cluster boundary. The start and the end of the text are always
+// valid positions.
+//
+// [Init] must have been called before.
+func (seg *Segmenter) IsCaretPosition(pos int) bool {
+	if pos < 0 || pos > len(seg.text) {
+		return false
+	}
+	return seg.attributes[pos]&aGraphemeBoundary != 0
+}
+
+// NextCaretPosition returns the first caret position strictly after [pos],
+// so that combining marks, Hangul syllables, emoji sequences and flags
+// are never split. It returns len(text) if [pos] is at (or past) the end of the text.
+//
+// Positions are rune indices into the text passed to [Init], which
+// are the indices used by the Runes field of shaped outputs.
+func (seg *Segmenter) NextCaretPosition(pos int) int {
+	if pos < 0 {
+		pos = -1
+	}
+	for pos++; pos < len(seg.text); pos++ {
+		if seg.attributes[pos]&aGraphemeBoundary != 0 {
+			return pos
+		}
+	}
+	return len(seg.text)
+}
+
+// PreviousCaretPosition returns the last caret position strictly before [pos],
+// or 0 if [pos] is at (or before) the start of the text.
+// See [NextCaretPosition] for more details.
+func (seg *Segmenter) PreviousCaretPosition(pos int) int {
+	if pos > len(seg.text) {
+		pos = len(seg.text) + 1
+	}
+	for pos--; pos > 0; pos-- {
+		if seg.attributes[pos]&aGraphemeBoundary != 0 {
+			return pos
+		}
+	}
+	return 0
+}
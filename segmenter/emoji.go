@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package segmenter
+
+import (
+	"unicode"
+
+	ucd "github.com/go-text/typesetting/unicodedata"
+)
+
+const (
+	zeroWidthJoiner     = 0x200D
+	textPresentation    = 0xFE0E // VS15
+	emojiPresentation   = 0xFE0F // VS16
+	combiningKeycap     = 0x20E3
+	blackFlag           = 0x1F3F4 // the base of the tag sequences
+	cancelTag           = 0xE007F
+	tagSpecStart        = 0xE0020
+	tagSpecEnd          = 0xE007E
+	regionalIndicatorLo = 0x1F1E6
+	regionalIndicatorHi = 0x1F1FF
+)
+
+func isRegionalIndicator(r rune) bool { return regionalIndicatorLo <= r && r <= regionalIndicatorHi }
+
+func isKeycapBase(r rune) bool { return '0' <= r && r <= '9' || r == '#' || r == '*' }
+
+// EmojiSequence is an emoji sequence, as defined by
+// UTS#51 (https://unicode.org/reports/tr51/#Emoji_Sequences).
+type EmojiSequence struct {
+	// Text is a subslice of the original input slice, containing the sequence
+	Text []rune
+	// Offset is the start of the sequence in the input rune slice
+	Offset int
+	// IsEmojiPresentation is true if the sequence should be displayed
+	// with the emoji (colorful) presentation, either by default
+	// or explicitly, with a variation selector.
+	// It is false for emoji characters defaulting to, or explicitly requesting,
+	// the text presentation.
+	IsEmojiPresentation bool
+}
+
+// parseEmojiElement parses an emoji_zwj_element starting at text[i],
+// returning the end of the element (exclusive) and its presentation,
+// or end = i if text[i] does not start an emoji.
+func parseEmojiElement(text []rune, i int) (end int, isEmojiPres bool) {
+	r := text[i]
+	end = i + 1
+	switch {
+	case isRegionalIndicator(r): // emoji_flag_sequence
+		if end < len(text) && isRegionalIndicator(text[end]) {
+			end++
+		}
+		return end, true
+	case isKeycapBase(r): // emoji_keycap_sequence, or plain text
+		if end < len(text) && text[end] == emojiPresentation {
+			end++
+		}
+		if end < len(text) && text[end] == combiningKeycap {
+			return end + 1, true
+		}
+		if end == i+2 { // emoji_presentation_sequence
+			return end, true
+		}
+		return i, false
+	case unicode.Is(ucd.Emoji, r):
+		isEmojiPres = unicode.Is(ucd.Emoji_Presentation, r)
+		if end < len(text) {
+			switch next := text[end]; {
+			case unicode.Is(ucd.Emoji_Modifier_Base, r) && unicode.Is(ucd.Emoji_Modifier, next): // emoji_modifier_sequence
+				end++
+				isEmojiPres = true
+			case next == emojiPresentation: // emoji_presentation_sequence
+				end++
+				isEmojiPres = true
+			case next == textPresentation: // text_presentation_sequence
+				end++
+				isEmojiPres = false
+			}
+		}
+		if r == blackFlag { // emoji_tag_sequence
+			j := end
+			for j < len(text) && tagSpecStart <= text[j] && text[j] <= tagSpecEnd {
+				j++
+			}
+			if j > end && j < len(text) && text[j] == cancelTag {
+				end = j + 1
+				isEmojiPres = true
+			}
+		}
+		return end, isEmojiPres
+	default:
+		return i, false
+	}
+}
+
+// parseEmojiSequence parses an emoji sequence, possibly joined
+// by ZWJ, starting at text[i], returning end = i if text[i] does not start an emoji.
+func parseEmojiSequence(text []rune, i int) (end int, isEmojiPres bool) {
+	end, isEmojiPres = parseEmojiElement(text, i)
+	if end == i {
+		return end, false
+	}
+	for end+1 < len(text) && text[end] == zeroWidthJoiner {
+		next, _ := parseEmojiElement(text, end+1)
+		if next == end+1 { // not an emoji : do not include the ZWJ
+			break
+		}
+		// emoji_zwj_sequence are always displayed as emoji
+		end, isEmojiPres = next, true
+	}
+	return end, isEmojiPres
+}
+
+// EmojiIterator provides a convenient way of
+// iterating over the emoji sequences of a text.
+// Runes which are not part of an emoji sequence are skipped.
+type EmojiIterator struct {
+	text    []rune
+	pos     int
+	current EmojiSequence
+}
+
+// Next returns true if there is still an emoji sequence to process,
+// and advances the iterator; or return false.
+func (ei *EmojiIterator) Next() bool {
+	for ei.pos < len(ei.text) {
+		start := ei.pos
+		end, isEmojiPres := parseEmojiSequence(ei.text, start)
+		if end == start {
+			ei.pos++
+			continue
+		}
+		ei.pos = end
+		ei.current = EmojiSequence{Offset: start, Text: ei.text[start:end], IsEmojiPresentation: isEmojiPres}
+		return true
+	}
+	return false
+}
+
+// Sequence returns the current `EmojiSequence`
+func (ei *EmojiIterator) Sequence() EmojiSequence { return ei.current }
+
+// EmojiIterator returns an iterator over the emoji sequences
+// of the text given in [Init].
+func (sg *Segmenter) EmojiIterator() *EmojiIterator {
+	return &EmojiIterator{text: sg.text}
+}
+
+// NewEmojiIterator returns an iterator over the emoji sequences of [text].
+// Contrary to [Segmenter.EmojiIterator], it does not require to compute
+// the segmentation of the text.
+func NewEmojiIterator(text []rune) *EmojiIterator {
+	return &EmojiIterator{text: text}
+}
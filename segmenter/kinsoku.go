@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package segmenter
+
+import "strings"
+
+// Kinsoku selects the East Asian line breaking prohibitions
+// ("kinsoku shori") applied on top of the UAX#14 rules.
+//
+// The character classes follow the Requirements for Japanese Text Layout
+// (https://www.w3.org/TR/jlreq/#line_breaking_rules).
+// Note that most of these prohibitions are already implied by the
+// UAX#14 rules, but not after spaces (rule LB18).
+type Kinsoku uint8
+
+const (
+	// KinsokuNone only applies the UAX#14 rules.
+	KinsokuNone Kinsoku = iota
+	// KinsokuNormal forbids lines starting with closing brackets,
+	// dividing punctuation, middle dots, full stops and commas,
+	// and lines ending with opening brackets.
+	KinsokuNormal
+	// KinsokuStrict extends [KinsokuNormal], also forbidding
+	// lines starting with hyphens, iteration marks, the prolonged sound mark
+	// and small kana.
+	KinsokuStrict
+)
+
+const (
+	// runes which may not start a line (JLREQ cl-02, cl-04, cl-05, cl-06, cl-07)
+	kinsokuNoStartNormal = "’”）〕］｝〉》」』】〙〗〟｠»)]}" + // closing brackets
+		"？！‼⁇⁈⁉?!" + // dividing punctuation
+		"・：；:;" + // middle dots
+		"。." + // full stops
+		"、，," // commas
+
+	// JLREQ cl-03, also used by [LineNormal]
+	cjkHyphens = "‐〜゠–"
+	// JLREQ cl-09, also used by [LineLoose]
+	cjkIterationMarks = "ヽヾゝゞ々〻"
+
+	// runes which may not start a line, in strict mode (JLREQ cl-03, cl-09, cl-10, cl-11)
+	kinsokuNoStartStrict = cjkHyphens +
+		cjkIterationMarks +
+		"ー" + // prolonged sound mark
+		"ぁぃぅぇぉっゃゅょゎゕゖァィゥェォッャュョヮヵヶ" + // small kana
+		"ㇰㇱㇲㇳㇴㇵㇶㇷㇸㇹㇺㇻㇼㇽㇾㇿ" +
+		"ｧｨｩｪｫｬｭｮｯｰ"
+
+	// runes which may not end a line (JLREQ cl-01)
+	kinsokuNoEnd = "‘“（〔［｛〈《「『【〘〖〝｟«([{"
+)
+
+// isNoStart returns true if the rune is not allowed at the start of a line.
+func (k Kinsoku) isNoStart(r rune) bool {
+	switch k {
+	case KinsokuNormal:
+		return strings.ContainsRune(kinsokuNoStartNormal, r)
+	case KinsokuStrict:
+		return strings.ContainsRune(kinsokuNoStartNormal, r) || strings.ContainsRune(kinsokuNoStartStrict, r)
+	default:
+		return false
+	}
+}
+
+// isNoEnd returns true if the rune is not allowed at the end of a line.
+func (k Kinsoku) isNoEnd(r rune) bool {
+	return k != KinsokuNone && strings.ContainsRune(kinsokuNoEnd, r)
+}
+
+// forbidsBreak returns true if the rules forbid a break before text[i],
+// with 0 < i < len(text).
+func (k Kinsoku) forbidsBreak(text []rune, i int) bool {
+	return k.isNoEnd(text[i-1]) || k.isNoStart(text[i])
+}
+
+// applyKinsoku removes the (non mandatory) line break opportunities
+// forbidden by the given rules.
+func applyKinsoku(text []rune, attributes []runeAttr, k Kinsoku) {
+	if k == KinsokuNone {
+		return
+	}
+	// the first and last attributes are fixed by rules LB2 and LB3
+	for i := 1; i < len(text); i++ {
+		attr := attributes[i]
+		if attr&aLineBreak == 0 || attr&aMandatoryBreak != 0 {
+			continue
+		}
+		if k.forbidsBreak(text, i) {
+			attributes[i] = attr &^ aLineBreak
+		}
+	}
+}
@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+//go:build !race
+// +build !race
+
+package segmenter
+
+const raceEnabled = false
@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+//go:build race
+// +build race
+
+package segmenter
+
+// raceEnabled is true when the race detector is on,
+// which adds allocations to the instrumented code.
+const raceEnabled = true
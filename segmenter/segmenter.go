@@ -14,6 +14,7 @@ package segmenter
 
 import (
 	"unicode"
+	"unicode/utf8"
 
 	ucd "github.com/go-text/typesetting/unicodedata"
 )
@@ -29,6 +30,12 @@ const (
 	// this flag is on if the cursor can appear in front of a character.
 	// i.e. if we are at a grapheme boundary.
 	aGraphemeBoundary
+
+	// this flag is on if we are at a word boundary.
+	aWordBoundary
+
+	// this flag is on if we are at a sentence boundary.
+	aSentenceBoundary
 )
 
 const paragraphSeparator rune = 0x2029
@@ -41,6 +48,14 @@ type lineBreakClass = *unicode.RangeTable
 // See https://unicode.org/reports/tr29/#Grapheme_Cluster_Break_Property_Values
 type graphemeBreakClass = *unicode.RangeTable
 
+// wordBreakClass stores the Unicode Word Break Property
+// See https://unicode.org/reports/tr29/#Word_Boundaries
+type wordBreakClass = *unicode.RangeTable
+
+// sentenceBreakClass stores the Unicode Sentence Break Property
+// See https://unicode.org/reports/tr29/#Sentence_Boundaries
+type sentenceBreakClass = *unicode.RangeTable
+
 // cursor holds the information for the current index
 // processed by `computeAttributes`, that is
 // the context provided by previous and next runes in the text
@@ -83,12 +98,30 @@ type cursor struct {
 	// are we in an emoji sequence, as defined in rule GB11
 	// see [updatePictoSequence]
 	pictoSequence pictoSequenceState
+
+	prevPrevWord wordBreakClass // the Word Break Class at index i-2 (see rule WB4 for edge cases)
+	prevWord     wordBreakClass // the Word Break Class at index i-1 (see rule WB4 for edge cases)
+	word         wordBreakClass // the Word Break Class at index i
+
+	// true if the `prev` rune was an odd Regional_Indicator, false if it was even or not an RI
+	// used for rules WB15 and WB16
+	// see [updateWordRIOdd]
+	isPrevWordRIOdd bool
+
+	prevPrevSentence sentenceBreakClass // the Sentence Break Class at index i-2 (see rule SB5 for edge cases)
+	prevSentence     sentenceBreakClass // the Sentence Break Class at index i-1 (see rule SB5 for edge cases)
+	sentence         sentenceBreakClass // the Sentence Break Class at index i
+
+	// are we after a sentence terminator, as defined in rules SB8 to SB11,
+	// and which one (ATerm or STerm)
+	sentenceTerm      sentenceTermState
+	sentenceTermClass sentenceBreakClass
 }
 
-// initialise the cursor properties
-// some of them are set in [startIteration]
-func newCursor(text []rune) *cursor {
-	cr := cursor{
+// reset initialises the cursor properties,
+// some of them being set in [startIteration]
+func (cr *cursor) reset(text []rune) {
+	*cr = cursor{
 		prevPrevLine: ucd.BreakXX,
 	}
 
@@ -98,7 +131,6 @@ func newCursor(text []rune) *cursor {
 	if len(text) != 0 {
 		cr.nextLine = ucd.LookupLineBreakClass(text[0])
 	}
-	return &cr
 }
 
 // computeAttributes does the heavy lifting of the segmentation,
@@ -108,8 +140,13 @@ func newCursor(text []rune) *cursor {
 // which will be filled at index i by the attribute describing the
 // break between rune at index i-1 and index i
 //
-// Unicode defines a lot of properties; for now we only handle
-// graphemes and line breaking
+// `lineClasses` has length len(text) and is filled with the
+// Line Break Class of each rune, resolved by rule LB1.
+//
+// Unicode defines a lot of properties; this function handles
+// graphemes and line breaking, which are required by the line iterator.
+// Words and sentences, less often needed, are computed on demand
+// by [computeWordAttributes] and [computeSentenceAttributes].
 //
 // The rules are somewhat complex, but the general logic is pretty simple:
 // iterate through the input slice, fetch context information
@@ -117,9 +154,11 @@ func newCursor(text []rune) *cursor {
 // and finaly apply them.
 // Some rules require variable length lookup, which we handle by keeping
 // a state in a [cursor] object.
-func computeAttributes(text []rune, attributes []runeAttr) {
+// The state is stored in `cr`, which is reset first, so that
+// it may be reused between calls.
+func computeAttributes(text []rune, attributes []runeAttr, lineClasses []lineBreakClass, cr *cursor) {
 	// initialise the cursor properties
-	cr := newCursor(text)
+	cr.reset(text)
 
 	for i := 0; i <= len(text); i++ { // note that we accept i == len(text) to fill the last attribute
 		cr.startIteration(text, i)
@@ -136,6 +175,9 @@ func computeAttributes(text []rune, attributes []runeAttr) {
 		// UAX#14 Line Breaking
 
 		bo := cr.applyLineBreakingRules()
+		if i < len(text) {
+			lineClasses[i] = cr.line
+		}
 		switch bo {
 		case breakEmpty:
 			// rule LB31 : default to allow line break
@@ -166,6 +208,41 @@ func computeAttributes(text []rune, attributes []runeAttr) {
 	attributes[len(text)] |= aMandatoryBreak // Rule LB3
 }
 
+// computeWordAttributes adds the UAX#29 word boundaries to [attributes],
+// which must have been filled by [computeAttributes].
+func computeWordAttributes(text []rune, attributes []runeAttr, cr *cursor) {
+	*cr = cursor{}
+	for i := 0; i <= len(text); i++ {
+		cr.startRune(text, i)
+		cr.isExtentedPic = unicode.Is(ucd.Extended_Pictographic, cr.r)
+		cr.word = ucd.LookupWordBreakClass(cr.r)
+
+		if cr.applyWordBoundaryRules(text, i) {
+			attributes[i] |= aWordBoundary
+		}
+		cr.endWordIteration(i == 0)
+	}
+	attributes[0] |= aWordBoundary         // Rule WB1
+	attributes[len(text)] |= aWordBoundary // Rule WB2
+}
+
+// computeSentenceAttributes adds the UAX#29 sentence boundaries to [attributes],
+// which must have been filled by [computeAttributes].
+func computeSentenceAttributes(text []rune, attributes []runeAttr, cr *cursor) {
+	*cr = cursor{}
+	for i := 0; i <= len(text); i++ {
+		cr.startRune(text, i)
+		cr.sentence = ucd.LookupSentenceBreakClass(cr.r)
+
+		if cr.applySentenceBoundaryRules(text, i) {
+			attributes[i] |= aSentenceBoundary
+		}
+		cr.endSentenceIteration(i == 0)
+	}
+	attributes[0] |= aSentenceBoundary         // Rule SB1
+	attributes[len(text)] |= aSentenceBoundary // Rule SB2
+}
+
 // Segmenter is the entry point of the package.
 //
 // Usage :
@@ -176,7 +253,22 @@ func computeAttributes(text []rune, attributes []runeAttr) {
 //	for iter.Next() {
 //	  ... // do something with iter.Line()
 //	}
+//
+// A Segmenter may be reused for several paragraphs : its internal
+// buffers are then recycled, avoiding allocations.
 type Segmenter struct {
+	// Kinsoku selects additional East Asian line breaking prohibitions,
+	// used in [Init]. The zero value only applies the UAX#14 rules.
+	Kinsoku Kinsoku
+	// Strictness selects the CSS line-break level applied to
+	// Chinese and Japanese text, used in [Init]. The zero value
+	// only applies the UAX#14 rules. The breaks it allows are
+	// still subject to [Segmenter.Kinsoku].
+	Strictness LineStrictness
+	// WordBreak selects how line breaks are allowed inside words,
+	// used in [Init]. The zero value only applies the UAX#14 rules.
+	WordBreak WordBreakMode
+
 	text []rune
 	// with length len(text) + 1 :
 	// the attribute at indice i is about the
@@ -186,14 +278,56 @@ type Segmenter struct {
 	// 	text : 			[b, 		u, 	l, 	l]
 	// 	attributes :	[<start> b, b u, u l, l l, l <end>]
 	attributes []runeAttr
+	// with length len(text) : the resolved Line Break Class
+	// of each rune
+	lineClasses []lineBreakClass
+	// cursor is the state used by computeAttributes,
+	// stored here to avoid allocations
+	cursor cursor
+	// hasWords and hasSentences are true when the word and sentence
+	// boundaries have been added to attributes, which is only done when
+	// the corresponding iterators are requested
+	hasWords, hasSentences bool
 }
 
 // Init resets the segmenter storage with the given input,
 // and computes the attributes required to segment the text.
 func (seg *Segmenter) Init(paragraph []rune) {
 	seg.text = append(seg.text[:0], paragraph...)
-	seg.attributes = append(seg.attributes[:0], make([]runeAttr, len(paragraph)+1)...)
-	computeAttributes(seg.text, seg.attributes)
+	seg.init()
+}
+
+// InitString is the same as [Init], but accepts an UTF-8 encoded string.
+// Positions reported by the iterators are still rune indices.
+func (seg *Segmenter) InitString(paragraph string) {
+	seg.text = seg.text[:0]
+	for _, r := range paragraph {
+		seg.text = append(seg.text, r)
+	}
+	seg.init()
+}
+
+// InitBytes is the same as [InitString], but accepts an UTF-8 encoded byte slice,
+// which is not retained.
+// Positions reported by the iterators are still rune indices.
+func (seg *Segmenter) InitBytes(paragraph []byte) {
+	seg.text = seg.text[:0]
+	for len(paragraph) != 0 {
+		r, size := utf8.DecodeRune(paragraph)
+		seg.text = append(seg.text, r)
+		paragraph = paragraph[size:]
+	}
+	seg.init()
+}
+
+func (seg *Segmenter) init() {
+	seg.attributes = append(seg.attributes[:0], make([]runeAttr, len(seg.text)+1)...)
+	seg.lineClasses = append(seg.lineClasses[:0], make([]lineBreakClass, len(seg.text))...)
+	seg.hasWords, seg.hasSentences = false, false
+	computeAttributes(seg.text, seg.attributes, seg.lineClasses, &seg.cursor)
+	applyStrictness(seg.text, seg.attributes, seg.lineClasses, seg.Strictness, seg.Kinsoku)
+	applyWordBreakMode(seg.text, seg.attributes, seg.lineClasses, seg.WordBreak)
+	applyKinsoku(seg.text, seg.attributes, seg.Kinsoku)
 }
 
 // attributeIterator is an helper type used to
@@ -257,6 +391,129 @@ func (sg *Segmenter) LineIterator() *LineIterator {
 	return &LineIterator{attributeIterator: attributeIterator{src: sg, flag: aLineBreak}}
 }
 
+// BreakIterator provides a convenient way of
+// iterating over the line break opportunities delimited by a `Segmenter`.
+type BreakIterator struct {
+	attributeIterator
+}
+
+// Next returns true if there is still a break opportunity to process,
+// and advances the iterator; or return false.
+func (bi *BreakIterator) Next() bool { return bi.next() }
+
+// Break returns the current `Break`
+func (bi *BreakIterator) Break() Break {
+	out := Break{
+		Position:    bi.pos,
+		IsMandatory: bi.src.attributes[bi.pos]&aMandatoryBreak != 0,
+		Before:      bi.src.lineClasses[bi.pos-1],
+		Kind:        bi.src.breakKind(bi.pos),
+	}
+	if bi.pos < len(bi.src.text) {
+		out.After = bi.src.lineClasses[bi.pos]
+	}
+	return out
+}
+
+// Break is a line break opportunity found by the segmenter.
+type Break struct {
+	// Before and After are the Line Break Classes of the
+	// runes surrounding the break, after resolution of the
+	// ambiguous classes (rule LB1). They may be compared
+	// to the ucd.BreakXX tables of the unicodedata package.
+	// After is nil for the break at the end of the text.
+	Before, After *unicode.RangeTable
+
+	// Position is the index in the input rune slice
+	// of the first rune after the break; that is, the break occurs
+	// between text[Position-1] and text[Position].
+	// The end of the text is always reported as a (mandatory) break.
+	Position int
+
+	// IsMandatory is true if breaking is mandatory
+	IsMandatory bool
+
+	// Kind classifies the break, so that line breaking algorithms
+	// may prefer some breaks over others.
+	Kind BreakKind
+}
+
+// BreakIterator returns an iterator over the line break
+// opportunities delimited in [Init].
+func (sg *Segmenter) BreakIterator() *BreakIterator {
+	return &BreakIterator{attributeIterator: attributeIterator{src: sg, flag: aLineBreak}}
+}
+
+// EmergencyBreakIterator returns an iterator over all the grapheme cluster
+// boundaries delimited in [Init], which may be used to break lines when
+// no line break opportunity is available.
+// The boundaries which are not line break opportunities are
+// reported with the [BreakEmergency] kind.
+func (sg *Segmenter) EmergencyBreakIterator() *BreakIterator {
+	return &BreakIterator{attributeIterator: attributeIterator{src: sg, flag: aGraphemeBoundary}}
+}
+
+// BreakKind classifies a line break opportunity. Apart from [BreakMandatory],
+// the kinds are sorted from the most to the least desirable break.
+type BreakKind uint8
+
+const (
+	// BreakMandatory is a forced break, like after a new line.
+	BreakMandatory BreakKind = iota
+	// BreakSpace is a break after a space separating words
+	// (including zero width spaces).
+	BreakSpace
+	// BreakHyphen is a break after a hyphen or a soft hyphen.
+	BreakHyphen
+	// BreakIdeographic is a break before or after an ideograph
+	// (or a similar character, like Hangul syllables or kana), which
+	// do not use spaces to separate words.
+	BreakIdeographic
+	// BreakOther is a break allowed by the other rules, like after a slash
+	// or before an opening parenthesis.
+	BreakOther
+	// BreakEmergency is a break between grapheme clusters which is not a line break
+	// opportunity, only reported by [Segmenter.EmergencyBreakIterator].
+	BreakEmergency
+)
+
+// breakKind classifies the break before the rune at [pos]
+func (sg *Segmenter) breakKind(pos int) BreakKind {
+	attr := sg.attributes[pos]
+	if attr&aMandatoryBreak != 0 {
+		return BreakMandatory
+	}
+	if attr&aLineBreak == 0 {
+		return BreakEmergency
+	}
+	before := sg.lineClasses[pos-1]
+	switch before {
+	case ucd.BreakSP, ucd.BreakZW:
+		return BreakSpace
+	case ucd.BreakHY:
+		return BreakHyphen
+	case ucd.BreakBA:
+		if r := sg.text[pos-1]; r == 0x00AD || unicode.Is(unicode.Pd, r) {
+			return BreakHyphen
+		}
+	}
+	if isIdeographic(before) || (pos < len(sg.text) && isIdeographic(sg.lineClasses[pos])) {
+		return BreakIdeographic
+	}
+	return BreakOther
+}
+
+// isIdeographic returns true for the classes of the characters
+// written without spaces between words
+func isIdeographic(class lineBreakClass) bool {
+	switch class {
+	case ucd.BreakID, ucd.BreakCJ, ucd.BreakNS, ucd.BreakH2, ucd.BreakH3,
+		ucd.BreakJL, ucd.BreakJV, ucd.BreakJT:
+		return true
+	}
+	return false
+}
+
 // GraphemeIterator provides a convenient way of
 // iterating over the graphemes delimited by a `Segmenter`.
 type GraphemeIterator struct {
@@ -288,3 +545,136 @@ type Grapheme struct {
 func (sg *Segmenter) GraphemeIterator() *GraphemeIterator {
 	return &GraphemeIterator{attributeIterator: attributeIterator{src: sg, flag: aGraphemeBoundary}}
 }
+
+// WordKind is a coarse category of a word,
+// useful to implement selection and caret movement.
+type WordKind uint8
+
+const (
+	// WordOther is used for segments containing
+	// symbols, emojis, controls, etc...
+	WordOther WordKind = iota
+	// WordLetter is used for segments containing at least one letter
+	WordLetter
+	// WordNumber is used for segments containing digits but no letters
+	WordNumber
+	// WordPunctuation is used for segments made of punctuation
+	WordPunctuation
+	// WordSpace is used for segments made of white spaces
+	WordSpace
+)
+
+// wordKind returns the category of the given segment
+func wordKind(text []rune) WordKind {
+	var hasNumber, hasPunct, hasSpace, hasOther bool
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r):
+			return WordLetter
+		case unicode.IsNumber(r):
+			hasNumber = true
+		case unicode.IsPunct(r):
+			hasPunct = true
+		case unicode.IsSpace(r):
+			hasSpace = true
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+			// marks and format controls do not change the category
+		default:
+			hasOther = true
+		}
+	}
+	switch {
+	case hasNumber:
+		return WordNumber
+	case hasOther:
+		return WordOther
+	case hasPunct:
+		return WordPunctuation
+	case hasSpace:
+		return WordSpace
+	default:
+		return WordOther
+	}
+}
+
+// WordIterator provides a convenient way of
+// iterating over the words delimited by a `Segmenter`.
+type WordIterator struct {
+	attributeIterator
+}
+
+// Next returns true if there is still a word to process,
+// and advances the iterator; or return false.
+func (wr *WordIterator) Next() bool { return wr.next() }
+
+// Word returns the current `Word`
+func (wr *WordIterator) Word() Word {
+	text := wr.src.text[wr.lastBreak:wr.pos]
+	return Word{
+		Offset: wr.lastBreak,
+		Text:   text,
+		Kind:   wordKind(text),
+	}
+}
+
+// Word is the content of a segment delimited by the word boundaries
+// rules of the segmenter. Note that, following UAX#29, spaces and
+// punctuation are also returned as (separate) words : use [Word.Kind]
+// to filter them.
+type Word struct {
+	// Text is a subslice of the original input slice, containing the delimited word
+	Text []rune
+	// Offset is the start of the word in the input rune slice
+	Offset int
+	// Kind is a coarse category of the word content
+	Kind WordKind
+}
+
+// WordIterator returns an iterator over the words
+// delimited in [Init].
+// The word boundaries are computed on the first call after [Init].
+func (sg *Segmenter) WordIterator() *WordIterator {
+	if !sg.hasWords {
+		computeWordAttributes(sg.text, sg.attributes, &sg.cursor)
+		sg.hasWords = true
+	}
+	return &WordIterator{attributeIterator: attributeIterator{src: sg, flag: aWordBoundary}}
+}
+
+// SentenceIterator provides a convenient way of
+// iterating over the sentences delimited by a `Segmenter`.
+type SentenceIterator struct {
+	attributeIterator
+}
+
+// Next returns true if there is still a sentence to process,
+// and advances the iterator; or return false.
+func (sr *SentenceIterator) Next() bool { return sr.next() }
+
+// Sentence returns the current `Sentence`
+func (sr *SentenceIterator) Sentence() Sentence {
+	return Sentence{
+		Offset: sr.lastBreak,
+		Text:   sr.src.text[sr.lastBreak:sr.pos],
+	}
+}
+
+// Sentence is the content of a sentence delimited by the segmenter.
+// The trailing spaces and paragraph separators are included.
+type Sentence struct {
+	// Text is a subslice of the original input slice, containing the delimited sentence
+	Text []rune
+	// Offset is the start of the sentence in the input rune slice
+	Offset int
+}
+
+// SentenceIterator returns an iterator over the sentences
+// delimited in [Init].
+// The sentence boundaries are computed on the first call after [Init].
+func (sg *Segmenter) SentenceIterator() *SentenceIterator {
+	if !sg.hasSentences {
+		computeSentenceAttributes(sg.text, sg.attributes, &sg.cursor)
+		sg.hasSentences = true
+	}
+	return &SentenceIterator{attributeIterator: attributeIterator{src: sg, flag: aSentenceBoundary}}
+}
@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+
+	ucd "github.com/go-text/typesetting/unicodedata"
 )
 
 func hex(rs []rune) string {
@@ -148,3 +150,368 @@ func BenchmarkSegmentUnicodeReference(b *testing.B) {
 		}
 	}
 }
+
+func TestBreakIterator(t *testing.T) {
+	var seg Segmenter
+	seg.InitString("a b\nc-d")
+	iter := seg.BreakIterator()
+	var got []Break
+	for iter.Next() {
+		got = append(got, iter.Break())
+	}
+	expected := []Break{
+		{Position: 2, Before: ucd.BreakSP, After: ucd.BreakAL, Kind: BreakSpace},
+		{Position: 4, Before: ucd.BreakLF, After: ucd.BreakAL, IsMandatory: true, Kind: BreakMandatory},
+		{Position: 6, Before: ucd.BreakHY, After: ucd.BreakAL, Kind: BreakHyphen},
+		{Position: 7, Before: ucd.BreakAL, IsMandatory: true, Kind: BreakMandatory},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	// ambiguous classes are resolved
+	seg.Init([]rune{'§', 'a'})
+	iter = seg.BreakIterator()
+	iter.Next()
+	if b := iter.Break(); b.Before != ucd.BreakAL {
+		t.Errorf("expected AL class for ambiguous rune")
+	}
+}
+
+func TestBreakKind(t *testing.T) {
+	collectKinds := func(iter *BreakIterator) (positions []int, kinds []BreakKind) {
+		for iter.Next() {
+			b := iter.Break()
+			positions = append(positions, b.Position)
+			kinds = append(kinds, b.Kind)
+		}
+		return positions, kinds
+	}
+
+	var seg Segmenter
+	// a space, a soft hyphen, a slash, ideographs, a new line
+	seg.InitString("ab cd\u00ADef/gh\u4E00\u4E8C\n")
+	positions, kinds := collectKinds(seg.BreakIterator())
+	expectedPositions := []int{3, 6, 9, 11, 12, 14}
+	expectedKinds := []BreakKind{BreakSpace, BreakHyphen, BreakOther, BreakIdeographic, BreakIdeographic, BreakMandatory}
+	if !reflect.DeepEqual(positions, expectedPositions) || !reflect.DeepEqual(kinds, expectedKinds) {
+		t.Errorf("expected %v %v, got %v %v", expectedPositions, expectedKinds, positions, kinds)
+	}
+
+	// emergency breaks are reported between grapheme clusters
+	seg.InitString("ae\u0301 b")
+	positions, kinds = collectKinds(seg.EmergencyBreakIterator())
+	expectedPositions = []int{1, 3, 4, 5}
+	expectedKinds = []BreakKind{BreakEmergency, BreakEmergency, BreakSpace, BreakMandatory}
+	if !reflect.DeepEqual(positions, expectedPositions) || !reflect.DeepEqual(kinds, expectedKinds) {
+		t.Errorf("expected %v %v, got %v %v", expectedPositions, expectedKinds, positions, kinds)
+	}
+}
+
+func collectWords(s *Segmenter, input []rune) []string {
+	s.Init(input)
+	iter := s.WordIterator()
+	var out []string
+	for iter.Next() {
+		out = append(out, string(iter.Word().Text))
+	}
+	return out
+}
+
+func TestWordBreakUnicodeReference(t *testing.T) {
+	file := "test/WordBreakTest.txt"
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(b), "\n")
+
+	var seg1 Segmenter
+	for i, line := range lines {
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		s, expectedSegments := parseUCDTestLine(t, line)
+		text := []rune(s)
+		actualSegments := collectWords(&seg1, text)
+		if !reflect.DeepEqual(expectedSegments, actualSegments) {
+			t.Errorf("line %d [%s]: expected %#v, got %#v", i+1, hex(text), expectedSegments, actualSegments)
+		}
+	}
+}
+
+func TestWordKind(t *testing.T) {
+	var seg Segmenter
+	seg.InitString("Hello, world 42 times! 😀")
+	iter := seg.WordIterator()
+	var words []string
+	var kinds []WordKind
+	for iter.Next() {
+		w := iter.Word()
+		words = append(words, string(w.Text))
+		kinds = append(kinds, w.Kind)
+	}
+	expectedWords := []string{"Hello", ",", " ", "world", " ", "42", " ", "times", "!", " ", "😀"}
+	expectedKinds := []WordKind{
+		WordLetter, WordPunctuation, WordSpace, WordLetter, WordSpace, WordNumber,
+		WordSpace, WordLetter, WordPunctuation, WordSpace, WordOther,
+	}
+	if !reflect.DeepEqual(words, expectedWords) {
+		t.Errorf("expected %q, got %q", expectedWords, words)
+	}
+	if !reflect.DeepEqual(kinds, expectedKinds) {
+		t.Errorf("expected %v, got %v", expectedKinds, kinds)
+	}
+}
+
+func collectSentences(s *Segmenter, input []rune) []string {
+	s.Init(input)
+	iter := s.SentenceIterator()
+	var out []string
+	for iter.Next() {
+		out = append(out, string(iter.Sentence().Text))
+	}
+	return out
+}
+
+func TestSentenceBreakUnicodeReference(t *testing.T) {
+	file := "test/SentenceBreakTest.txt"
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(b), "\n")
+
+	var seg1 Segmenter
+	for i, line := range lines {
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		s, expectedSegments := parseUCDTestLine(t, line)
+		text := []rune(s)
+		actualSegments := collectSentences(&seg1, text)
+		if !reflect.DeepEqual(expectedSegments, actualSegments) {
+			t.Errorf("line %d [%s]: expected %#v, got %#v", i+1, hex(text), expectedSegments, actualSegments)
+		}
+	}
+}
+
+func TestKinsoku(t *testing.T) {
+	// UAX#14 already forbids most of the kinsoku breaks
+	// (small kana are resolved to NS by rule LB1)
+	input := []rune("日本「語」ァ。")
+	for _, kinsoku := range []Kinsoku{KinsokuNone, KinsokuNormal, KinsokuStrict} {
+		seg := Segmenter{Kinsoku: kinsoku}
+		expected := []string{"日", "本", "「語」ァ。"}
+		if got := collectLines(&seg, input); !reflect.DeepEqual(got, expected) {
+			t.Errorf("kinsoku %d: expected %q, got %q", kinsoku, expected, got)
+		}
+	}
+
+	// but allows a break after spaces (rule LB18)
+	input = []rune("語 ・語 ァ")
+	for _, test := range []struct {
+		kinsoku  Kinsoku
+		expected []string
+	}{
+		{KinsokuNone, []string{"語 ", "・", "語 ", "ァ"}},
+		{KinsokuNormal, []string{"語 ・", "語 ", "ァ"}},
+		{KinsokuStrict, []string{"語 ・", "語 ァ"}},
+	} {
+		seg := Segmenter{Kinsoku: test.kinsoku}
+		if got := collectLines(&seg, input); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("kinsoku %d: expected %q, got %q", test.kinsoku, test.expected, got)
+		}
+	}
+}
+
+func TestLineStrictness(t *testing.T) {
+	input := []rune("語ァ語〜語々語…語$語")
+	for _, test := range []struct {
+		strictness LineStrictness
+		expected   []string
+	}{
+		{LineStrict, []string{"語ァ", "語〜", "語々", "語…", "語", "$語"}},
+		{LineNormal, []string{"語", "ァ", "語", "〜", "語々", "語…", "語", "$語"}},
+		{LineLoose, []string{"語", "ァ", "語", "〜", "語", "々", "語", "…", "語", "$", "語"}},
+	} {
+		seg := Segmenter{Strictness: test.strictness}
+		if got := collectLines(&seg, input); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("strictness %d: expected %q, got %q", test.strictness, test.expected, got)
+		}
+	}
+
+	// kinsoku prohibitions still apply
+	seg := Segmenter{Strictness: LineLoose, Kinsoku: KinsokuStrict}
+	expected := []string{"語ァ", "語〜", "語々", "語", "…", "語", "$", "語"}
+	if got := collectLines(&seg, input); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	// centered punctuation may not start a line with kinsoku
+	seg = Segmenter{Strictness: LineLoose, Kinsoku: KinsokuNormal}
+	expected = []string{"語・", "語"}
+	if got := collectLines(&seg, []rune("語・語")); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	// tailored breaks are restricted to CJK context
+	seg = Segmenter{Strictness: LineLoose}
+	expected = []string{"a…", "b"}
+	if got := collectLines(&seg, []rune("a…b")); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestWordBreakMode(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		mode     WordBreakMode
+		expected []string
+	}{
+		{"ab 日本語", WordBreakNormal, []string{"ab ", "日", "本", "語"}},
+		{"ab 日本語", WordBreakAll, []string{"a", "b ", "日", "本", "語"}},
+		{"ab 日本語", WordKeepAll, []string{"ab ", "日本語"}},
+		{"한국어 문장", WordBreakNormal, []string{"한", "국", "어 ", "문", "장"}},
+		{"한국어 문장", WordKeepAll, []string{"한국어 ", "문장"}},
+		{"e\u0301f", WordBreakAll, []string{"e\u0301", "f"}}, // graphemes are preserved
+		{"日本、語", WordKeepAll, []string{"日本、", "語"}},          // punctuation
+	} {
+		seg := Segmenter{WordBreak: test.mode}
+		if got := collectLines(&seg, []rune(test.input)); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%q (mode %d): expected %q, got %q", test.input, test.mode, test.expected, got)
+		}
+	}
+}
+
+func TestEmojiIterator(t *testing.T) {
+	type seq struct {
+		offset  int
+		text    string
+		isEmoji bool
+	}
+	for _, test := range []struct {
+		input    string
+		expected []seq
+	}{
+		{"abc 123", nil},
+		{"a😀b", []seq{{1, "😀", true}}},
+		{"©", []seq{{0, "©", false}}},
+		{"©\uFE0F", []seq{{0, "©\uFE0F", true}}},
+		{"😀\uFE0E", []seq{{0, "😀\uFE0E", false}}},
+		{"1\uFE0F\u20E3#\u20E3", []seq{{0, "1\uFE0F\u20E3", true}, {3, "#\u20E3", true}}},
+		{"👍🏽", []seq{{0, "👍🏽", true}}},                  // modifier
+		{"🇫🇷🇩", []seq{{0, "🇫🇷", true}, {2, "🇩", true}}}, // flags
+		{"👩\u200D💻!", []seq{{0, "👩\u200D💻", true}}},     // ZWJ
+		{"👩\u200Da", []seq{{0, "👩", true}}},             // invalid ZWJ
+		{"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", []seq{ // tag sequence
+			{0, "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", true},
+		}},
+	} {
+		var seg Segmenter
+		seg.InitString(test.input)
+		iter := seg.EmojiIterator()
+		var got []seq
+		for iter.Next() {
+			s := iter.Sequence()
+			got = append(got, seq{s.Offset, string(s.Text), s.IsEmojiPresentation})
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestSegmenterReuse(t *testing.T) {
+	text := "Hello world, this is a small paragraph 日本語"
+
+	var seg Segmenter
+	expected := collectLines(&seg, []rune(text))
+	seg.InitBytes([]byte(text))
+	var got []string
+	for iter := seg.LineIterator(); iter.Next(); {
+		got = append(got, string(iter.Line().Text))
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	// reusing the segmenter does not allocate
+	if raceEnabled || testing.Short() {
+		t.Skip("allocations are not checked with the race detector or in short mode")
+	}
+	runes, bytes := []rune(text), []byte(text)
+	allocs := testing.AllocsPerRun(10, func() {
+		seg.Init(runes)
+		seg.InitBytes(bytes)
+		iter := seg.LineIterator()
+		for iter.Next() {
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected allocations: %f", allocs)
+	}
+}
+
+func TestCaretPosition(t *testing.T) {
+	for _, test := range []struct {
+		text      string
+		positions []int // expected caret positions, from the start
+	}{
+		{"", []int{0}},
+		{"abc", []int{0, 1, 2, 3}},
+		{"e\u0301a", []int{0, 2, 3}},                                 // combining mark
+		{"\U0001F469\u200D\U0001F467x", []int{0, 3, 4}},              // ZWJ emoji sequence
+		{"\U0001F1EB\U0001F1F7\U0001F1E9\U0001F1EA", []int{0, 2, 4}}, // flags
+		{"\U0001F44D\U0001F3FDa", []int{0, 2, 3}},                    // emoji modifier
+		{"\u1100\u1161\u11A8\u1100", []int{0, 3, 4}},                 // Hangul jamos
+		{"a\r\nb", []int{0, 1, 3, 4}},                                // CRLF
+	} {
+		var seg Segmenter
+		seg.InitString(test.text)
+		n := len([]rune(test.text))
+
+		var forward []int
+		for pos := 0; ; pos = seg.NextCaretPosition(pos) {
+			forward = append(forward, pos)
+			if !seg.IsCaretPosition(pos) {
+				t.Errorf("%q: %d should be a caret position", test.text, pos)
+			}
+			if pos == n {
+				break
+			}
+		}
+		if !reflect.DeepEqual(forward, test.positions) {
+			t.Errorf("%q: expected %v, got %v", test.text, test.positions, forward)
+		}
+
+		var backward []int
+		for pos := n; ; pos = seg.PreviousCaretPosition(pos) {
+			backward = append([]int{pos}, backward...)
+			if pos == 0 {
+				break
+			}
+		}
+		if !reflect.DeepEqual(backward, test.positions) {
+			t.Errorf("%q: expected %v, got %v", test.text, test.positions, backward)
+		}
+	}
+
+	var seg Segmenter
+	seg.InitString("e\u0301a")
+	if seg.IsCaretPosition(1) || seg.IsCaretPosition(-1) || seg.IsCaretPosition(4) {
+		t.Error("unexpected caret position")
+	}
+	if got := seg.NextCaretPosition(-5); got != 0 {
+		t.Errorf("expected 0, got %d", got)
+	}
+	if got := seg.NextCaretPosition(10); got != 3 {
+		t.Errorf("expected 3, got %d", got)
+	}
+	if got := seg.PreviousCaretPosition(10); got != 3 {
+		t.Errorf("expected 3, got %d", got)
+	}
+	if got := seg.PreviousCaretPosition(1); got != 0 {
+		t.Errorf("expected 0, got %d", got)
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package segmenter
+
+import (
+	"strings"
+
+	ucd "github.com/go-text/typesetting/unicodedata"
+)
+
+// LineStrictness selects how strictly the line breaking rules are applied
+// to Chinese and Japanese text, following the semantics of the
+// CSS line-break property (https://drafts.csswg.org/css-text-3/#line-break-property).
+//
+// Since the content language is not known by the segmenter, the breaks
+// restricted to Chinese or Japanese text are only allowed
+// after an ideographic or nonstarter character.
+//
+// The levels overlap with [Kinsoku], which takes precedence : a break
+// added by the strictness level is only allowed if it is not forbidden by the
+// kinsoku rules. In particular, [KinsokuStrict] forbids all the breaks
+// added by [LineNormal], so that only the breaks added by [LineLoose] before
+// inseparable characters and postfixes, and after prefixes, are still allowed with it.
+type LineStrictness uint8
+
+const (
+	// LineStrict applies the default UAX#14 rules, which match the CSS strict level :
+	// no breaks before small kana, the prolonged sound mark or hyphens.
+	LineStrict LineStrictness = iota
+	// LineNormal extends [LineStrict], allowing breaks before small kana
+	// and the prolonged sound mark (class CJ), and before the hyphens
+	// U+2010, U+2013, U+301C and U+30A0.
+	LineNormal
+	// LineLoose extends [LineNormal], also allowing breaks before iteration marks,
+	// inseparable characters, centered punctuation and postfixes,
+	// and after prefixes.
+	LineLoose
+)
+
+const (
+	// runes before which [LineNormal] allows a break, in CJK context
+	normalBreakBefore = cjkHyphens
+
+	// runes before which [LineLoose] allows a break, in CJK context
+	looseBreakBefore = cjkIterationMarks +
+		"‥…⋯︙" + // inseparable characters
+		"・：；゠‼⁇⁈⁉！？" + // centered punctuation
+		"%¢°‰′″℃％￠" // postfixes
+
+	// runes after which [LineLoose] allows a break, before an ideograph
+	looseBreakAfter = "$£¥€№＄￡￥"
+)
+
+// isCJKContext returns true if a (Chinese or Japanese) tailored break
+// may follow a rune with the given resolved class.
+func isCJKContext(class lineBreakClass) bool {
+	return class == ucd.BreakID || class == ucd.BreakNS
+}
+
+// canBreakBeforeCJ returns true if a break would be allowed before an ideograph
+// following a rune with the given resolved class.
+func canBreakBeforeCJ(class lineBreakClass) bool {
+	switch class {
+	case ucd.BreakID, ucd.BreakNS, ucd.BreakAL, ucd.BreakHL, ucd.BreakNU,
+		ucd.BreakCL, ucd.BreakCP, ucd.BreakEX:
+		return true
+	default:
+		return false
+	}
+}
+
+// allowsBreak returns true if the strictness level allows a break before text[i],
+// with 0 < i < len(text).
+func (s LineStrictness) allowsBreak(text []rune, lineClasses []lineBreakClass, i int) bool {
+	before, after := lineClasses[i-1], lineClasses[i]
+	if ucd.LookupLineBreakClass(text[i]) == ucd.BreakCJ && canBreakBeforeCJ(before) {
+		return true
+	}
+	if isCJKContext(before) && strings.ContainsRune(normalBreakBefore, text[i]) {
+		return true
+	}
+	if s != LineLoose {
+		return false
+	}
+	if isCJKContext(before) && strings.ContainsRune(looseBreakBefore, text[i]) {
+		return true
+	}
+	return after == ucd.BreakID && strings.ContainsRune(looseBreakAfter, text[i-1])
+}
+
+// applyStrictness adds the line break opportunities allowed by
+// the given strictness level, and not forbidden by [k].
+func applyStrictness(text []rune, attributes []runeAttr, lineClasses []lineBreakClass, s LineStrictness, k Kinsoku) {
+	if s == LineStrict {
+		return
+	}
+	// the first and last attributes are fixed by rules LB2 and LB3
+	for i := 1; i < len(text); i++ {
+		if attributes[i]&aLineBreak != 0 {
+			continue
+		}
+		if s.allowsBreak(text, lineClasses, i) && !k.forbidsBreak(text, i) {
+			attributes[i] |= aLineBreak
+		}
+	}
+}
@@ -381,6 +381,22 @@ func (cr *cursor) updateNumSequence() bool {
 // Some properties depending on the context are rather
 // updated in the previous `endIteration` call.
 func (cr *cursor) startIteration(text []rune, i int) {
+	cr.startRune(text, i)
+
+	// query general unicode properties for the current rune
+	cr.isExtentedPic = unicode.Is(ucd.Extended_Pictographic, cr.r)
+
+	cr.prevGrapheme = cr.grapheme
+	cr.grapheme = ucd.LookupGraphemeBreakClass(cr.r)
+
+	// prevPrevLine and prevLine are handled in endIteration
+	cr.line = cr.nextLine // avoid calling LookupBreakClass twice
+	cr.nextLine = ucd.LookupLineBreakClass(cr.next)
+}
+
+// startRune updates the prev, r and next fields,
+// setting the current rune to text[i].
+func (cr *cursor) startRune(text []rune, i int) {
 	cr.prev = cr.r
 	if i < len(text) {
 		cr.r = text[i]
@@ -396,16 +412,6 @@ func (cr *cursor) startIteration(text []rune, i int) {
 	} else {
 		cr.next = text[i+1]
 	}
-
-	// query general unicode properties for the current rune
-	cr.isExtentedPic = unicode.Is(ucd.Extended_Pictographic, cr.r)
-
-	cr.prevGrapheme = cr.grapheme
-	cr.grapheme = ucd.LookupGraphemeBreakClass(cr.r)
-
-	// prevPrevLine and prevLine are handled in endIteration
-	cr.line = cr.nextLine // avoid calling LookupBreakClass twice
-	cr.nextLine = ucd.LookupLineBreakClass(cr.next)
 }
 
 // end the current iteration, computing some of the properties
@@ -2,7 +2,11 @@
 
 package segmenter
 
-import ucd "github.com/go-text/typesetting/unicodedata"
+import (
+	"unicode"
+
+	ucd "github.com/go-text/typesetting/unicodedata"
+)
 
 // Apply the Grapheme_Cluster_Boundary_Rules and returns a true if we are
 // at a grapheme break.
@@ -95,3 +99,209 @@ func (cr *cursor) updatePictoSequence() bool {
 		panic("exhaustive switch")
 	}
 }
+
+// isWordIgnored returns true for the classes ignored by rule WB4
+func isWordIgnored(cl wordBreakClass) bool {
+	return cl == ucd.WordBreakExtend || cl == ucd.WordBreakFormat || cl == ucd.WordBreakZWJ
+}
+
+// AHLetter in the UAX#29 notation
+func isAHLetter(cl wordBreakClass) bool {
+	return cl == ucd.WordBreakALetter || cl == ucd.WordBreakHebrew_Letter
+}
+
+// MidNumLetQ in the UAX#29 notation
+func isMidNumLetQ(cl wordBreakClass) bool {
+	return cl == ucd.WordBreakMidNumLet || cl == ucd.WordBreakSingle_Quote
+}
+
+// nextWordClass returns the word break class of the first rune after
+// `i` which is not ignored by rule WB4, or nil
+func nextWordClass(text []rune, i int) wordBreakClass {
+	for j := i + 1; j < len(text); j++ {
+		cl := ucd.LookupWordBreakClass(text[j])
+		if !isWordIgnored(cl) {
+			return cl
+		}
+	}
+	return nil
+}
+
+// Apply the Word_Boundary_Rules and returns a true if we are
+// at a word break.
+// See https://unicode.org/reports/tr29/#Word_Boundary_Rules
+func (cr *cursor) applyWordBoundaryRules(text []rune, i int) bool {
+	triggerWB15_16 := cr.updateWordRIOdd() // apply rule WB15 and WB16
+
+	br0, br1 := cr.prevWord, cr.word
+	if cr.r == '\n' && cr.prev == '\r' {
+		return false // Rule WB3
+	} else if br0 == ucd.WordBreakNewline || br0 == ucd.WordBreakCR || br0 == ucd.WordBreakLF ||
+		br1 == ucd.WordBreakNewline || br1 == ucd.WordBreakCR || br1 == ucd.WordBreakLF {
+		return true // Rules WB3a && WB3b
+	} else if cr.isExtentedPic && unicode.Is(ucd.WordBreakZWJ, cr.prev) {
+		return false // Rule WB3c
+	} else if br1 == ucd.WordBreakWSegSpace && unicode.Is(ucd.WordBreakWSegSpace, cr.prev) {
+		return false // Rule WB3d
+	} else if isWordIgnored(br1) {
+		return false // Rule WB4
+	} else if isAHLetter(br0) && isAHLetter(br1) {
+		return false // Rule WB5
+	} else if isAHLetter(br0) && (br1 == ucd.WordBreakMidLetter || isMidNumLetQ(br1)) &&
+		isAHLetter(nextWordClass(text, i)) {
+		return false // Rule WB6
+	} else if isAHLetter(cr.prevPrevWord) && (br0 == ucd.WordBreakMidLetter || isMidNumLetQ(br0)) && isAHLetter(br1) {
+		return false // Rule WB7
+	} else if br0 == ucd.WordBreakHebrew_Letter && br1 == ucd.WordBreakSingle_Quote {
+		return false // Rule WB7a
+	} else if br0 == ucd.WordBreakHebrew_Letter && br1 == ucd.WordBreakDouble_Quote &&
+		nextWordClass(text, i) == ucd.WordBreakHebrew_Letter {
+		return false // Rule WB7b
+	} else if cr.prevPrevWord == ucd.WordBreakHebrew_Letter && br0 == ucd.WordBreakDouble_Quote && br1 == ucd.WordBreakHebrew_Letter {
+		return false // Rule WB7c
+	} else if (br0 == ucd.WordBreakNumeric || isAHLetter(br0)) && br1 == ucd.WordBreakNumeric {
+		return false // Rules WB8 && WB9
+	} else if br0 == ucd.WordBreakNumeric && isAHLetter(br1) {
+		return false // Rule WB10
+	} else if cr.prevPrevWord == ucd.WordBreakNumeric && (br0 == ucd.WordBreakMidNum || isMidNumLetQ(br0)) && br1 == ucd.WordBreakNumeric {
+		return false // Rule WB11
+	} else if br0 == ucd.WordBreakNumeric && (br1 == ucd.WordBreakMidNum || isMidNumLetQ(br1)) &&
+		nextWordClass(text, i) == ucd.WordBreakNumeric {
+		return false // Rule WB12
+	} else if br0 == ucd.WordBreakKatakana && br1 == ucd.WordBreakKatakana {
+		return false // Rule WB13
+	} else if (isAHLetter(br0) || br0 == ucd.WordBreakNumeric || br0 == ucd.WordBreakKatakana ||
+		br0 == ucd.WordBreakExtendNumLet) && br1 == ucd.WordBreakExtendNumLet {
+		return false // Rule WB13a
+	} else if br0 == ucd.WordBreakExtendNumLet &&
+		(isAHLetter(br1) || br1 == ucd.WordBreakNumeric || br1 == ucd.WordBreakKatakana) {
+		return false // Rule WB13b
+	} else if triggerWB15_16 {
+		return false // Rule WB15 && WB16
+	}
+
+	return true // Rule WB999
+}
+
+// update `isPrevWordRIOdd` used for the rules WB15 and WB16
+// and returns `true` if one of them triggered
+func (cr *cursor) updateWordRIOdd() (trigger bool) {
+	if cr.word == ucd.WordBreakRegional_Indicator {
+		trigger = cr.isPrevWordRIOdd
+		cr.isPrevWordRIOdd = !cr.isPrevWordRIOdd // switch the parity
+	} else if !isWordIgnored(cr.word) { // beware of the rule WB4: (Extend|Format|ZWJ) ignore the update
+		cr.isPrevWordRIOdd = false
+	}
+	return trigger
+}
+
+// endWordIteration updates the word break classes required
+// for the next rune, respecting rule WB4
+func (cr *cursor) endWordIteration(isStart bool) {
+	isAfterNewline := cr.prevWord == ucd.WordBreakNewline || cr.prevWord == ucd.WordBreakCR || cr.prevWord == ucd.WordBreakLF
+	if isWordIgnored(cr.word) && !isStart && !isAfterNewline {
+		return // rule WB4 : ignore the rune for prevWord and prevPrevWord
+	}
+	cr.prevPrevWord = cr.prevWord
+	cr.prevWord = cr.word
+}
+
+// isSentenceIgnored returns true for the classes ignored by rule SB5
+func isSentenceIgnored(cl sentenceBreakClass) bool {
+	return cl == ucd.SentenceBreakExtend || cl == ucd.SentenceBreakFormat
+}
+
+// ParaSep in the UAX#29 notation
+func isParaSep(cl sentenceBreakClass) bool {
+	return cl == ucd.SentenceBreakSep || cl == ucd.SentenceBreakCR || cl == ucd.SentenceBreakLF
+}
+
+// SATerm in the UAX#29 notation
+func isSATerm(cl sentenceBreakClass) bool {
+	return cl == ucd.SentenceBreakSTerm || cl == ucd.SentenceBreakATerm
+}
+
+// see rules SB8 to SB11
+type sentenceTermState uint8
+
+const (
+	noSentenceTerm      sentenceTermState = iota // we are not after a terminator
+	inSentenceTermClose                          // we are in SATerm Close*
+	inSentenceTermSp                             // we are in SATerm Close* Sp+
+	seenSentenceTermSep                          // we are at SATerm Close* Sp* ParaSep
+)
+
+// isLowerAhead implements the lookahead required by rule SB8 :
+// ( ¬(OLetter | Upper | Lower | ParaSep | SATerm) )* Lower
+func isLowerAhead(text []rune, i int) bool {
+	for ; i < len(text); i++ {
+		cl := ucd.LookupSentenceBreakClass(text[i])
+		if cl == ucd.SentenceBreakLower {
+			return true
+		}
+		if cl == ucd.SentenceBreakOLetter || cl == ucd.SentenceBreakUpper || isParaSep(cl) || isSATerm(cl) {
+			return false
+		}
+	}
+	return false
+}
+
+// Apply the Sentence_Boundary_Rules and returns a true if we are
+// at a sentence break.
+// See https://unicode.org/reports/tr29/#Sentence_Boundary_Rules
+func (cr *cursor) applySentenceBoundaryRules(text []rune, i int) bool {
+	br0, br1 := cr.prevSentence, cr.sentence
+	isAfterTerm := cr.sentenceTerm == inSentenceTermClose || cr.sentenceTerm == inSentenceTermSp
+	if cr.r == '\n' && cr.prev == '\r' {
+		return false // Rule SB3
+	} else if isParaSep(br0) {
+		return true // Rule SB4
+	} else if isSentenceIgnored(br1) {
+		return false // Rule SB5
+	} else if br0 == ucd.SentenceBreakATerm && br1 == ucd.SentenceBreakNumeric {
+		return false // Rule SB6
+	} else if (cr.prevPrevSentence == ucd.SentenceBreakUpper || cr.prevPrevSentence == ucd.SentenceBreakLower) &&
+		br0 == ucd.SentenceBreakATerm && br1 == ucd.SentenceBreakUpper {
+		return false // Rule SB7
+	} else if isAfterTerm && cr.sentenceTermClass == ucd.SentenceBreakATerm && isLowerAhead(text, i) {
+		return false // Rule SB8
+	} else if isAfterTerm && (br1 == ucd.SentenceBreakSContinue || isSATerm(br1)) {
+		return false // Rule SB8a
+	} else if cr.sentenceTerm == inSentenceTermClose &&
+		(br1 == ucd.SentenceBreakClose || br1 == ucd.SentenceBreakSp || isParaSep(br1)) {
+		return false // Rule SB9
+	} else if isAfterTerm && (br1 == ucd.SentenceBreakSp || isParaSep(br1)) {
+		return false // Rule SB10
+	} else if cr.sentenceTerm != noSentenceTerm {
+		return true // Rule SB11
+	}
+
+	return false // Rule SB998
+}
+
+// endSentenceIteration updates the sentence break classes and the
+// terminator state required for the next rune, respecting rule SB5
+func (cr *cursor) endSentenceIteration(isStart bool) {
+	if isSentenceIgnored(cr.sentence) && !isStart && !isParaSep(cr.prevSentence) {
+		return // rule SB5 : ignore the rune
+	}
+
+	cl := cr.sentence
+	switch {
+	case isSATerm(cl):
+		cr.sentenceTerm = inSentenceTermClose
+		cr.sentenceTermClass = cl
+	case cl == ucd.SentenceBreakClose && cr.sentenceTerm == inSentenceTermClose:
+		// continue the sequence
+	case cl == ucd.SentenceBreakSp && (cr.sentenceTerm == inSentenceTermClose || cr.sentenceTerm == inSentenceTermSp):
+		cr.sentenceTerm = inSentenceTermSp
+	case isParaSep(cl) && (cr.sentenceTerm == inSentenceTermClose || cr.sentenceTerm == inSentenceTermSp):
+		cr.sentenceTerm = seenSentenceTermSep
+	default:
+		cr.sentenceTerm = noSentenceTerm
+		cr.sentenceTermClass = nil
+	}
+
+	cr.prevPrevSentence = cr.prevSentence
+	cr.prevSentence = cl
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package segmenter
+
+import (
+	"unicode"
+
+	ucd "github.com/go-text/typesetting/unicodedata"
+)
+
+// WordBreakMode selects how line breaks are allowed inside words,
+// following the semantics of the CSS word-break property
+// (https://drafts.csswg.org/css-text-3/#word-break-property).
+type WordBreakMode uint8
+
+const (
+	// WordBreakNormal applies the default UAX#14 rules.
+	WordBreakNormal WordBreakMode = iota
+	// WordBreakAll allows breaks between any pair of letters or digits,
+	// as if they were ideographs. This is typically used for
+	// CJK text mixed with other scripts.
+	// Grapheme clusters are never broken.
+	WordBreakAll
+	// WordKeepAll forbids breaks between pairs of letters or digits, including
+	// ideographs and Hangul syllables, so that Korean and CJK words are kept together.
+	// Breaks are still allowed at spaces and punctuation.
+	WordKeepAll
+)
+
+// isWordCharacter returns true if the rune, with the given resolved class,
+// is a typographic letter unit, as defined by CSS.
+func isWordCharacter(r rune, class lineBreakClass) bool {
+	switch class {
+	case ucd.BreakAL, ucd.BreakHL, ucd.BreakNU, ucd.BreakID,
+		ucd.BreakH2, ucd.BreakH3, ucd.BreakJL, ucd.BreakJV, ucd.BreakJT:
+		return true
+	case ucd.BreakNS: // small kana and the prolonged sound mark (class CJ)
+		return unicode.IsLetter(r)
+	default:
+		return false
+	}
+}
+
+// applyWordBreakMode adds or removes the line break opportunities
+// inside words, according to the given mode.
+func applyWordBreakMode(text []rune, attributes []runeAttr, lineClasses []lineBreakClass, mode WordBreakMode) {
+	if mode == WordBreakNormal {
+		return
+	}
+	// the first and last attributes are fixed by rules LB2 and LB3
+	last := 0 // index of the last rune which is not a combining mark (rule LB9)
+	for i := 1; i < len(text); i++ {
+		attr := attributes[i]
+		if class := lineClasses[i]; class == ucd.BreakCM || class == ucd.BreakZWJ {
+			continue
+		}
+		before := last
+		last = i
+		if attr&aMandatoryBreak != 0 {
+			continue
+		}
+		if !isWordCharacter(text[before], lineClasses[before]) || !isWordCharacter(text[i], lineClasses[i]) {
+			continue
+		}
+		switch mode {
+		case WordBreakAll:
+			if attr&aGraphemeBoundary != 0 {
+				attributes[i] = attr | aLineBreak
+			}
+		case WordKeepAll:
+			attributes[i] = attr &^ aLineBreak
+		}
+	}
+}
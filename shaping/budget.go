@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// BudgetedCache is implemented by the caches whose memory
+// is controlled by a [CacheBudget].
+//
+// Applications may implement it to register their own caches (like
+// face or glyph outline caches) : such caches record the time of use
+// of their entries with [CacheBudget.Now], and report their additions
+// and removals with [CacheBudget.Add] and [CacheBudget.Remove].
+//
+// The methods are called by the budget while it is locked, so
+// they must not call the budget.
+type BudgetedCache interface {
+	// OldestUse returns the time of use of the least recently
+	// used entry, or false if the cache is empty.
+	OldestUse() (uint64, bool)
+	// EvictOldest removes the least recently used entry and returns
+	// its estimated size in bytes.
+	EvictOldest() int
+}
+
+// CacheBudget is a memory limit shared by several caches (see [BudgetedCache]),
+// like the font cache of [HarfbuzzShaper] (see [HarfbuzzShaper.SetCacheBudget])
+// and [GlyphMetricsCache] (see [GlyphMetricsCache.SetCacheBudget]).
+//
+// When the total estimated size of the entries of the registered caches exceeds the limit,
+// the least recently used entries among all the caches are evicted.
+//
+// A CacheBudget is safe for concurrent use.
+type CacheBudget struct {
+	clock uint64 // accessed atomically, first for alignment
+
+	mu     sync.Mutex
+	limit  int
+	used   int
+	caches []BudgetedCache
+}
+
+// NewCacheBudget returns a budget limiting the registered caches
+// to [limit] bytes.
+func NewCacheBudget(limit int) *CacheBudget {
+	return &CacheBudget{limit: limit}
+}
+
+// Register adds [cache] to the caches controlled by [b].
+// The size of the entries already in [cache] is not taken into account.
+func (b *CacheBudget) Register(cache BudgetedCache) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.caches = append(b.caches, cache)
+}
+
+// Unregister removes [cache] from the caches controlled by [b],
+// so that it may be garbage collected.
+// The size of its entries is removed from the budget.
+func (b *CacheBudget) Unregister(cache BudgetedCache) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, c := range b.caches {
+		if c == cache {
+			b.caches = append(b.caches[:i], b.caches[i+1:]...)
+			break
+		}
+	}
+	for {
+		if _, ok := cache.OldestUse(); !ok {
+			break
+		}
+		b.used -= cache.EvictOldest()
+	}
+}
+
+// Now returns a (monotonic) time of use, to be recorded in the cache entries.
+func (b *CacheBudget) Now() uint64 { return atomic.AddUint64(&b.clock, 1) }
+
+// Add records an entry of [size] bytes added to a registered cache,
+// evicting the least recently used entries if the limit is exceeded.
+// The cache must not be locked when calling Add.
+func (b *CacheBudget) Add(size int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.used += size
+	b.evict()
+}
+
+// Remove records an entry of [size] bytes removed from a registered cache,
+// other than by [BudgetedCache.EvictOldest].
+func (b *CacheBudget) Remove(size int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.used -= size
+}
+
+// Used returns the estimated size of the entries of the registered caches.
+func (b *CacheBudget) Used() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used
+}
+
+// SetLimit updates the memory limit, evicting entries if needed.
+func (b *CacheBudget) SetLimit(limit int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.limit = limit
+	b.evict()
+}
+
+// evict removes the least recently used entries, among
+// all the caches, until the limit is respected.
+func (b *CacheBudget) evict() {
+	for b.used > b.limit {
+		var (
+			oldest      BudgetedCache
+			oldestUse   uint64
+			foundOldest bool
+		)
+		for _, cache := range b.caches {
+			use, ok := cache.OldestUse()
+			if ok && (!foundOldest || use < oldestUse) {
+				oldest, oldestUse, foundOldest = cache, use, true
+			}
+		}
+		if !foundOldest { // all the caches are empty
+			return
+		}
+		b.used -= oldest.EvictOldest()
+	}
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
+	fontapi "github.com/go-text/typesetting/opentype/api/font"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestCacheBudget(t *testing.T) {
+	budget := NewCacheBudget(10 * glyphMetricsEntrySize)
+	cache := NewGlyphMetricsCache(0)
+	cache.SetCacheBudget(budget)
+	for gid := font.GID(0); gid < 20; gid++ {
+		cache.Metrics(benchEnFace, gid, fixed.I(12))
+	}
+	if cache.Len() != 10 || budget.Used() != 10*glyphMetricsEntrySize {
+		t.Fatalf("unexpected cache size %d (%d bytes)", cache.Len(), budget.Used())
+	}
+
+	// the font and plan caches of a shaper share the budget : their entries are the
+	// most recently used, so that glyph metrics are evicted
+	shaperSize := estimatedFontSize(benchEnFace.Font) + estimatedPlanSize
+	budget.SetLimit(shaperSize + 10*glyphMetricsEntrySize)
+	var shaper HarfbuzzShaper
+	shaper.SetCacheBudget(budget)
+	text := []rune("abc")
+	input := Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(12),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	shaper.Shape(input)
+	if budget.Used() > shaperSize+10*glyphMetricsEntrySize {
+		t.Fatalf("budget exceeded: %d", budget.Used())
+	}
+	if _, ok := shaper.fonts.Get(benchEnFace.Font); !ok {
+		t.Fatal("font should be cached")
+	}
+	if stats := shaper.PlanCacheStats(); stats.Size != 1 {
+		t.Fatalf("unexpected plans %d", stats.Size)
+	}
+
+	// lowering the limit evicts the oldest entries first
+	budget.SetLimit(shaperSize)
+	if cache.Len() != 0 || budget.Used() != shaperSize {
+		t.Fatalf("unexpected cache size %d (%d bytes)", cache.Len(), budget.Used())
+	}
+	budget.SetLimit(0)
+	if _, ok := shaper.fonts.Get(benchEnFace.Font); ok || budget.Used() != 0 {
+		t.Fatalf("font should be evicted (%d bytes)", budget.Used())
+	}
+	if stats := shaper.PlanCacheStats(); stats.Size != 0 {
+		t.Fatalf("plans should be evicted (%d)", stats.Size)
+	}
+
+	budget.SetLimit(1 << 20)
+	shaper.Shape(input)
+	budget.Unregister(shaper.BudgetedCache())
+	if budget.Used() != 0 {
+		t.Fatalf("unexpected budget %d", budget.Used())
+	}
+}
+
+func TestCacheBudgetConcurrent(t *testing.T) {
+	budget := NewCacheBudget(50 * glyphMetricsEntrySize)
+	cache := NewGlyphMetricsCache(0)
+	cache.SetCacheBudget(budget)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// faces are not safe for concurrent use
+			face := &fontapi.Face{Font: benchEnFace.Font}
+			var shaper HarfbuzzShaper
+			shaper.SetCacheBudget(budget)
+			shaper.SetGlyphMetricsCache(cache)
+			text := []rune("Lorem ipsum, dolor sit amet.")
+			for size := 10; size < 30; size++ {
+				shaper.MeasureAdvance(Input{
+					Text:      text,
+					RunStart:  0,
+					RunEnd:    len(text),
+					Direction: di.DirectionLTR,
+					Face:      face,
+					Size:      fixed.I(size),
+					Script:    language.Latin,
+					Language:  language.NewLanguage("en"),
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	if budget.Used() > 50*glyphMetricsEntrySize {
+		t.Fatalf("budget exceeded: %d", budget.Used())
+	}
+}
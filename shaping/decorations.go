@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"sort"
+
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/math/fixed"
+)
+
+// DecorationKind is a kind of text decoration line.
+type DecorationKind uint8
+
+const (
+	// Underline is drawn below the baseline, at the position
+	// suggested by the font.
+	Underline DecorationKind = iota
+	// Strikethrough is drawn through the lowercase letters, at the position
+	// suggested by the font.
+	Strikethrough
+	// Overline is drawn at the ascent of the line, with the
+	// thickness of the underline.
+	Overline
+)
+
+// Decoration is a segment of decoration line, ready to be drawn as a rectangle.
+type Decoration struct {
+	// Line is the index of the line in the slice given to [Decorations],
+	// and Run the index of the run in the line.
+	Line, Run int
+	// X0 and X1 are the horizontal extent of the segment,
+	// relative to the start of the line.
+	X0, X1 fixed.Int26_6
+	// Top is the position of the top edge of the segment, relative
+	// to the baseline, with the Y axis pointing down.
+	Top fixed.Int26_6
+	// Thickness is the height of the segment.
+	Thickness fixed.Int26_6
+}
+
+// Decorations returns the segments of the decoration line [kind] for the runes
+// of [lines] in the range [runes] (whose indices are relative to the text of the paragraph),
+// with one segment per run (or more if [skipInk] is true), using the metrics of
+// the face of the run. Only horizontal runs are decorated.
+//
+// The runs of each line are placed one after the other, in the order of the
+// slice, which is the visual order once the lines have been reordered (as in
+// [PositionedLine]).
+//
+// If [skipInk] is true, the underlines are interrupted where they would cross
+// the glyphs, like the descenders of 'g' or 'p' (as the CSS text-decoration-skip-ink property).
+// It has no effect on the other kinds.
+func Decorations(lines []Line, runes Range, kind DecorationKind, skipInk bool) []Decoration {
+	var out []Decoration
+	for lineIndex, line := range lines {
+		var pen fixed.Int26_6
+		for runIndex, run := range line {
+			if run.Direction.IsVertical() || run.Face == nil {
+				pen += run.Advance
+				continue
+			}
+			deco := Decoration{Line: lineIndex, Run: runIndex}
+			deco.Top, deco.Thickness = decorationMetrics(run, kind)
+			runStart, inRange := pen, false
+			for _, g := range run.Glyphs {
+				selected := runes.Offset <= g.ClusterIndex && g.ClusterIndex < runes.Offset+runes.Count
+				if selected && !inRange {
+					deco.X0 = pen
+				}
+				if !selected && inRange {
+					out = appendDecoration(out, deco, run, runStart, skipInk && kind == Underline)
+				}
+				inRange = selected
+				pen += g.XAdvance
+				deco.X1 = pen
+			}
+			if inRange {
+				out = appendDecoration(out, deco, run, runStart, skipInk && kind == Underline)
+			}
+		}
+	}
+	return out
+}
+
+// decorationMetrics returns the position of the top
+// edge of the decoration, and its thickness.
+func decorationMetrics(run Output, kind DecorationKind) (top, thickness fixed.Int26_6) {
+	scale := shapingScale(run.Face, run.Size)
+	em := fixed.I(run.Size.Ceil())
+	metric := func(metric api.LineMetric) fixed.Int26_6 {
+		return scale.Fixed(run.Face.LineMetric(metric))
+	}
+
+	underlineThickness := metric(api.UnderlineThickness)
+	if underlineThickness <= 0 {
+		underlineThickness = em / 14
+	}
+	switch kind {
+	case Strikethrough:
+		thickness = metric(api.StrikethroughThickness)
+		if thickness <= 0 {
+			thickness = underlineThickness
+		}
+		position := metric(api.StrikethroughPosition)
+		if position == 0 {
+			// center the line on the lowercase letters
+			position = (metric(api.XHeight) + thickness) / 2
+		}
+		return -position, thickness
+	case Overline:
+		return -run.LineBounds.Ascent, underlineThickness
+	default: // Underline
+		position := metric(api.UnderlinePosition)
+		if position == 0 {
+			position = -em / 10
+		}
+		return -position, underlineThickness
+	}
+}
+
+// appendDecoration adds [deco] to [dst], possibly split where it
+// crosses the glyphs of [run], which starts at [pen].
+func appendDecoration(dst []Decoration, deco Decoration, run Output, pen fixed.Int26_6, skipInk bool) []Decoration {
+	if !skipInk {
+		return append(dst, deco)
+	}
+	scale := shapingScale(run.Face, run.Size)
+	gap := deco.Thickness
+	// collect the gaps, in visual order
+	var gaps [][2]fixed.Int26_6
+	for _, g := range run.Glyphs {
+		origin := pen + g.XOffset
+		pen += g.XAdvance
+		if origin+g.XBearing+g.Width < deco.X0-gap || origin+g.XBearing > deco.X1+gap {
+			continue
+		}
+		// the band of the decoration, in font units, Y up
+		yMin := scale.FontUnits(float32(-(deco.Top+deco.Thickness)-g.YOffset) / 64)
+		yMax := scale.FontUnits(float32(-deco.Top-g.YOffset) / 64)
+		x0, x1, ok := inkInterval(run.Face, g.GlyphID, yMin, yMax)
+		if !ok {
+			continue
+		}
+		gaps = append(gaps, [2]fixed.Int26_6{origin + scale.Fixed(x0) - gap, origin + scale.Fixed(x1) + gap})
+	}
+
+	sort.Slice(gaps, func(i, j int) bool { return gaps[i][0] < gaps[j][0] })
+	start := deco.X0
+	for _, ga := range gaps {
+		if ga[0] > start {
+			seg := deco
+			seg.X0, seg.X1 = start, min26_6(ga[0], deco.X1)
+			if seg.X1 > seg.X0 {
+				dst = append(dst, seg)
+			}
+		}
+		start = max26_6(start, ga[1])
+	}
+	if start < deco.X1 {
+		deco.X0 = start
+		dst = append(dst, deco)
+	}
+	return dst
+}
+
+// inkInterval returns the horizontal extent (in font units) of the part of the
+// glyph [gid] between [yMin] and [yMax], or false if the glyph has no ink there.
+func inkInterval(face font.Face, gid font.GID, yMin, yMax float32) (x0, x1 float32, ok bool) {
+	outline, isOutline := face.GlyphData(gid).(api.GlyphOutline)
+	if !isOutline {
+		// use the bounding box
+		extents, hasExtents := face.GlyphExtents(gid)
+		if !hasExtents || extents.YBearing < yMin || extents.YBearing+extents.Height > yMax {
+			return 0, 0, false
+		}
+		return extents.XBearing, extents.XBearing + extents.Width, true
+	}
+
+	x0, x1 = float32(1e30), float32(-1e30)
+	clip := func(p, q api.SegmentPoint) {
+		if p.Y > q.Y {
+			p, q = q, p
+		}
+		if q.Y < yMin || p.Y > yMax {
+			return
+		}
+		if p.Y == q.Y { // horizontal segment
+			x0, x1 = min32(x0, min32(p.X, q.X)), max32(x1, max32(p.X, q.X))
+		} else {
+			for _, y := range [2]float32{max32(p.Y, yMin), min32(q.Y, yMax)} {
+				x := p.X + (q.X-p.X)*(y-p.Y)/(q.Y-p.Y)
+				x0, x1 = min32(x0, x), max32(x1, x)
+			}
+		}
+		ok = true
+	}
+	// curves are approximated by a fixed number of lines
+	const steps = 8
+	var start, current api.SegmentPoint
+	for i, seg := range outline.Segments {
+		switch seg.Op {
+		case api.SegmentOpMoveTo:
+			if i != 0 { // close the previous contour
+				clip(current, start)
+			}
+			start, current = seg.Args[0], seg.Args[0]
+		case api.SegmentOpLineTo:
+			clip(current, seg.Args[0])
+			current = seg.Args[0]
+		case api.SegmentOpQuadTo:
+			p0, p1, p2 := current, seg.Args[0], seg.Args[1]
+			for i := 1; i <= steps; i++ {
+				t := float32(i) / steps
+				p := lerpPoint(t, lerpPoint(t, p0, p1), lerpPoint(t, p1, p2))
+				clip(current, p)
+				current = p
+			}
+		case api.SegmentOpCubeTo:
+			p0, p1, p2, p3 := current, seg.Args[0], seg.Args[1], seg.Args[2]
+			for i := 1; i <= steps; i++ {
+				t := float32(i) / steps
+				q0, q1, q2 := lerpPoint(t, p0, p1), lerpPoint(t, p1, p2), lerpPoint(t, p2, p3)
+				p := lerpPoint(t, lerpPoint(t, q0, q1), lerpPoint(t, q1, q2))
+				clip(current, p)
+				current = p
+			}
+		}
+	}
+	if len(outline.Segments) != 0 {
+		clip(current, start)
+	}
+	return x0, x1, ok
+}
+
+func lerpPoint(t float32, p, q api.SegmentPoint) api.SegmentPoint {
+	return api.SegmentPoint{X: p.X + t*(q.X-p.X), Y: p.Y + t*(q.Y-p.Y)}
+}
+
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
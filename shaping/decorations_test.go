@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestDecorations(t *testing.T) {
+	text := []rune("Typing good apples")
+	run := shapeLatin(text)
+	lines := []Line{{run}}
+	all := Range{Count: len(text)}
+
+	scale := shapingScale(benchEnFace, run.Size)
+	deco := Decorations(lines, all, Underline, false)
+	if len(deco) != 1 || deco[0].X0 != 0 || deco[0].X1 != run.Advance {
+		t.Fatalf("unexpected underline %v", deco)
+	}
+	if deco[0].Top != -scale.Fixed(benchEnFace.LineMetric(api.UnderlinePosition)) ||
+		deco[0].Thickness != scale.Fixed(benchEnFace.LineMetric(api.UnderlineThickness)) || deco[0].Top <= 0 {
+		t.Fatalf("unexpected underline metrics %v", deco[0])
+	}
+	if deco := Decorations(lines, all, Strikethrough, false); len(deco) != 1 || deco[0].Top >= 0 || deco[0].Thickness <= 0 {
+		t.Fatalf("unexpected strikethrough %v", deco)
+	}
+	if deco := Decorations(lines, all, Overline, false); len(deco) != 1 || deco[0].Top != -run.LineBounds.Ascent {
+		t.Fatalf("unexpected overline %v", deco)
+	}
+
+	// a part of the text
+	var start fixed.Int26_6
+	for _, g := range run.Glyphs[:7] {
+		start += g.XAdvance
+	}
+	deco = Decorations(lines, Range{Offset: 7, Count: 4}, Underline, false)
+	if len(deco) != 1 || deco[0].X0 != start || deco[0].X1 <= start || deco[0].X1 >= run.Advance {
+		t.Fatalf("unexpected underline %v", deco)
+	}
+
+	// skip ink around the descenders
+	deco = Decorations(lines, all, Underline, true)
+	if len(deco) < 4 {
+		t.Fatalf("expected gaps, got %v", deco)
+	}
+	for i, d := range deco {
+		if d.X0 >= d.X1 || d.X0 < 0 || d.X1 > run.Advance || (i > 0 && d.X0 <= deco[i-1].X1) {
+			t.Fatalf("invalid segments %v", deco)
+		}
+	}
+	noDescender := []rune("none")
+	if deco := Decorations([]Line{{shapeLatin(noDescender)}}, Range{Count: 4}, Underline, true); len(deco) != 1 {
+		t.Fatalf("unexpected gaps %v", deco)
+	}
+
+	// several lines
+	var wrapper LineWrapper
+	lines, _ = wrapper.WrapParagraph(WrapConfig{}, 80, text, run)
+	if len(lines) < 2 {
+		t.Fatalf("expected several lines, got %d", len(lines))
+	}
+	deco = Decorations(lines, all, Strikethrough, false)
+	if len(deco) != len(lines) {
+		t.Fatalf("unexpected strikethrough %v", deco)
+	}
+	for i, d := range deco {
+		if d.Line != i || d.X0 != 0 || d.X1 != lines[i][0].Advance {
+			t.Fatalf("unexpected strikethrough %v", deco)
+		}
+	}
+}
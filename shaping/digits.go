@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"unicode"
+
+	"github.com/go-text/typesetting/language"
+)
+
+// DigitSubstitution selects the digits used to display
+// the European digits 0-9 (U+0030 to U+0039).
+type DigitSubstitution uint8
+
+const (
+	// DigitsEuropean keeps the European digits.
+	DigitsEuropean DigitSubstitution = iota
+	// DigitsArabicIndic uses the Arabic-Indic digits (U+0660 to U+0669),
+	// typically used in Arabic.
+	DigitsArabicIndic
+	// DigitsExtendedArabicIndic uses the Extended Arabic-Indic digits (U+06F0 to U+06F9),
+	// typically used in Persian and Urdu.
+	DigitsExtendedArabicIndic
+)
+
+// zero returns the rune used for the digit 0
+func (ds DigitSubstitution) zero() rune {
+	switch ds {
+	case DigitsArabicIndic:
+		return '٠'
+	case DigitsExtendedArabicIndic:
+		return '۰'
+	default:
+		return '0'
+	}
+}
+
+// DigitSubstitutionFor returns the digits natively used by the given language.
+// Arabic spoken in the Maghreb uses European digits.
+func DigitSubstitutionFor(lang language.Language) DigitSubstitution {
+	switch {
+	case lang.IsDerivedFrom("fa"), lang.IsDerivedFrom("ur"), lang.IsDerivedFrom("ps"),
+		lang.IsDerivedFrom("sd"), lang.IsDerivedFrom("ks"):
+		return DigitsExtendedArabicIndic
+	case lang.IsDerivedFrom("ar"):
+		for _, l := range lang.SimpleInheritance() {
+			switch l {
+			case "ar-ma", "ar-dz", "ar-tn", "ar-ly", "ar-eh":
+				return DigitsEuropean
+			}
+		}
+		return DigitsArabicIndic
+	default:
+		return DigitsEuropean
+	}
+}
+
+// SubstituteDigits replaces in place the European digits of [text]
+// by the digits selected by [ds], so that the text may then be shaped
+// as usual : the number of runes is not modified.
+//
+// If [contextual] is true, a digit is only substituted when the closest
+// preceding letter is written in the Arabic script (or, at the start of the text,
+// if the first letter is), which is the behavior expected in mixed text.
+func SubstituteDigits(text []rune, ds DigitSubstitution, contextual bool) {
+	if ds == DigitsEuropean {
+		return
+	}
+	zero := ds.zero()
+	isArabicContext := true
+	if contextual {
+		isArabicContext = firstLetterIsArabic(text)
+	}
+	for i, r := range text {
+		if '0' <= r && r <= '9' {
+			if isArabicContext {
+				text[i] = zero + (r - '0')
+			}
+		} else if contextual && unicode.IsLetter(r) {
+			isArabicContext = language.LookupScript(r) == language.Arabic
+		}
+	}
+}
+
+func firstLetterIsArabic(text []rune) bool {
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			return language.LookupScript(r) == language.Arabic
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/language"
+)
+
+func TestDigitSubstitutionFor(t *testing.T) {
+	for _, test := range []struct {
+		lang     string
+		expected DigitSubstitution
+	}{
+		{"en", DigitsEuropean},
+		{"ar", DigitsArabicIndic},
+		{"ar-EG", DigitsArabicIndic},
+		{"ar-MA", DigitsEuropean},
+		{"fa-IR", DigitsExtendedArabicIndic},
+		{"ur", DigitsExtendedArabicIndic},
+	} {
+		if got := DigitSubstitutionFor(language.NewLanguage(test.lang)); got != test.expected {
+			t.Errorf("%s: expected %d, got %d", test.lang, test.expected, got)
+		}
+	}
+}
+
+func TestSubstituteDigits(t *testing.T) {
+	for _, test := range []struct {
+		text       string
+		ds         DigitSubstitution
+		contextual bool
+		expected   string
+	}{
+		{"abc 123", DigitsEuropean, false, "abc 123"},
+		{"abc 123", DigitsArabicIndic, false, "abc ١٢٣"},
+		{"عدد 45", DigitsExtendedArabicIndic, false, "عدد ۴۵"},
+		{"عدد 45 and 67", DigitsArabicIndic, true, "عدد ٤٥ and 67"},
+		{"12 عدد", DigitsArabicIndic, true, "١٢ عدد"},
+		{"12 abc", DigitsArabicIndic, true, "12 abc"},
+	} {
+		text := []rune(test.text)
+		SubstituteDigits(text, test.ds, test.contextual)
+		if got := string(text); got != test.expected {
+			t.Errorf("%q: expected %q, got %q", test.text, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"github.com/go-text/typesetting/segmenter"
+	"golang.org/x/image/math/fixed"
+)
+
+// ElideMode selects where text is removed by [Elide].
+type ElideMode uint8
+
+const (
+	// ElideEnd removes text at the end, as in "Long file na…".
+	ElideEnd ElideMode = iota
+	// ElideStart removes text at the start, as in "…ong file name".
+	ElideStart
+	// ElideMiddle removes text in the middle, as in "Long f…e name",
+	// which is typically used for file names.
+	ElideMiddle
+)
+
+// Elide fits [run] into [maxWidth] by removing whole grapheme clusters at the
+// position selected by [mode], and inserting [ellipsis] (which may be empty) in their place.
+// [run] must be the result of shaping [text], so that its Runes field indexes into [text].
+//
+// The runs of the returned line are in logical order, and the Runes field of the
+// inserted ellipsis covers the removed runes, so that the line still covers all the runes
+// of [run]. If [run] already fits, it is returned unchanged, with false.
+func Elide(text []rune, run Output, ellipsis Output, maxWidth fixed.Int26_6, mode ElideMode) (_ Line, elided bool) {
+	if run.Advance <= maxWidth || run.Runes.Count == 0 {
+		return Line{run}, false
+	}
+
+	// the ends of the grapheme clusters, as absolute rune indices
+	var seg segmenter.Segmenter
+	seg.Init(text[run.Runes.Offset : run.Runes.Offset+run.Runes.Count])
+	var ends []int
+	for iter := seg.GraphemeIterator(); iter.Next(); {
+		g := iter.Grapheme()
+		ends = append(ends, run.Runes.Offset+g.Offset+len(g.Text))
+	}
+	starts := make([]int, len(ends)) // starts[i] is the start of the grapheme i
+	starts[0] = run.Runes.Offset
+	copy(starts[1:], ends)
+
+	mapping := mapRunesToClusterIndices(run.Direction, run.Runes, run.Glyphs, nil)
+	runEnd := run.Runes.Offset + run.Runes.Count
+	// prefix returns the first n graphemes, suffix the last n
+	prefix := func(n int) Output {
+		if n == 0 {
+			return Output{}
+		}
+		return cutRun(text, run, mapping, run.Runes.Offset, ends[n-1]-1)
+	}
+	suffix := func(n int) Output {
+		if n == 0 {
+			return Output{}
+		}
+		return cutRun(text, run, mapping, starts[len(starts)-n], runEnd-1)
+	}
+
+	available := maxWidth - ellipsis.Advance
+	var kept [2]int // number of graphemes kept at the start and at the end
+	switch mode {
+	case ElideEnd:
+		for kept[0] < len(ends) && prefix(kept[0]+1).Advance <= available {
+			kept[0]++
+		}
+	case ElideStart:
+		for kept[1] < len(ends) && suffix(kept[1]+1).Advance <= available {
+			kept[1]++
+		}
+	case ElideMiddle:
+		// alternatively add a grapheme at the start and at the end,
+		// as long as it fits
+		for side := 0; kept[0]+kept[1] < len(ends); side = 1 - side {
+			next := kept
+			next[side]++
+			if prefix(next[0]).Advance+suffix(next[1]).Advance > available {
+				// try the other side before giving up
+				next = kept
+				next[1-side]++
+				if prefix(next[0]).Advance+suffix(next[1]).Advance > available {
+					break
+				}
+			}
+			kept = next
+		}
+	}
+
+	removedStart, removedEnd := run.Runes.Offset, runEnd
+	var line Line
+	if kept[0] != 0 {
+		line = append(line, prefix(kept[0]))
+		removedStart = ends[kept[0]-1]
+	}
+	if kept[1] != 0 {
+		removedEnd = starts[len(starts)-kept[1]]
+	}
+	ellipsis.Runes = Range{Offset: removedStart, Count: removedEnd - removedStart}
+	if run.Bytes.Count != 0 {
+		ellipsis.Bytes = Range{
+			Offset: run.Bytes.Offset + utf8Len(text[run.Runes.Offset:removedStart]),
+			Count:  utf8Len(text[removedStart:removedEnd]),
+		}
+	}
+	line = append(line, ellipsis)
+	if kept[1] != 0 {
+		line = append(line, suffix(kept[1]))
+	}
+	return line, true
+}
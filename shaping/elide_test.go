@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func shapeLatin(text []rune) Output {
+	var shaper HarfbuzzShaper
+	return shaper.Shape(Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("EN"),
+	})
+}
+
+func TestElide(t *testing.T) {
+	text := []rune("long_file_name.txt")
+	run := shapeLatin(text)
+	ellipsis := shapeLatin([]rune("…"))
+	charWidth := shapeLatin([]rune("n")).Advance
+
+	// no elision needed
+	line, elided := Elide(text, run, ellipsis, run.Advance, ElideEnd)
+	if elided || len(line) != 1 {
+		t.Fatalf("unexpected elision")
+	}
+
+	maxWidth := run.Advance / 2
+	for _, test := range []struct {
+		mode ElideMode
+	}{
+		{ElideEnd},
+		{ElideStart},
+		{ElideMiddle},
+	} {
+		line, elided := Elide(text, run, ellipsis, maxWidth, test.mode)
+		if !elided {
+			t.Fatalf("mode %d: expected elision", test.mode)
+		}
+		var width fixed.Int26_6
+		runes := 0
+		for _, r := range line {
+			width += r.Advance
+			if r.Runes.Offset != runes {
+				t.Errorf("mode %d: unexpected rune offset %v", test.mode, r.Runes)
+			}
+			runes += r.Runes.Count
+		}
+		if width > maxWidth {
+			t.Errorf("mode %d: line too wide: %d > %d", test.mode, width, maxWidth)
+		}
+		if width+charWidth*2 < maxWidth {
+			t.Errorf("mode %d: too much text removed: %d for %d", test.mode, width, maxWidth)
+		}
+		if runes != len(text) {
+			t.Errorf("mode %d: expected the line to cover %d runes, got %d", test.mode, len(text), runes)
+		}
+
+		switch test.mode {
+		case ElideEnd:
+			if len(line) != 2 || line[1].Glyphs[0].GlyphID != ellipsis.Glyphs[0].GlyphID {
+				t.Errorf("expected the ellipsis at the end")
+			}
+		case ElideStart:
+			if len(line) != 2 || line[0].Glyphs[0].GlyphID != ellipsis.Glyphs[0].GlyphID {
+				t.Errorf("expected the ellipsis at the start")
+			}
+		case ElideMiddle:
+			if len(line) != 3 || line[1].Glyphs[0].GlyphID != ellipsis.Glyphs[0].GlyphID {
+				t.Errorf("expected the ellipsis in the middle")
+			}
+		}
+	}
+
+	// grapheme clusters are never split
+	text = []rune("ae\u0301e\u0301e\u0301e\u0301")
+	run = shapeLatin(text)
+	for width := fixed.Int26_6(0); width < run.Advance; width += charWidth / 4 {
+		line, _ := Elide(text, run, Output{}, width, ElideEnd)
+		if end := line[0].Runes.Offset + line[0].Runes.Count; len(line) == 2 && end%2 == 0 {
+			t.Errorf("grapheme split at %d", end)
+		}
+	}
+}
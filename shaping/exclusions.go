@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import "golang.org/x/image/math/fixed"
+
+// Exclusion is a rectangular area of a paragraph that wrapped lines
+// must avoid, such as a drop cap or an inline image.
+type Exclusion struct {
+	// Rect is the excluded area, relative to the top left corner
+	// of the paragraph, with the Y axis pointing down.
+	// Areas touching the left edge of the paragraph (Rect.Min.X <= 0) indent the
+	// start of the lines, other areas reduce the width available on their left.
+	Rect fixed.Rectangle26_6
+}
+
+// LinePlacement is the position and the width available to a wrapped line,
+// resulting from the exclusion zones of the [WrapConfig].
+type LinePlacement struct {
+	// Top is the top of the line, relative to the top of the paragraph,
+	// with the Y axis pointing down.
+	// It is moved below the exclusions leaving no space for the line.
+	Top fixed.Int26_6
+	// Indent is the distance from the left edge of the paragraph
+	// to the left edge of the line.
+	Indent int
+	// Width is the width used to wrap the line.
+	Width int
+}
+
+// LinePlacements returns the placements of the lines wrapped since the last call
+// to [LineWrapper.Prepare] (or [LineWrapper.WrapParagraph]), in order, if the
+// Exclusions field of the config is not empty.
+//
+// The returned slice is only valid until the next call to Prepare.
+func (l *LineWrapper) LinePlacements() []LinePlacement { return l.placements }
+
+// placeLine returns the placement of the next line, below the previous ones.
+// The height of the line is estimated from the run containing its first rune.
+func (l *LineWrapper) placeLine(maxWidth int) LinePlacement {
+	var bounds Bounds
+	for _, run := range l.glyphRuns[l.currentRun:] {
+		bounds = run.LineBounds
+		if l.lineStartRune < run.Runes.Offset+run.Runes.Count {
+			break
+		}
+	}
+	height := bounds.Ascent - bounds.Descent + bounds.Gap
+
+	top := l.lineTop
+	for {
+		out := placeBetween(l.config.Exclusions, top, top+height, maxWidth)
+		if out.Width > 0 {
+			return out
+		}
+		// move below the first exclusion ending after [top]
+		next := top
+		for _, ex := range l.config.Exclusions {
+			if ex.Rect.Max.Y > top && overlaps(ex.Rect, top, top+height) && (next == top || ex.Rect.Max.Y < next) {
+				next = ex.Rect.Max.Y
+			}
+		}
+		if next == top { // should not happen
+			return out
+		}
+		top = next
+	}
+}
+
+// placeBetween returns the horizontal space left by [exclusions]
+// for a line spanning from [top] to [bottom].
+func placeBetween(exclusions []Exclusion, top, bottom fixed.Int26_6, maxWidth int) LinePlacement {
+	out := LinePlacement{Top: top}
+	end := maxWidth
+	for _, ex := range exclusions {
+		if !overlaps(ex.Rect, top, bottom) {
+			continue
+		}
+		if ex.Rect.Min.X <= 0 {
+			if indent := ex.Rect.Max.X.Ceil(); indent > out.Indent {
+				out.Indent = indent
+			}
+		} else if start := ex.Rect.Min.X.Floor(); start < end {
+			end = start
+		}
+	}
+	out.Width = end - out.Indent
+	return out
+}
+
+// overlaps returns true if [rect] vertically overlaps
+// the line spanning from [top] to [bottom].
+func overlaps(rect fixed.Rectangle26_6, top, bottom fixed.Int26_6) bool {
+	return !rect.Empty() && rect.Min.Y < bottom && top < rect.Max.Y
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestWrapExclusions(t *testing.T) {
+	text := []rune("The quick brown fox jumps over the lazy dog, again and again, and again and again, until the fox is tired of jumping.")
+	run := (&HarfbuzzShaper{}).Shape(Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	})
+	lineHeight := run.LineBounds.LineHeight()
+	rect := func(x0, y0, x1, y1 fixed.Int26_6) fixed.Rectangle26_6 {
+		return fixed.Rectangle26_6{Min: fixed.Point26_6{X: x0, Y: y0}, Max: fixed.Point26_6{X: x1, Y: y1}}
+	}
+	lineWidth := func(line Line) int {
+		var width fixed.Int26_6
+		for _, run := range line {
+			width += run.Advance
+		}
+		return width.Ceil()
+	}
+
+	var wrapper LineWrapper
+	config := WrapConfig{Exclusions: []Exclusion{
+		{Rect: rect(0, 0, fixed.I(40), 2*lineHeight)},                        // drop cap on two lines
+		{Rect: rect(fixed.I(150), 3*lineHeight, fixed.I(200), 4*lineHeight)}, // image on the right of the fourth line
+	}}
+	lines, _ := wrapper.WrapParagraph(config, 200, text, run)
+	placements := wrapper.LinePlacements()
+	if len(lines) < 5 || len(placements) != len(lines) {
+		t.Fatalf("unexpected placements %v for %d lines", placements, len(lines))
+	}
+	for i, expected := range []LinePlacement{
+		{Top: 0, Indent: 40, Width: 160},
+		{Top: lineHeight, Indent: 40, Width: 160},
+		{Top: 2 * lineHeight, Indent: 0, Width: 200},
+		{Top: 3 * lineHeight, Indent: 0, Width: 150},
+		{Top: 4 * lineHeight, Indent: 0, Width: 200},
+	} {
+		if placements[i] != expected {
+			t.Errorf("line %d: expected %v, got %v", i, expected, placements[i])
+		}
+		if w := lineWidth(lines[i]); w > expected.Width {
+			t.Errorf("line %d: width %d exceeds %d", i, w, expected.Width)
+		}
+	}
+
+	// an exclusion spanning the whole width moves the line below it
+	config.Exclusions = []Exclusion{{Rect: rect(0, lineHeight/2, fixed.I(200), 2*lineHeight)}}
+	wrapper.WrapParagraph(config, 200, text, run)
+	placements = wrapper.LinePlacements()
+	if len(placements) < 2 || placements[0].Top != 2*lineHeight || placements[0].Width != 200 {
+		t.Fatalf("unexpected placements %v", placements)
+	}
+
+	// without exclusions, no placements are computed
+	wrapper.WrapParagraph(WrapConfig{}, 200, text, run)
+	if len(wrapper.LinePlacements()) != 0 {
+		t.Fatal("unexpected placements")
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import "golang.org/x/image/math/fixed"
+
+// LineBaseline is the position of a wrapped line snapped
+// to the baseline grid of the [WrapConfig].
+type LineBaseline struct {
+	// Baseline is the position of the baseline, relative to the top of
+	// the paragraph, with the Y axis pointing down. It is a multiple of the grid.
+	Baseline fixed.Int26_6
+	// Height is the height of the line, rounded up to a multiple of the grid,
+	// so that the baseline of the next line is also on the grid.
+	Height fixed.Int26_6
+	// Leading is the space added to the natural height of the line
+	// (its ascent, descent and gap) by the snapping.
+	Leading fixed.Int26_6
+}
+
+// LineBaselines returns the snapped baselines of the lines wrapped since the last call
+// to [LineWrapper.Prepare] (or [LineWrapper.WrapParagraph]), in order, if the
+// BaselineGrid field of the config is not zero.
+//
+// The returned slice is only valid until the next call to Prepare.
+func (l *LineWrapper) LineBaselines() []LineBaseline { return l.baselines }
+
+// snapLine returns the position of the line with the
+// given logical rectangle, starting at [top].
+func snapLine(grid, top fixed.Int26_6, logical fixed.Rectangle26_6) LineBaseline {
+	top = roundUpTo(top, grid)
+	ascent, rest := -logical.Min.Y, logical.Max.Y // rest is the descent and the gap
+	above := roundUpTo(ascent, grid)
+	height := roundUpTo(above+rest, grid)
+	return LineBaseline{
+		Baseline: top + above,
+		Height:   height,
+		Leading:  height - (ascent + rest),
+	}
+}
+
+// roundUpTo returns the smallest multiple of [grid] greater than or equal to [v],
+// or [v] if [grid] is not positive.
+func roundUpTo(v, grid fixed.Int26_6) fixed.Int26_6 {
+	if grid <= 0 {
+		return v
+	}
+	q := v / grid
+	if q*grid < v {
+		q++
+	}
+	return q * grid
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestWrapBaselineGrid(t *testing.T) {
+	text := []rune("The quick brown fox jumps over the lazy dog, again and again, and again and again.")
+	run := (&HarfbuzzShaper{}).Shape(Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	})
+	lineHeight := run.LineBounds.LineHeight()
+	grid := fixed.I(12)
+
+	var wrapper LineWrapper
+	lines, _ := wrapper.WrapParagraph(WrapConfig{BaselineGrid: grid}, 150, text, run)
+	baselines := wrapper.LineBaselines()
+	if len(lines) < 3 || len(baselines) != len(lines) {
+		t.Fatalf("unexpected baselines %v for %d lines", baselines, len(lines))
+	}
+	expectedHeight := roundUpTo(roundUpTo(run.LineBounds.Ascent, grid)-run.LineBounds.Descent+run.LineBounds.Gap, grid)
+	for i, b := range baselines {
+		if b.Baseline%grid != 0 || b.Height%grid != 0 {
+			t.Errorf("line %d: baseline not on the grid: %v", i, b)
+		}
+		if b.Height != expectedHeight || b.Leading != b.Height-lineHeight || b.Leading < 0 {
+			t.Errorf("line %d: unexpected height %v", i, b)
+		}
+		if b.Baseline < run.LineBounds.Ascent || (i > 0 && b.Baseline != baselines[i-1].Baseline+b.Height) {
+			t.Errorf("line %d: unexpected baseline %v", i, b)
+		}
+	}
+
+	// the grid also applies to a single line
+	wrapper.WrapParagraph(WrapConfig{BaselineGrid: grid}, 10000, text, run)
+	if baselines := wrapper.LineBaselines(); len(baselines) != 1 || baselines[0].Baseline != roundUpTo(run.LineBounds.Ascent, grid) {
+		t.Errorf("unexpected baselines %v", baselines)
+	}
+	// without the option, nothing is reported
+	wrapper.WrapParagraph(WrapConfig{}, 150, text, run)
+	if len(wrapper.LineBaselines()) != 0 {
+		t.Error("unexpected baselines")
+	}
+}
+
+func TestSnapLine(t *testing.T) {
+	logical := fixed.Rectangle26_6{Min: fixed.Point26_6{Y: -fixed.I(5)}, Max: fixed.Point26_6{Y: fixed.I(4)}}
+	// the rounded ascent pushes the descent below the rounded natural height
+	got := snapLine(fixed.I(10), fixed.I(3), logical)
+	if exp := (LineBaseline{Baseline: fixed.I(20), Height: fixed.I(20), Leading: fixed.I(11)}); got != exp {
+		t.Errorf("expected %v, got %v", exp, got)
+	}
+	if roundUpTo(fixed.I(20), fixed.I(10)) != fixed.I(20) || roundUpTo(-fixed.I(5), fixed.I(10)) != 0 || roundUpTo(7, 0) != 7 {
+		t.Error("unexpected rounding")
+	}
+}
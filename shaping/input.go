@@ -9,6 +9,8 @@ import (
 	"github.com/go-text/typesetting/font"
 	"github.com/go-text/typesetting/harfbuzz"
 	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/loader"
+	"github.com/go-text/typesetting/segmenter"
 	"golang.org/x/image/math/fixed"
 )
 
@@ -34,7 +36,28 @@ type Input struct {
 	Script language.Script
 
 	// Language is an identifier for the language of the text.
+	// By default, it is used to select the OpenType language system of the font,
+	// with its localized forms ('locl' feature), and to apply
+	// language specific adjustments, like avoiding the "fi" ligature in Turkish.
 	Language language.Language
+
+	// DisableLocaleFeatures, if true, ignores [Language] when selecting
+	// the font features : the default language system of the font is used,
+	// and no language specific adjustments are applied.
+	DisableLocaleFeatures bool
+
+	// FontFeatures are the OpenType features (like 'smcp' or 'tnum')
+	// applied to the whole run, overriding the default ones.
+	FontFeatures []FontFeature
+}
+
+// FontFeature sets the value of an OpenType feature.
+type FontFeature struct {
+	Tag loader.Tag
+	// Value 0 disables the feature, and non-zero values (usually 1)
+	// enable it. For alternates features (like 'salt'), Value is
+	// a one-based index into the alternates.
+	Value uint32
 }
 
 // Fontmap provides a general mechanism to select
@@ -46,7 +69,20 @@ type Fontmap interface {
 	ResolveFace(r rune) font.Face
 }
 
-var _ Fontmap = fixedFontmap(nil)
+// EmojiFontmap is an optional extension of [Fontmap], used by [SplitByFace]
+// to select the face of emoji sequences according to their presentation :
+// the default one of the emoji, or the one explicitly requested by the
+// variation selectors VS15 (text) and VS16 (emoji).
+type EmojiFontmap interface {
+	Fontmap
+	// ResolveEmojiFace is called by `SplitByFace` for the first rune of each
+	// emoji sequence, with [color] set to true for the emoji (color) presentation.
+	// The whole sequence is shaped with the returned face.
+	// It must always return a valid (non nil) font.Face value.
+	ResolveEmojiFace(r rune, color bool) font.Face
+}
+
+var _ EmojiFontmap = fixedFontmap(nil)
 
 type fixedFontmap []font.Face
 
@@ -60,10 +96,23 @@ func (ff fixedFontmap) ResolveFace(r rune) font.Face {
 	return ff[0]
 }
 
+// ResolveEmojiFace returns the first face supporting [r] with the
+// requested presentation, or defaults to [ResolveFace].
+func (ff fixedFontmap) ResolveEmojiFace(r rune, color bool) font.Face {
+	for _, f := range ff {
+		if gid, has := f.NominalGlyph(r); has && f.HasColorGlyph(gid) == color {
+			return f
+		}
+	}
+	return ff.ResolveFace(r)
+}
+
 // SplitByFontGlyphs split the runes from 'input' to several items, sharing the same
 // characteristics as 'input', expected for the `Face` which is set to
 // the first font among 'availableFonts' providing support for all the runes
 // in the item.
+// Emoji sequences are mapped to the first font providing a color glyph
+// if they have the emoji presentation, or a non color glyph otherwise.
 // Runes supported by no fonts are mapped to the first element of 'availableFonts', which
 // must not be empty.
 // The 'Face' field of 'input' is ignored: only 'availableFaces' are consulted.
@@ -77,18 +126,44 @@ func SplitByFontGlyphs(input Input, availableFaces []font.Face) []Input {
 // characteristics as 'input', expected for the `Face` which is set to
 // the return value of the `Fontmap.ResolveFace` call.
 // The 'Face' field of 'input' is ignored: only 'availableFaces' is used to select the face.
+// If 'availableFaces' implements [EmojiFontmap], the emoji sequences are kept in one item,
+// whose face is selected according to the presentation of the sequence.
 func SplitByFace(input Input, availableFaces Fontmap) []Input {
+	var (
+		emojis   *segmenter.EmojiIterator
+		hasEmoji bool
+	)
+	emojiFontmap, resolveEmojis := availableFaces.(EmojiFontmap)
+	if resolveEmojis {
+		emojis = segmenter.NewEmojiIterator(input.Text[input.RunStart:input.RunEnd])
+		hasEmoji = emojis.Next()
+	}
+
 	var splitInputs []Input
 	currentInput := input
 	for i := input.RunStart; i < input.RunEnd; i++ {
 		r := input.Text[i]
+
+		var selectedFace font.Face
+		if hasEmoji && input.RunStart+emojis.Sequence().Offset == i {
+			seq := emojis.Sequence()
+			selectedFace = emojiFontmap.ResolveEmojiFace(r, seq.IsEmojiPresentation)
+			hasEmoji = emojis.Next()
+			if currentInput.Face != selectedFace {
+				splitInputs, currentInput = appendSplit(splitInputs, currentInput, input, i, selectedFace)
+			}
+			// add the whole sequence to the current input
+			i += len(seq.Text) - 1
+			continue
+		}
+
 		if currentInput.Face != nil && ignoreFaceChange(r) {
 			// add the rune to the current input
 			continue
 		}
 
 		// select the first font supporting r
-		selectedFace := availableFaces.ResolveFace(r)
+		selectedFace = availableFaces.ResolveFace(r)
 
 		if currentInput.Face == selectedFace {
 			// add the rune to the current input
@@ -96,18 +171,83 @@ func SplitByFace(input Input, availableFaces Fontmap) []Input {
 		}
 
 		// new face needed
+		splitInputs, currentInput = appendSplit(splitInputs, currentInput, input, i, selectedFace)
+	}
+
+	// close and add the last input
+	currentInput.RunEnd = input.RunEnd
+	splitInputs = append(splitInputs, currentInput)
+	return splitInputs
+}
+
+// appendSplit closes [current] at [i], adds it to [splitInputs] (unless it is empty)
+// and returns a new item, starting at [i] with [face].
+func appendSplit(splitInputs []Input, current, input Input, i int, face font.Face) ([]Input, Input) {
+	if i != input.RunStart {
+		// close the current input ...
+		current.RunEnd = i
+		// ... add it to the output ...
+		splitInputs = append(splitInputs, current)
+	}
+
+	// ... and create a new one
+	current = input
+	current.RunStart = i
+	current.Face = face
+	return splitInputs, current
+}
+
+// SplitByScript split the runes from 'input' to several items, sharing the same
+// characteristics as 'input', expected for the `Script` which is set to
+// the script of the runes in the item.
+// Runes with a Common or Inherited script are added to the current item. Runes shared
+// by several scripts (see language.LookupScriptExtensions), like the Devanagari danda,
+// are also added to the current item if its script uses them, or else
+// attached to the following item.
+// The 'Script' field of 'input' is only used for items containing
+// no script specific runes.
+func SplitByScript(input Input) []Input {
+	var splitInputs []Input
+	currentInput := input
+	resolved := false // has the script of currentInput been set from the text ?
+	for i := input.RunStart; i < input.RunEnd; i++ {
+		r := input.Text[i]
+		script, extensions := language.LookupScript(r), language.LookupScriptExtensions(r)
+		if extensions == nil && isSharedScript(script) {
+			// add the rune to the current input
+			continue
+		}
+		if extensions != nil {
+			if resolved && containsScript(extensions, currentInput.Script) {
+				// add the rune to the current input
+				continue
+			}
+			script = resolveScriptExtensions(input.Text[i+1:input.RunEnd], extensions, input.Script)
+		}
 
-		if i != input.RunStart {
-			// close the current input ...
-			currentInput.RunEnd = i
-			// ... add it to the output ...
-			splitInputs = append(splitInputs, currentInput)
+		if !resolved {
+			// the runes seen so far are shared: use the script of r
+			currentInput.Script = script
+			resolved = true
+			continue
 		}
 
+		if currentInput.Script == script {
+			// add the rune to the current input
+			continue
+		}
+
+		// new script needed
+
+		// close the current input ...
+		currentInput.RunEnd = i
+		// ... add it to the output ...
+		splitInputs = append(splitInputs, currentInput)
+
 		// ... and create a new one
 		currentInput = input
 		currentInput.RunStart = i
-		currentInput.Face = selectedFace
+		currentInput.Script = script
 	}
 
 	// close and add the last input
@@ -116,6 +256,75 @@ func SplitByFace(input Input, availableFaces Fontmap) []Input {
 	return splitInputs
 }
 
+// SplitByScriptString is the same as [SplitByScript], for the UTF-8 [text] used by
+// [HarfbuzzShaper.ShapeString] : input.Text is ignored, and the RunStart and RunEnd
+// fields of [input] and of the returned items are byte offsets into [text].
+func SplitByScriptString(input Input, text string) []Input {
+	return splitString(input, text, SplitByScript)
+}
+
+// SplitByFaceString is the same as [SplitByFace], for the UTF-8 [text] used by
+// [HarfbuzzShaper.ShapeString] : input.Text is ignored, and the RunStart and RunEnd
+// fields of [input] and of the returned items are byte offsets into [text].
+func SplitByFaceString(input Input, text string, availableFaces Fontmap) []Input {
+	return splitString(input, text, func(input Input) []Input { return SplitByFace(input, availableFaces) })
+}
+
+// splitString decodes the run of [input] from [text], splits it with [split],
+// and converts the runs of the resulting items back to byte offsets.
+func splitString(input Input, text string, split func(Input) []Input) []Input {
+	start, end := clampRun(input.RunStart, input.RunEnd, len(text))
+	runes := make([]rune, 0, end-start)
+	offsets := make([]int, 0, end-start+1) // byte offset of each rune, and of the end of the run
+	for i, r := range text[start:end] {
+		runes = append(runes, r)
+		offsets = append(offsets, start+i)
+	}
+	offsets = append(offsets, end)
+
+	input.Text, input.RunStart, input.RunEnd = runes, 0, len(runes)
+	items := split(input)
+	for i := range items {
+		items[i].Text = nil
+		items[i].RunStart, items[i].RunEnd = offsets[items[i].RunStart], offsets[items[i].RunEnd]
+	}
+	return items
+}
+
+// isSharedScript returns true for the values of the Script property
+// which do not identify a specific writing system.
+func isSharedScript(script language.Script) bool {
+	return script == language.Common || script == language.Inherited || script == language.Unknown
+}
+
+func containsScript(scripts []language.Script, script language.Script) bool {
+	for _, s := range scripts {
+		if s == script {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveScriptExtensions selects the script to use for a rune used by
+// several scripts, looking at the following runes in 'text', and then at 'defaultScript'.
+func resolveScriptExtensions(text []rune, extensions []language.Script, defaultScript language.Script) language.Script {
+	for _, r := range text {
+		script := language.LookupScript(r)
+		if isSharedScript(script) {
+			continue
+		}
+		if containsScript(extensions, script) {
+			return script
+		}
+		break
+	}
+	if containsScript(extensions, defaultScript) {
+		return defaultScript
+	}
+	return extensions[0]
+}
+
 // ignoreFaceChange returns `true` is the given rune should not trigger
 // a change of font.
 //
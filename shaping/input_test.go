@@ -7,6 +7,7 @@ import (
 	"unicode"
 
 	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
 	"github.com/go-text/typesetting/opentype/api"
 	oFont "github.com/go-text/typesetting/opentype/api/font"
 )
@@ -217,3 +218,120 @@ func TestSplitByFontGlyphs(t *testing.T) {
 		})
 	}
 }
+
+// emojiFontmap uses [text] for the runes,
+// and [textEmoji] or [colorEmoji] for the emoji sequences.
+type emojiFontmap struct {
+	text, textEmoji, colorEmoji font.Face
+}
+
+func (fm emojiFontmap) ResolveFace(r rune) font.Face { return fm.text }
+
+func (fm emojiFontmap) ResolveEmojiFace(r rune, color bool) font.Face {
+	if color {
+		return fm.colorEmoji
+	}
+	return fm.textEmoji
+}
+
+func TestSplitByFaceEmoji(t *testing.T) {
+	fm := emojiFontmap{
+		text:       &oFont.Face{Font: &oFont.Font{Cmap: universalCmap{}}},
+		textEmoji:  &oFont.Face{Font: &oFont.Font{Cmap: universalCmap{}}},
+		colorEmoji: &oFont.Face{Font: &oFont.Font{Cmap: universalCmap{}}},
+	}
+	// U+263A defaults to the text presentation, U+1F600 to the emoji presentation,
+	// and emoji modifier sequences are always displayed as emoji
+	text := []rune("xa\u263A\uFE0F b\u263A c\U0001F600\uFE0E d\U0001F44D\U0001F3FD e")
+	input := Input{Text: text, RunStart: 1, RunEnd: len(text)}
+	got := SplitByFace(input, fm)
+
+	expected := []struct {
+		start, end int
+		face       font.Face
+	}{
+		{1, 2, fm.text},
+		{2, 5, fm.colorEmoji},
+		{5, 6, fm.text},
+		{6, 8, fm.textEmoji},
+		{8, 9, fm.text},
+		{9, 12, fm.textEmoji},
+		{12, 13, fm.text},
+		{13, 16, fm.colorEmoji},
+		{16, 17, fm.text},
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d items, got %d", len(expected), len(got))
+	}
+	for i, exp := range expected {
+		if got[i].RunStart != exp.start || got[i].RunEnd != exp.end || got[i].Face != exp.face {
+			t.Errorf("item %d: expected [%d, %d), got [%d, %d)", i, exp.start, exp.end, got[i].RunStart, got[i].RunEnd)
+		}
+	}
+
+	// the same items, with byte offsets
+	str := string(text)
+	stringInput := Input{RunStart: len(string(text[:1])), RunEnd: len(str)}
+	got = SplitByFaceString(stringInput, str, fm)
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d items, got %d", len(expected), len(got))
+	}
+	for i, exp := range expected {
+		start, end := len(string(text[:exp.start])), len(string(text[:exp.end]))
+		if got[i].RunStart != start || got[i].RunEnd != end || got[i].Face != exp.face || got[i].Text != nil {
+			t.Errorf("item %d: expected [%d, %d), got [%d, %d)", i, start, end, got[i].RunStart, got[i].RunEnd)
+		}
+	}
+
+	// a plain Fontmap ignores the emoji sequences
+	got = SplitByFace(input, struct{ Fontmap }{fm})
+	if len(got) != 1 || got[0].Face != fm.text {
+		t.Errorf("unexpected split %v", got)
+	}
+}
+
+func TestSplitByScript(t *testing.T) {
+	type item struct {
+		start, end int
+		script     language.Script
+	}
+	for _, test := range []struct {
+		text     string
+		expected []item
+	}{
+		{"", []item{{0, 0, language.Latin}}},
+		{"123 ", []item{{0, 4, language.Latin}}},
+		{"abc", []item{{0, 3, language.Latin}}},
+		{"abc تثذ", []item{{0, 4, language.Latin}, {4, 7, language.Arabic}}},
+		// the danda is shared by Devanagari and Bengali
+		{"नमस्ते। বাংলা।", []item{{0, 8, language.Devanagari}, {8, 14, language.Bengali}}},
+		// the katakana middle dot and the prolonged sound mark are shared by Hiragana and Katakana
+		{"カ・ひーカ", []item{{0, 2, language.Katakana}, {2, 4, language.Hiragana}, {4, 5, language.Katakana}}},
+		// ideographic punctuation at the start is attached to the following runes
+		{"「日本」", []item{{0, 4, language.Han}}},
+		{"abc、日本", []item{{0, 3, language.Latin}, {3, 6, language.Han}}},
+		// Arabic comma in Syriac text
+		{"ܐ، ܐ", []item{{0, 4, language.Syriac}}},
+	} {
+		text := []rune(test.text)
+		items := SplitByScript(Input{Text: text, RunEnd: len(text), Script: language.Latin})
+		var got []item
+		for _, it := range items {
+			got = append(got, item{it.RunStart, it.RunEnd, it.Script})
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.text, test.expected, got)
+		}
+
+		// the same items, with byte offsets
+		items = SplitByScriptString(Input{RunEnd: len(test.text), Script: language.Latin}, test.text)
+		if len(items) != len(test.expected) {
+			t.Fatalf("%q: expected %d items, got %d", test.text, len(test.expected), len(items))
+		}
+		for i, it := range items {
+			if exp := test.expected[i]; it.RunStart != len(string(text[:exp.start])) || it.RunEnd != len(string(text[:exp.end])) || it.Script != exp.script {
+				t.Errorf("%q: unexpected item %d: [%d, %d)", test.text, i, it.RunStart, it.RunEnd)
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import "golang.org/x/image/math/fixed"
+
+// Justification configures the justification of the lines
+// wrapped by a [LineWrapper] (see [WrapConfig]).
+type Justification struct {
+	// Enabled stretches the inner spaces of each wrapped line, except
+	// the last line of the paragraph, so that it fills the maximum width.
+	// Justification is only supported for horizontal text.
+	Enabled bool
+	// MaxSpaceRatio, if not zero, is the maximum ratio between the justified
+	// advance of the spaces of a line and their natural advance (typically 2 or 3).
+	// The space which can't be added to the spaces is added between the clusters
+	// if InterLetter is true, or is left at the end of the line otherwise.
+	MaxSpaceRatio float32
+	// MinSpaceRatio, if not zero, is the minimum ratio between the justified
+	// advance of the spaces of a line and their natural advance (typically 0.8).
+	// The line breaking then accepts the lines fitting in the maximum width
+	// once their spaces are shrunk, including the last line of the paragraph.
+	MinSpaceRatio float32
+	// InterLetter distributes the space which is not added to the spaces
+	// after each cluster of the line. This is required to justify Chinese or Japanese text,
+	// whose lines usually have no space.
+	InterLetter bool
+}
+
+// justify returns a copy of [line], justified to fill [maxWidth],
+// leaving out its trailing spaces.
+// The last line of the paragraph is only shrunk, if it does not fit.
+func (l *LineWrapper) justify(line Line, maxWidth int, isLast bool) Line {
+	if len(line) == 0 || line[0].Direction.IsVertical() {
+		return line
+	}
+	var width fixed.Int26_6
+	for _, run := range line {
+		width += run.Advance
+	}
+	width -= trailingSpaceAdvance(line[len(line)-1], l.paragraph)
+	if isLast && width <= fixed.I(maxWidth) {
+		return line
+	}
+	line = append(Line(nil), line...)
+	justifyLine(line, l.paragraph, fixed.I(maxWidth)-width, l.config.Justification)
+	return line
+}
+
+// shrinkableWidth returns the advance which may be removed by the justification
+// from the line made of [runs] and [last] : its trailing spaces and the part of
+// its inner spaces allowed by [Justification.MinSpaceRatio].
+// It returns 0 if the spaces may not be shrunk.
+func (l *LineWrapper) shrinkableWidth(runs []Output, last Output) fixed.Int26_6 {
+	opts := l.config.Justification
+	if !opts.Enabled || opts.MinSpaceRatio <= 0 || last.Direction.IsVertical() {
+		return 0
+	}
+	// the spaces after the last non space glyph are trailing spaces
+	lastRune := -1
+	for _, g := range last.Glyphs {
+		if !isClusterSpace(g, l.paragraph) && g.ClusterIndex > lastRune {
+			lastRune = g.ClusterIndex
+		}
+	}
+	for _, run := range runs {
+		for _, g := range run.Glyphs {
+			if !isClusterSpace(g, l.paragraph) && g.ClusterIndex > lastRune {
+				lastRune = g.ClusterIndex
+			}
+		}
+	}
+	var inner, trailing fixed.Int26_6
+	addSpaces := func(glyphs []Glyph) {
+		for _, g := range glyphs {
+			if !isClusterSpace(g, l.paragraph) {
+				continue
+			}
+			if g.ClusterIndex < lastRune {
+				inner += g.XAdvance
+			} else {
+				trailing += g.XAdvance
+			}
+		}
+	}
+	for _, run := range runs {
+		addSpaces(run.Glyphs)
+	}
+	addSpaces(last.Glyphs)
+	return trailing + scaleAdvance(inner, 1-opts.MinSpaceRatio)
+}
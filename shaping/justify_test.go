@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestWrapJustification(t *testing.T) {
+	shape := func(text []rune) Output {
+		return (&HarfbuzzShaper{}).Shape(Input{
+			Text:      text,
+			RunStart:  0,
+			RunEnd:    len(text),
+			Direction: di.DirectionLTR,
+			Face:      benchEnFace,
+			Size:      fixed.I(16),
+			Script:    language.Latin,
+			Language:  language.NewLanguage("en"),
+		})
+	}
+	const maxWidth = 150
+	// lineWidth returns the advance of the line, without its trailing spaces
+	lineWidth := func(line Line, text []rune) fixed.Int26_6 {
+		var width fixed.Int26_6
+		for _, run := range line {
+			width += run.Advance
+		}
+		return width - trailingSpaceAdvance(line[len(line)-1], text)
+	}
+
+	text := []rune("The quick brown fox jumps over the lazy dog, again and again.")
+	run := shape(text)
+	glyphs := append([]Glyph(nil), run.Glyphs...)
+
+	var wrapper LineWrapper
+	ragged, _ := wrapper.WrapParagraph(WrapConfig{}, maxWidth, text, run)
+	lines, _ := wrapper.WrapParagraph(WrapConfig{Justification: Justification{Enabled: true}}, maxWidth, text, run)
+	if len(lines) < 3 || len(lines) != len(ragged) {
+		t.Fatalf("unexpected lines %d", len(lines))
+	}
+	for i, line := range lines {
+		if i == len(lines)-1 {
+			if !reflect.DeepEqual(line, ragged[i]) {
+				t.Errorf("last line should not be justified")
+			}
+		} else if w := lineWidth(line, text); w != fixed.I(maxWidth) {
+			t.Errorf("line %d: expected width %s, got %s", i, fixed.I(maxWidth), w)
+		}
+		for _, run := range line {
+			advance := run.Advance
+			run.RecomputeAdvance()
+			if run.Advance != advance {
+				t.Errorf("line %d: inconsistent advance %s %s", i, advance, run.Advance)
+			}
+		}
+	}
+	if !reflect.DeepEqual(run.Glyphs, glyphs) {
+		t.Error("shaped run should not be modified")
+	}
+
+	// limiting the stretch of the spaces
+	config := WrapConfig{Justification: Justification{Enabled: true, MaxSpaceRatio: 1.1}}
+	lines, _ = wrapper.WrapParagraph(config, maxWidth, text, run)
+	for i, line := range lines[:len(lines)-1] {
+		if w := lineWidth(line, text); w > fixed.I(maxWidth) || w < lineWidth(ragged[i], text) {
+			t.Errorf("line %d: unexpected width %s", i, w)
+		}
+	}
+	// ... the rest is added between the letters
+	config.Justification.InterLetter = true
+	lines, _ = wrapper.WrapParagraph(config, maxWidth, text, run)
+	for i, line := range lines[:len(lines)-1] {
+		if w := lineWidth(line, text); w != fixed.I(maxWidth) {
+			t.Errorf("line %d: expected width %s, got %s", i, fixed.I(maxWidth), w)
+		}
+	}
+
+	// lines without spaces
+	text = []rune("日本語の文章は単語の間に空白を入れずに書かれるので文字の間を調整する")
+	run = shape(text)
+	config = WrapConfig{Justification: Justification{Enabled: true, InterLetter: true}}
+	lines, _ = wrapper.WrapParagraph(config, maxWidth, text, run)
+	if len(lines) < 2 {
+		t.Fatalf("unexpected lines %d", len(lines))
+	}
+	for i, line := range lines[:len(lines)-1] {
+		if w := lineWidth(line, text); w != fixed.I(maxWidth) {
+			t.Errorf("line %d: expected width %s, got %s", i, fixed.I(maxWidth), w)
+		}
+	}
+}
+
+func TestJustifyLineShrink(t *testing.T) {
+	text := []rune("a b c ")
+	glyphs := make([]Glyph, len(text))
+	for i := range glyphs {
+		glyphs[i] = Glyph{ClusterIndex: i, RuneCount: 1, GlyphCount: 1, XAdvance: fixed.I(10)}
+	}
+	line := Line{{Glyphs: glyphs, Advance: fixed.I(60)}}
+
+	// without MinSpaceRatio, lines are not shrunk
+	if added := justifyLine(line, text, -fixed.I(5), Justification{}); added != 0 {
+		t.Fatalf("unexpected shrink %s", added)
+	}
+	// the two inner spaces may lose half of their advance
+	if added := justifyLine(line, text, -fixed.I(15), Justification{MinSpaceRatio: 0.5}); added != -fixed.I(10) {
+		t.Fatalf("unexpected shrink %s", added)
+	}
+	if line[0].Glyphs[1].XAdvance != fixed.I(5) || line[0].Glyphs[3].XAdvance != fixed.I(5) ||
+		line[0].Glyphs[5].XAdvance != fixed.I(10) || line[0].Advance != fixed.I(50) {
+		t.Errorf("unexpected glyphs %v", line[0].Glyphs)
+	}
+	if glyphs[1].XAdvance != fixed.I(10) {
+		t.Error("input glyphs should not be modified")
+	}
+}
+
+func TestWrapJustificationShrink(t *testing.T) {
+	shape := func(text []rune) Output {
+		return (&HarfbuzzShaper{}).Shape(Input{
+			Text:      text,
+			RunStart:  0,
+			RunEnd:    len(text),
+			Direction: di.DirectionLTR,
+			Face:      benchEnFace,
+			Size:      fixed.I(16),
+			Script:    language.Latin,
+			Language:  language.NewLanguage("en"),
+		})
+	}
+	lineRunes := func(line Line) int {
+		n := 0
+		for _, run := range line {
+			n += run.Runes.Count
+		}
+		return n
+	}
+
+	// the first line only fits if its spaces are shrunk
+	text := []rune("The quick brown fox jumps")
+	run := shape(text)
+	maxWidth := shape([]rune("The quick brown")).Advance.Ceil() - 1
+
+	var wrapper LineWrapper
+	config := WrapConfig{Justification: Justification{Enabled: true}}
+	lines, _ := wrapper.WrapParagraph(config, maxWidth, text, run)
+	if n := lineRunes(lines[0]); n != len("The quick ") {
+		t.Fatalf("unexpected first line length %d", n)
+	}
+
+	config.Justification.MinSpaceRatio = 0.5
+	lines, _ = wrapper.WrapParagraph(config, maxWidth, text, run)
+	if n := lineRunes(lines[0]); n != len("The quick brown ") {
+		t.Fatalf("unexpected first line length %d", n)
+	}
+	var width fixed.Int26_6
+	for _, run := range lines[0] {
+		width += run.Advance
+	}
+	if width -= trailingSpaceAdvance(lines[0][len(lines[0])-1], text); width != fixed.I(maxWidth) {
+		t.Errorf("expected width %s, got %s", fixed.I(maxWidth), width)
+	}
+
+	// the last line is also shrunk
+	text = []rune("The quick brown")
+	lines, _ = wrapper.WrapParagraph(config, maxWidth, text, shape(text))
+	if len(lines) != 1 || lines[0][0].Advance != fixed.I(maxWidth) {
+		t.Errorf("unexpected lines %v", lines)
+	}
+}
@@ -0,0 +1,313 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/unicodedata"
+	"golang.org/x/image/math/fixed"
+)
+
+// Alignment specifies the horizontal position of the lines of a paragraph.
+type Alignment uint8
+
+const (
+	// AlignLeft places the lines on the left edge.
+	AlignLeft Alignment = iota
+	// AlignRight places the lines on the right edge.
+	AlignRight
+	// AlignCenter centers the lines.
+	AlignCenter
+	// AlignJustify stretches the spaces of the lines so that they fill the width,
+	// except for the last line of the paragraph, which is aligned on the
+	// start edge (the left edge for left-to-right text).
+	// If the lines are already justified by the line wrapper (see [WrapConfig.Justification]),
+	// they are only aligned on the start edge.
+	AlignJustify
+)
+
+// Paragraph is a paragraph of a [Document].
+type Paragraph struct {
+	// Text is the content of the paragraph, which should
+	// not contain paragraph separators.
+	Text []rune
+	// Input is the template used to shape the text : its Text, RunStart and RunEnd
+	// fields are ignored, and its Script field is only used for text
+	// without script specific runes (see [SplitByScript]).
+	// Bidirectional text is not supported : Input.Direction, which must be horizontal,
+	// is used for the whole paragraph.
+	Input Input
+	// Faces, if not nil, selects the face used for each rune (see [SplitByFace]).
+	// Otherwise, Input.Face is used.
+	Faces Fontmap
+	// Align is the alignment of the lines.
+	Align Alignment
+	// SpaceBefore and SpaceAfter are added above and below the paragraph.
+	SpaceBefore, SpaceAfter fixed.Int26_6
+	// LineSpacing is added between the lines of the paragraph,
+	// in addition to the line gap of the font.
+	LineSpacing fixed.Int26_6
+}
+
+// Document is a sequence of paragraphs, laid out by [DocumentLayouter].
+type Document struct {
+	Paragraphs []Paragraph
+	// Wrap are the line wrapping settings, applied to each paragraph.
+	Wrap WrapConfig
+	// MaxWidth is the width available for the lines.
+	MaxWidth int
+}
+
+// PositionedLine is a line of a laid out [Document].
+type PositionedLine struct {
+	// Line contains the runs of the line, in visual order (from left to right).
+	// The Runes fields of the runs are relative to the text of the paragraph.
+	Line Line
+	// Paragraph is the index of the paragraph of the line.
+	Paragraph int
+	// Origin is the position of the start of the baseline of the first run,
+	// relative to the top left corner of the document, with the Y axis pointing down.
+	Origin fixed.Point26_6
+	// Width is the advance of the line, including the justification.
+	Width fixed.Int26_6
+	// Bounds are the union of the line bounds of the runs.
+	Bounds Bounds
+}
+
+// DocumentLayout is the result of [DocumentLayouter.Layout].
+type DocumentLayout struct {
+	Lines []PositionedLine
+	// Height is the total height of the document, including the
+	// paragraph spacing.
+	Height fixed.Int26_6
+}
+
+// DocumentLayouter shapes, wraps and positions the lines of documents.
+//
+// Reusing a DocumentLayouter for multiple documents should improve performance.
+type DocumentLayouter struct {
+	// Shaper is used to shape the paragraphs. If nil, a [HarfbuzzShaper] is used.
+	Shaper Shaper
+
+	wrapper LineWrapper
+}
+
+// Layout lays out the paragraphs of [doc], one after the other.
+func (dl *DocumentLayouter) Layout(doc Document) DocumentLayout {
+	if dl.Shaper == nil {
+		dl.Shaper = &HarfbuzzShaper{}
+	}
+	var (
+		out  DocumentLayout
+		penY fixed.Int26_6
+	)
+	for index, paragraph := range doc.Paragraphs {
+		input := paragraph.Input
+		input.Text = paragraph.Text
+		input.RunStart, input.RunEnd = 0, len(paragraph.Text)
+		runs := appendShapedItems(nil, dl.Shaper, input, paragraph.Faces)
+
+		penY += paragraph.SpaceBefore
+		lines, _ := dl.wrapper.WrapParagraph(doc.Wrap, doc.MaxWidth, paragraph.Text, runs...)
+		for i, line := range lines {
+			if i != 0 {
+				penY += paragraph.LineSpacing
+			}
+			pl := positionLine(line, paragraph, doc.MaxWidth, i == len(lines)-1, doc.Wrap.Justification.Enabled)
+			pl.Paragraph = index
+			penY += pl.Bounds.Ascent
+			pl.Origin.Y = penY
+			penY += -pl.Bounds.Descent + pl.Bounds.Gap
+			out.Lines = append(out.Lines, pl)
+		}
+		penY += paragraph.SpaceAfter
+	}
+	out.Height = penY
+	return out
+}
+
+// positionLine computes the horizontal position and the bounds of [line],
+// which is justified if required and if it has not been [justified] by the line wrapper.
+func positionLine(line Line, paragraph Paragraph, maxWidth int, isLast, justified bool) PositionedLine {
+	out := PositionedLine{Line: make(Line, len(line))}
+	copy(out.Line, line)
+	if paragraph.Input.Direction.Progression() == di.TowardTopLeft {
+		// visual order is the reverse of the logical order
+		for i, j := 0, len(out.Line)-1; i < j; i, j = i+1, j-1 {
+			out.Line[i], out.Line[j] = out.Line[j], out.Line[i]
+		}
+	}
+
+	for i, run := range out.Line {
+		out.Width += run.Advance
+		if i == 0 {
+			out.Bounds = run.LineBounds
+			continue
+		}
+		out.Bounds.Ascent = max26_6(out.Bounds.Ascent, run.LineBounds.Ascent)
+		out.Bounds.Descent = -max26_6(-out.Bounds.Descent, -run.LineBounds.Descent)
+		out.Bounds.Gap = max26_6(out.Bounds.Gap, run.LineBounds.Gap)
+	}
+
+	// trailing spaces are ignored when aligning
+	var trailing fixed.Int26_6
+	if len(line) != 0 {
+		trailing = trailingSpaceAdvance(line[len(line)-1], paragraph.Text)
+	}
+	available := fixed.I(maxWidth) - (out.Width - trailing)
+	align := paragraph.Align
+	if align == AlignJustify && (isLast || justified || available <= 0) {
+		// align on the start edge
+		align = AlignLeft
+		if paragraph.Input.Direction.Progression() == di.TowardTopLeft {
+			align = AlignRight
+		}
+	}
+	switch align {
+	case AlignRight:
+		out.Origin.X = available
+	case AlignCenter:
+		out.Origin.X = available / 2
+	case AlignJustify:
+		out.Width += justifyLine(out.Line, paragraph.Text, available, Justification{})
+	}
+	if paragraph.Input.Direction.Progression() == di.TowardTopLeft {
+		// trailing spaces are visually on the left
+		out.Origin.X -= trailing
+	}
+	return out
+}
+
+func max26_6(a, b fixed.Int26_6) fixed.Int26_6 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// isBreakingSpace returns true for the white spaces allowing a line break after them,
+// including tabulations and line feeds. No-break spaces (like U+00A0) are not included.
+func isBreakingSpace(r rune) bool {
+	return unicodedata.IsBreakingSpace(r) || unicodedata.IsSegmentSeparator(r) || unicodedata.IsParagraphSeparator(r)
+}
+
+// isClusterSpace returns true if the glyph [g] is the glyph
+// of a breaking white space, which may be stretched by justification.
+func isClusterSpace(g Glyph, text []rune) bool {
+	return g.ClusterIndex < len(text) && g.RuneCount == 1 && isBreakingSpace(text[g.ClusterIndex])
+}
+
+// trailingSpaceAdvance returns the advance of the spaces at the (logical) end of [run].
+func trailingSpaceAdvance(run Output, text []rune) fixed.Int26_6 {
+	var advance fixed.Int26_6
+	for it := run.GlyphsLogical(); it.Next(); {
+		if isClusterSpace(it.Glyph(), text) {
+			advance += it.Glyph().XAdvance
+		} else {
+			advance = 0
+		}
+	}
+	return advance
+}
+
+// justifyLine distributes [extra] among the inner spaces of [line], according to [opts]
+// (whose Enabled field is ignored), and returns the added advance.
+// The runs of [line] are copied as needed.
+func justifyLine(line Line, text []rune, extra fixed.Int26_6, opts Justification) fixed.Int26_6 {
+	// the spaces after the last non space glyph are trailing spaces
+	lastRune := -1
+	for _, run := range line {
+		for _, g := range run.Glyphs {
+			if !isClusterSpace(g, text) && g.ClusterIndex > lastRune {
+				lastRune = g.ClusterIndex
+			}
+		}
+	}
+	isInnerSpace := func(g Glyph) bool { return isClusterSpace(g, text) && g.ClusterIndex < lastRune }
+	var (
+		spaces        int
+		spacesAdvance fixed.Int26_6
+	)
+	for _, run := range line {
+		for _, g := range run.Glyphs {
+			if isInnerSpace(g) {
+				spaces++
+				spacesAdvance += g.XAdvance
+			}
+		}
+	}
+
+	toSpaces := extra
+	if extra > 0 && opts.MaxSpaceRatio > 0 {
+		toSpaces = min26_6(extra, scaleAdvance(spacesAdvance, opts.MaxSpaceRatio-1))
+	} else if extra < 0 {
+		toSpaces = 0
+		if opts.MinSpaceRatio > 0 {
+			toSpaces = max26_6(extra, -scaleAdvance(spacesAdvance, 1-opts.MinSpaceRatio))
+		}
+	}
+	added := distributeAdvance(line, toSpaces, spaces, func(run *Output, j int) bool {
+		return isInnerSpace(run.Glyphs[j])
+	})
+
+	if rest := extra - added; opts.InterLetter && rest > 0 {
+		// add the remaining space after each cluster, except the last one
+		isClusterEnd := func(run *Output, j int) bool {
+			g := run.Glyphs[j]
+			return g.ClusterIndex < lastRune && (j+1 == len(run.Glyphs) || run.Glyphs[j+1].ClusterIndex != g.ClusterIndex)
+		}
+		clusters := 0
+		for i := range line {
+			for j := range line[i].Glyphs {
+				if isClusterEnd(&line[i], j) {
+					clusters++
+				}
+			}
+		}
+		added += distributeAdvance(line, rest, clusters, isClusterEnd)
+	}
+	return added
+}
+
+// distributeAdvance adds [amount] to the advances of the [count] glyphs of [line]
+// for which [selected] is true, copying the runs as needed, and returns the added advance.
+func distributeAdvance(line Line, amount fixed.Int26_6, count int, selected func(run *Output, j int) bool) fixed.Int26_6 {
+	if count == 0 || amount == 0 {
+		return 0
+	}
+	per, remainder := amount/fixed.Int26_6(count), amount%fixed.Int26_6(count)
+	var added fixed.Int26_6
+	for i := range line {
+		run := &line[i]
+		copied := false
+		for j := range run.Glyphs {
+			if !selected(run, j) {
+				continue
+			}
+			if !copied { // do not modify the runs returned by the shaper
+				run.Glyphs = append([]Glyph(nil), run.Glyphs...)
+				copied = true
+			}
+			delta := per
+			if remainder > 0 {
+				delta++
+				remainder--
+			} else if remainder < 0 {
+				delta--
+				remainder++
+			}
+			run.Glyphs[j].XAdvance += delta
+			run.Advance += delta
+			added += delta
+		}
+	}
+	return added
+}
+
+// scaleAdvance returns [v] * [ratio], or 0 for negative ratios.
+func scaleAdvance(v fixed.Int26_6, ratio float32) fixed.Int26_6 {
+	if ratio <= 0 {
+		return 0
+	}
+	return fixed.Int26_6(float32(v) * ratio)
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestDocumentLayout(t *testing.T) {
+	input := Input{
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	text := []rune("The quick brown fox jumps over the lazy dog, again and again.")
+	const maxWidth = 150
+	doc := Document{MaxWidth: maxWidth}
+	for _, align := range []Alignment{AlignLeft, AlignRight, AlignCenter, AlignJustify} {
+		doc.Paragraphs = append(doc.Paragraphs, Paragraph{
+			Text: text, Input: input, Align: align,
+			SpaceAfter: fixed.I(10), LineSpacing: fixed.I(2),
+		})
+	}
+
+	var layouter DocumentLayouter
+	layout := layouter.Layout(doc)
+	linesPerParagraph := len(layout.Lines) / 4
+	if linesPerParagraph < 3 || len(layout.Lines) != 4*linesPerParagraph {
+		t.Fatalf("unexpected lines count %d", len(layout.Lines))
+	}
+
+	var previousY fixed.Int26_6
+	for i, line := range layout.Lines {
+		if line.Paragraph != i/linesPerParagraph {
+			t.Errorf("line %d: unexpected paragraph %d", i, line.Paragraph)
+		}
+		if line.Origin.Y <= previousY {
+			t.Errorf("line %d: unexpected Y %s", i, line.Origin.Y)
+		}
+		previousY = line.Origin.Y
+
+		var width fixed.Int26_6
+		for _, run := range line.Line {
+			width += run.Advance
+		}
+		if width != line.Width {
+			t.Errorf("line %d: unexpected width %s", i, line.Width)
+		}
+
+		left := line.Origin.X
+		right := line.Origin.X + line.Width - trailingSpaceAdvance(line.Line[len(line.Line)-1], text)
+		isLast := i%linesPerParagraph == linesPerParagraph-1
+		switch doc.Paragraphs[line.Paragraph].Align {
+		case AlignLeft:
+			if left != 0 {
+				t.Errorf("line %d: unexpected origin %s", i, left)
+			}
+		case AlignRight:
+			if right != fixed.I(maxWidth) {
+				t.Errorf("line %d: unexpected right edge %s", i, right)
+			}
+		case AlignCenter:
+			if d := (fixed.I(maxWidth) - right) - left; d < -1 || d > 1 {
+				t.Errorf("line %d: line is not centered (%s, %s)", i, left, right)
+			}
+		case AlignJustify:
+			if left != 0 || (!isLast && right != fixed.I(maxWidth)) {
+				t.Errorf("line %d: line is not justified (%s, %s)", i, left, right)
+			}
+		}
+	}
+
+	// the layout of a paragraph does not depend on the others
+	lastLine := layout.Lines[linesPerParagraph-1]
+	firstLine := layout.Lines[linesPerParagraph]
+	expectedY := lastLine.Origin.Y - lastLine.Bounds.Descent + lastLine.Bounds.Gap + fixed.I(10) + firstLine.Bounds.Ascent
+	if firstLine.Origin.Y != expectedY {
+		t.Errorf("expected Y %s, got %s", expectedY, firstLine.Origin.Y)
+	}
+	if last := layout.Lines[len(layout.Lines)-1]; layout.Height != last.Origin.Y-last.Bounds.Descent+last.Bounds.Gap+fixed.I(10) {
+		t.Errorf("unexpected height %s", layout.Height)
+	}
+
+	// justified runs are consistent
+	for _, line := range layout.Lines[3*linesPerParagraph:] {
+		for _, run := range line.Line {
+			advance := run.Advance
+			run.RecomputeAdvance()
+			if run.Advance != advance {
+				t.Errorf("inconsistent advance %s %s", advance, run.Advance)
+			}
+		}
+	}
+}
+
+func TestJustifyNoBreakSpace(t *testing.T) {
+	text := []rune("one two\u00A0three four\u00A0")
+	run := (&HarfbuzzShaper{}).Shape(Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	})
+	advances := make(map[int]fixed.Int26_6)
+	for _, g := range run.Glyphs {
+		advances[g.ClusterIndex] = g.XAdvance
+	}
+
+	// no-break spaces are not trailing spaces
+	if trailing := trailingSpaceAdvance(run, text); trailing != 0 {
+		t.Errorf("unexpected trailing advance %s", trailing)
+	}
+
+	line := Line{run}
+	if added := justifyLine(line, text, fixed.I(20), Justification{}); added != fixed.I(20) {
+		t.Fatalf("unexpected added advance %s", added)
+	}
+	for _, g := range line[0].Glyphs {
+		stretched := g.XAdvance != advances[g.ClusterIndex]
+		if isSpace := text[g.ClusterIndex] == ' '; stretched != isSpace {
+			t.Errorf("rune %d (%q): unexpected stretch %v", g.ClusterIndex, text[g.ClusterIndex], stretched)
+		}
+	}
+}
+
+func TestDocumentLayoutWrapJustification(t *testing.T) {
+	input := Input{
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	text := []rune("The quick brown fox jumps over the lazy dog, again and again.")
+	const maxWidth = 150
+	// the spaces may not absorb all the extra width
+	wrap := WrapConfig{Justification: Justification{Enabled: true, MaxSpaceRatio: 1.2}}
+	doc := Document{
+		MaxWidth:   maxWidth,
+		Wrap:       wrap,
+		Paragraphs: []Paragraph{{Text: text, Input: input, Align: AlignJustify}},
+	}
+
+	var layouter DocumentLayouter
+	layout := layouter.Layout(doc)
+
+	input.Text, input.RunStart, input.RunEnd = text, 0, len(text)
+	var wrapper LineWrapper
+	lines, _ := wrapper.WrapParagraph(wrap, maxWidth, text, layouter.Shaper.Shape(input))
+	if len(layout.Lines) != len(lines) || len(lines) < 3 {
+		t.Fatalf("unexpected lines count %d", len(layout.Lines))
+	}
+	var partial bool
+	for i, line := range layout.Lines {
+		// the lines are not justified again
+		var width fixed.Int26_6
+		for _, run := range lines[i] {
+			width += run.Advance
+		}
+		if line.Width != width || line.Origin.X != 0 {
+			t.Errorf("line %d: expected width %s at 0, got %s at %s", i, width, line.Width, line.Origin.X)
+		}
+		right := line.Width - trailingSpaceAdvance(line.Line[len(line.Line)-1], text)
+		partial = partial || (i != len(lines)-1 && right < fixed.I(maxWidth))
+	}
+	if !partial {
+		t.Error("expected a line not filling the width")
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"unicode/utf8"
+
+	"github.com/go-text/typesetting/harfbuzz"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/loader"
+)
+
+var (
+	tagLocl = loader.MustNewTag("locl")
+	tagLiga = loader.MustNewTag("liga")
+)
+
+// disableLocl selects the default language system of the font
+var disableLocl = harfbuzz.Feature{
+	Tag: tagLocl, Value: 0,
+	Start: harfbuzz.FeatureGlobalStart, End: harfbuzz.FeatureGlobalEnd,
+}
+
+// hasDottedI returns true for the languages distinguishing
+// the dotted and dotless i (like Turkish), for which the "fi"
+// ligature should be avoided.
+func hasDottedI(lang language.Language) bool {
+	return lang.IsDerivedFrom("tr") || lang.IsDerivedFrom("az") ||
+		lang.IsDerivedFrom("crh") || lang.IsDerivedFrom("tt")
+}
+
+// disableLiga is used for the runs where the "fi" ligature would hide
+// the dot of the i. Ranged features would compile a new shaping plan for each
+// combination of positions, so that the ligatures of the whole run are disabled.
+var disableLiga = harfbuzz.Feature{
+	Tag: tagLiga, Value: 0,
+	Start: harfbuzz.FeatureGlobalStart, End: harfbuzz.FeatureGlobalEnd,
+}
+
+// isDottedIAfterF returns true for the runes whose dot
+// (or absence of dot) would be hidden by the "fi" ligature
+func isDottedIAfterF(r rune) bool { return r == 'i' || r == 'ı' }
+
+// appendLocaleFeatures appends to [features] the features
+// implied by the language of [input], or disabling them if
+// [Input.DisableLocaleFeatures] is true.
+//
+// The returned features only depend on the language and on the presence of
+// a "fi" sequence in the run, so that few shaping plans are compiled.
+func appendLocaleFeatures(features []harfbuzz.Feature, input Input) []harfbuzz.Feature {
+	if input.DisableLocaleFeatures {
+		return append(features, disableLocl)
+	}
+	if !hasDottedI(input.Language) {
+		return features
+	}
+	text := input.Text
+	for i := input.RunStart; i+1 < input.RunEnd && i+1 < len(text); i++ {
+		if text[i] == 'f' && isDottedIAfterF(text[i+1]) {
+			return append(features, disableLiga)
+		}
+	}
+	return features
+}
+
+// appendLocaleFeaturesString is the same as [appendLocaleFeatures],
+// for the UTF-8 [text] used by [HarfbuzzShaper.ShapeString].
+func appendLocaleFeaturesString(features []harfbuzz.Feature, input Input, text string) []harfbuzz.Feature {
+	if input.DisableLocaleFeatures {
+		return append(features, disableLocl)
+	}
+	if !hasDottedI(input.Language) {
+		return features
+	}
+	end := input.RunEnd
+	if end > len(text) {
+		end = len(text)
+	}
+	// since 'f' is ASCII, it is never part of a multi-byte sequence
+	for i := input.RunStart; i+1 < end; i++ {
+		if text[i] != 'f' {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(text[i+1 : end]); isDottedIAfterF(next) {
+			return append(features, disableLiga)
+		}
+	}
+	return features
+}
+
+// appendLocaleFeaturesBytes is the same as [appendLocaleFeaturesString],
+// for the UTF-8 [text] used by [HarfbuzzShaper.ShapeBytes].
+func appendLocaleFeaturesBytes(features []harfbuzz.Feature, input Input, text []byte) []harfbuzz.Feature {
+	if input.DisableLocaleFeatures {
+		return append(features, disableLocl)
+	}
+	if !hasDottedI(input.Language) {
+		return features
+	}
+	end := input.RunEnd
+	if end > len(text) {
+		end = len(text)
+	}
+	// since 'f' is ASCII, it is never part of a multi-byte sequence
+	for i := input.RunStart; i+1 < end; i++ {
+		if text[i] != 'f' {
+			continue
+		}
+		if next, _ := utf8.DecodeRune(text[i+1 : end]); isDottedIAfterF(next) {
+			return append(features, disableLiga)
+		}
+	}
+	return features
+}
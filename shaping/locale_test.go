@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/harfbuzz"
+	"github.com/go-text/typesetting/language"
+)
+
+func TestAppendLocaleFeatures(t *testing.T) {
+	text := []rune("fi fıf fl ffi")
+	input := Input{Text: text, RunStart: 0, RunEnd: len(text)}
+
+	input.Language = language.NewLanguage("en")
+	if got := appendLocaleFeatures(nil, input); len(got) != 0 {
+		t.Errorf("unexpected features %v", got)
+	}
+
+	// the ligatures are disabled for the whole run,
+	// so that the features do not depend on the positions
+	input.Language = language.NewLanguage("tr-TR")
+	expected := []harfbuzz.Feature{disableLiga}
+	if got := appendLocaleFeatures(nil, input); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	input.RunStart = 3
+	if got := appendLocaleFeatures(nil, input); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	input.RunStart = 0
+
+	str := string(text)
+	stringInput := input
+	stringInput.Text, stringInput.RunEnd = nil, len(str)
+	if got := appendLocaleFeaturesString(nil, stringInput, str); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	// only the run is considered
+	input.RunStart, input.RunEnd = 1, 4
+	if got := appendLocaleFeatures(nil, input); len(got) != 0 {
+		t.Errorf("unexpected features %v", got)
+	}
+	stringInput.RunStart, stringInput.RunEnd = 1, 4
+	if got := appendLocaleFeaturesString(nil, stringInput, str); len(got) != 0 {
+		t.Errorf("unexpected features %v", got)
+	}
+
+	input.DisableLocaleFeatures = true
+	expected = []harfbuzz.Feature{
+		{Tag: tagLocl, Value: 0, Start: harfbuzz.FeatureGlobalStart, End: harfbuzz.FeatureGlobalEnd},
+	}
+	if got := appendLocaleFeatures(nil, input); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestShapeLocale(t *testing.T) {
+	text := []rune("fi fıf")
+	input := Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      16 * 72,
+		Script:    language.Latin,
+		Language:  language.NewLanguage("tr"),
+	}
+	var shaper HarfbuzzShaper
+	withLocale := shaper.Shape(input)
+	input.DisableLocaleFeatures = true
+	withoutLocale := shaper.Shape(input)
+	// the font has no localized forms
+	if len(withLocale.Glyphs) != len(text) || len(withoutLocale.Glyphs) != len(text) {
+		t.Errorf("unexpected glyphs %v %v", withLocale.Glyphs, withoutLocale.Glyphs)
+	}
+	if withLocale.Advance != withoutLocale.Advance {
+		t.Errorf("unexpected advances %d %d", withLocale.Advance, withoutLocale.Advance)
+	}
+
+	// the "fi" positions do not change the shaping plan
+	input.DisableLocaleFeatures = false
+	before := shaper.PlanCacheStats()
+	for _, s := range []string{"afi", "aafi", "fi fi fi", "fıfı"} {
+		input.Text = []rune(s)
+		input.RunEnd = len(input.Text)
+		shaper.Shape(input)
+	}
+	if after := shaper.PlanCacheStats(); after.Misses != before.Misses {
+		t.Errorf("unexpected plan compilations %d", after.Misses-before.Misses)
+	}
+}
@@ -1,6 +1,8 @@
 package shaping
 
 import (
+	"sync"
+
 	"github.com/go-text/typesetting/harfbuzz"
 	"github.com/go-text/typesetting/opentype/api/font"
 )
@@ -10,6 +12,14 @@ type fontEntry struct {
 	next, prev *fontEntry
 	key        *font.Font
 	v          *harfbuzz.Font
+	size       int    // estimated size, in bytes
+	used       uint64 // time of use, if the cache has a budget
+}
+
+// estimatedFontSize returns a rough estimate of the memory used by
+// the harfbuzz font built from [ft], which stores an accelerator per lookup.
+func estimatedFontSize(ft *font.Font) int {
+	return 1<<10 + 256*(len(ft.GSUB.Lookups)+len(ft.GPOS.Lookups))
 }
 
 // fontLRU is a least-recently-used cache for harfbuzz fonts built from
@@ -19,16 +29,46 @@ type fontLRU struct {
 	// This implementation is derived from the one here under the terms of the UNLICENSE:
 	//
 	// https://git.sr.ht/~eliasnaur/gio/tree/e768fe347a732056031100f2c66987d6db258ea4/item/text/lru.go
+
+	// mu is only set with a budget, which is shared with other
+	// caches and may evict entries from any goroutine.
+	// It is stored as a pointer so that copying an unused shaper
+	// does not copy a lock.
+	mu         *sync.Mutex
 	m          map[*font.Font]*fontEntry
 	head, tail *fontEntry
 	maxSize    int
+	budget     *CacheBudget // optional
+}
+
+// setBudget registers the cache with [budget].
+func (l *fontLRU) setBudget(budget *CacheBudget) {
+	l.budget = budget
+	l.mu = new(sync.Mutex)
+}
+
+func (l *fontLRU) lock() {
+	if l.mu != nil {
+		l.mu.Lock()
+	}
+}
+
+func (l *fontLRU) unlock() {
+	if l.mu != nil {
+		l.mu.Unlock()
+	}
 }
 
 // Get fetches the value associated with the given key, if any.
 func (l *fontLRU) Get(k *font.Font) (*harfbuzz.Font, bool) {
+	l.lock()
+	defer l.unlock()
 	if lt, ok := l.m[k]; ok {
 		l.remove(lt)
 		l.insert(lt)
+		if l.budget != nil {
+			lt.used = l.budget.Now()
+		}
 		return lt.v, true
 	}
 	return nil, false
@@ -36,7 +76,9 @@ func (l *fontLRU) Get(k *font.Font) (*harfbuzz.Font, bool) {
 
 // Put inserts the given value with the given key, evicting old
 // cache entries if necessary.
+// With a budget, a zero maxSize does not limit the number of entries.
 func (l *fontLRU) Put(k *font.Font, v *harfbuzz.Font) {
+	l.lock()
 	if l.m == nil {
 		l.m = make(map[*font.Font]*fontEntry)
 		l.head = new(fontEntry)
@@ -44,14 +86,49 @@ func (l *fontLRU) Put(k *font.Font, v *harfbuzz.Font) {
 		l.head.prev = l.tail
 		l.tail.next = l.head
 	}
-	val := &fontEntry{key: k, v: v}
+	val := &fontEntry{key: k, v: v, size: estimatedFontSize(k)}
+	if l.budget != nil {
+		val.used = l.budget.Now()
+	}
 	l.m[k] = val
 	l.insert(val)
-	if len(l.m) > l.maxSize {
+	evictedSize := 0
+	if len(l.m) > l.maxSize && (l.budget == nil || l.maxSize > 0) {
 		oldest := l.tail.next
 		l.remove(oldest)
 		delete(l.m, oldest.key)
+		evictedSize = oldest.size
+	}
+	l.unlock()
+
+	// the budget may evict entries from this cache : it must not be locked
+	if l.budget != nil {
+		l.budget.Remove(evictedSize)
+		l.budget.Add(val.size)
+	}
+}
+
+// OldestUse implements [BudgetedCache].
+func (l *fontLRU) OldestUse() (uint64, bool) {
+	l.lock()
+	defer l.unlock()
+	if len(l.m) == 0 {
+		return 0, false
+	}
+	return l.tail.next.used, true
+}
+
+// EvictOldest implements [BudgetedCache].
+func (l *fontLRU) EvictOldest() int {
+	l.lock()
+	defer l.unlock()
+	if len(l.m) == 0 {
+		return 0
 	}
+	oldest := l.tail.next
+	l.remove(oldest)
+	delete(l.m, oldest.key)
+	return oldest.size
 }
 
 // remove cuts e out of the lru linked list.
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"unicode"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/api/font"
+	"github.com/go-text/typesetting/opentype/tables"
+	"golang.org/x/image/math/fixed"
+)
+
+// maxCoverageCacheSize is the number of fonts whose layout
+// coverage is cached by a shaper
+const maxCoverageCacheSize = 16
+
+// glyphSet is a bitset of glyphs
+type glyphSet []uint64
+
+func (gs glyphSet) has(gid font.GID) bool {
+	index := int(gid) / 64
+	return index < len(gs) && gs[index]&(1<<(gid%64)) != 0
+}
+
+func (gs *glyphSet) add(gid tables.GlyphID) {
+	index := int(gid) / 64
+	for len(*gs) <= index {
+		*gs = append(*gs, 0)
+	}
+	(*gs)[index] |= 1 << (gid % 64)
+}
+
+func (gs *glyphSet) addCoverage(cov tables.Coverage) {
+	switch cov := cov.(type) {
+	case tables.Coverage1:
+		for _, gid := range cov.Glyphs {
+			gs.add(gid)
+		}
+	case tables.Coverage2:
+		for _, rang := range cov.Ranges {
+			for gid := int(rang.StartGlyphID); gid <= int(rang.EndGlyphID); gid++ {
+				gs.add(tables.GlyphID(gid))
+			}
+		}
+	}
+}
+
+// layoutCoverage returns the glyphs which may start a GSUB or GPOS lookup,
+// whatever the features, or nil if the font has layout tables
+// which are not supported by the measurement fast path.
+func layoutCoverage(ft *font.Font) *glyphSet {
+	if len(ft.Morx) != 0 || len(ft.Kern) != 0 || len(ft.Kerx) != 0 || !ft.Trak.IsEmpty() {
+		return nil
+	}
+	var out glyphSet
+	for _, lookup := range ft.GSUB.Lookups {
+		for _, subtable := range lookup.Subtables {
+			out.addCoverage(subtable.Cov())
+		}
+	}
+	for _, lookup := range ft.GPOS.Lookups {
+		for _, subtable := range lookup.Subtables {
+			out.addCoverage(subtable.Cov())
+		}
+	}
+	return &out
+}
+
+// isSimpleRune returns true if [r] is rendered by its nominal glyph,
+// without any shaping, provided the font has no layout lookup involving it.
+func isSimpleRune(r rune) bool {
+	if r < 0x20 || unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp) {
+		return false
+	}
+	if r < 0x80 {
+		return true
+	}
+	switch language.LookupScript(r) {
+	case language.Latin, language.Greek, language.Cyrillic, language.Common:
+		return true
+	default:
+		return false
+	}
+}
+
+// MeasureAdvance returns the advance of the text of [input], as provided in
+// the Advance field of [HarfbuzzShaper.Shape].
+//
+// When the text only uses simple characters (like Latin letters, without
+// combining marks) for which the font defines no substitution nor positioning,
+// the advance is computed from the nominal glyphs, which is much faster than
+// shaping the text (and even faster with [HarfbuzzShaper.SetGlyphMetricsCache]).
+// Otherwise, the text is shaped.
+func (t *HarfbuzzShaper) MeasureAdvance(input Input) fixed.Int26_6 {
+	if advance, ok := t.measureFast(input); ok {
+		return advance
+	}
+	return t.Shape(input).Advance
+}
+
+// measureFast returns false if the text of [input] requires shaping.
+func (t *HarfbuzzShaper) measureFast(input Input) (fixed.Int26_6, bool) {
+	if input.Direction != di.DirectionLTR || input.Face == nil {
+		return 0, false
+	}
+	start, end := input.RunStart, input.RunEnd
+	if start < 0 || end > len(input.Text) || start > end {
+		return 0, false
+	}
+	scale := shapingScale(input.Face, input.Size)
+	if scale.Upem == 0 {
+		return 0, false
+	}
+	coverage := t.layoutCoverage(input.Face.Font)
+	if coverage == nil {
+		return 0, false
+	}
+
+	var advance fixed.Int26_6
+	for _, r := range input.Text[start:end] {
+		if !isSimpleRune(r) {
+			return 0, false
+		}
+		gid, ok := input.Face.NominalGlyph(r)
+		if !ok || coverage.has(gid) {
+			return 0, false
+		}
+		if t.metrics != nil {
+			advance += t.metrics.Advance(input.Face, gid, input.Size)
+		} else {
+			advance += scale.Fixed(input.Face.HorizontalAdvance(gid))
+		}
+	}
+	return advance, true
+}
+
+// layoutCoverage returns the (cached) layout coverage of [ft]
+func (t *HarfbuzzShaper) layoutCoverage(ft *font.Font) *glyphSet {
+	if cov, ok := t.coverages[ft]; ok {
+		return cov
+	}
+	if t.coverages == nil || len(t.coverages) >= maxCoverageCacheSize {
+		t.coverages = make(map[*font.Font]*glyphSet)
+	}
+	cov := layoutCoverage(ft)
+	t.coverages[ft] = cov
+	return cov
+}
+
+// TextMetrics are the dimensions of a line of text, as returned by [MeasureString].
+type TextMetrics struct {
+	// Advance is the width of the text.
+	Advance fixed.Int26_6
+	// Ascent and Descent are the line bounds suggested by the face,
+	// as in [Bounds] : Descent is typically negative.
+	Ascent, Descent fixed.Int26_6
+	// Ink is the union of the bounding boxes of the glyphs, relative to the
+	// start of the baseline, with the Y axis pointing down (as for
+	// golang.org/x/image/font.BoundString). It is empty if no glyph has ink.
+	Ink fixed.Rectangle26_6
+}
+
+// MeasureString shapes [text] with [face] at [size], and returns its dimensions,
+// which is handy to compute the size of a label.
+//
+// The text is split by script, each part being shaped in the natural direction
+// of its script, with the default features of the font for [lang]. Bidirectional
+// reordering is not applied : the parts are placed in logical order, which only
+// matters for the ink bounds of mixed direction text.
+//
+// MeasureString uses a new shaper for each call : see [HarfbuzzShaper.MeasureString]
+// to benefit from the shaper caches.
+func MeasureString(face *font.Face, size fixed.Int26_6, lang language.Language, text string) TextMetrics {
+	var shaper HarfbuzzShaper
+	return shaper.MeasureString(face, size, lang, text)
+}
+
+// MeasureString is the same as the [MeasureString] function, using [t].
+func (t *HarfbuzzShaper) MeasureString(face *font.Face, size fixed.Int26_6, lang language.Language, text string) TextMetrics {
+	runes := []rune(text)
+	input := Input{
+		Text:     runes,
+		RunStart: 0,
+		RunEnd:   len(runes),
+		Face:     face,
+		Size:     size,
+		Script:   language.Common,
+		Language: lang,
+	}
+	var out TextMetrics
+	for i, item := range SplitByScript(input) {
+		item.Direction = ResolveScriptLayout(item.Script, di.Horizontal).Direction
+		run := t.Shape(item)
+		if i == 0 {
+			out.Ascent, out.Descent = run.LineBounds.Ascent, run.LineBounds.Descent
+		} else {
+			out.Ascent = max26_6(out.Ascent, run.LineBounds.Ascent)
+			out.Descent = -max26_6(-out.Descent, -run.LineBounds.Descent)
+		}
+		run.appendInk(&out.Ink, out.Advance)
+		out.Advance += run.Advance
+	}
+	return out
+}
+
+func min26_6(a, b fixed.Int26_6) fixed.Int26_6 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestMeasureAdvance(t *testing.T) {
+	monoFace, err := font.ParseTTF(bytes.NewReader(gomono.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		text   string
+		dir    di.Direction
+		face   font.Face
+		script language.Script
+		fast   bool
+	}{
+		{"Lorem ipsum, dolor.", di.DirectionLTR, monoFace, language.Latin, true},
+		{"Ελληνικά и кириллица", di.DirectionLTR, monoFace, language.Latin, true},
+		{"Lorem ipsum, dolor.", di.DirectionLTR, benchEnFace, language.Latin, true},
+		{"e\u0301", di.DirectionLTR, benchEnFace, language.Latin, false},         // combining mark
+		{"zero\u200bwidth", di.DirectionLTR, benchEnFace, language.Latin, false}, // format character
+		{"تسجّل يتكلّم", di.DirectionRTL, benchArFace, language.Arabic, false},   // complex script
+		{"Lorem ipsum", di.DirectionLTR, benchArFace, language.Latin, false},     // GPOS kerning
+		{"\U0010FFFF", di.DirectionLTR, benchEnFace, language.Latin, false},      // missing glyph
+	} {
+		text := []rune(test.text)
+		for _, size := range []fixed.Int26_6{fixed.I(12), fixed.I(17) + 13, 16 * 72} {
+			input := Input{
+				Text:      text,
+				RunStart:  0,
+				RunEnd:    len(text),
+				Direction: test.dir,
+				Face:      test.face,
+				Size:      size,
+				Script:    test.script,
+				Language:  language.NewLanguage("en"),
+			}
+			var shaper HarfbuzzShaper
+			exp := shaper.Shape(input).Advance
+			if got := shaper.MeasureAdvance(input); got != exp {
+				t.Errorf("%q at %s: expected advance %s, got %s", test.text, size, exp, got)
+			}
+			if _, fast := shaper.measureFast(input); fast != test.fast {
+				t.Errorf("%q: expected fast path %v, got %v", test.text, test.fast, fast)
+			}
+		}
+	}
+}
+
+func TestMeasureString(t *testing.T) {
+	size := fixed.I(16)
+	text := []rune("Hello, go")
+	run := (&HarfbuzzShaper{}).Shape(Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      size,
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	})
+	m := MeasureString(benchEnFace, size, language.NewLanguage("en"), string(text))
+	if m.Advance != run.Advance || m.Ascent != run.LineBounds.Ascent || m.Descent != run.LineBounds.Descent {
+		t.Fatalf("unexpected metrics %v (expected %v)", m, run)
+	}
+	// the ink is above the baseline, except for the descenders of 'g' and ','
+	if m.Ink.Min.X < 0 || m.Ink.Max.X > m.Advance || m.Ink.Min.Y >= 0 || m.Ink.Max.Y <= 0 {
+		t.Fatalf("unexpected ink bounds %v", m.Ink)
+	}
+	if m.Ink.Min.Y != -run.GlyphBounds.Ascent || m.Ink.Max.Y != -run.GlyphBounds.Descent {
+		t.Fatalf("unexpected ink bounds %v (expected %v)", m.Ink, run.GlyphBounds)
+	}
+
+	if m := MeasureString(benchEnFace, size, language.NewLanguage("en"), " "); !m.Ink.Empty() || m.Advance <= 0 {
+		t.Fatalf("unexpected metrics for a space %v", m)
+	}
+
+	// the Arabic text is shaped right to left, and its advance is added to the Latin one
+	var shaper HarfbuzzShaper
+	arabic := []rune("تسجّل")
+	arRun := shaper.Shape(Input{
+		Text:      arabic,
+		RunStart:  0,
+		RunEnd:    len(arabic),
+		Direction: di.DirectionRTL,
+		Face:      benchArFace,
+		Size:      size,
+		Script:    language.Arabic,
+		Language:  language.NewLanguage("ar"),
+	})
+	m = shaper.MeasureString(benchArFace, size, language.NewLanguage("ar"), string(arabic))
+	if m.Advance != arRun.Advance {
+		t.Fatalf("expected advance %v, got %v", arRun.Advance, m.Advance)
+	}
+	mixed := shaper.MeasureString(benchArFace, size, language.NewLanguage("ar"), "ab "+string(arabic))
+	latin := shaper.MeasureString(benchArFace, size, language.NewLanguage("ar"), "ab ")
+	if mixed.Advance != latin.Advance+m.Advance {
+		t.Fatalf("unexpected advance %v", mixed.Advance)
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/math/fixed"
+)
+
+// FontMetrics describes typographic metrics of a font face, scaled
+// to a font size, which may be used to align text (or icons) vertically.
+// Positions are distances above the baseline, in coordinate systems that grow up.
+type FontMetrics struct {
+	// CapHeight is the height of flat capital letters, like 'H'.
+	CapHeight fixed.Int26_6
+	// XHeight is the height of flat lowercase letters, like 'x'.
+	XHeight fixed.Int26_6
+
+	// SuperscriptSize is the suggested font size for superscripts,
+	// and SuperscriptOffset the position of their baseline.
+	SuperscriptSize, SuperscriptOffset fixed.Int26_6
+	// SubscriptSize is the suggested font size for subscripts,
+	// and SubscriptOffset the position of their baseline (typically negative).
+	SubscriptSize, SubscriptOffset fixed.Int26_6
+
+	// EmBox is the em square, whose height is the font size, placed
+	// in the same proportions as the font ascender and descender.
+	// Its Gap is always zero.
+	EmBox Bounds
+}
+
+// FontMetrics returns the metrics of the face of [o], at the size of [o].
+// As for the shaped glyphs, values in font units are scaled by Size.Ceil() / upem.
+// The cap height and x-height are read from the 'OS/2' table, or measured
+// on the glyphs for 'H' and 'x' if the table does not provide them.
+func (o *Output) FontMetrics() FontMetrics {
+	if o.Face == nil {
+		return FontMetrics{}
+	}
+	scale := shapingScale(o.Face, o.Size)
+	if scale.Upem == 0 {
+		return FontMetrics{}
+	}
+	metric := func(metric api.LineMetric) fixed.Int26_6 {
+		return scale.Fixed(o.Face.LineMetric(metric))
+	}
+
+	out := FontMetrics{
+		CapHeight:         metric(api.CapHeight),
+		XHeight:           metric(api.XHeight),
+		SuperscriptSize:   metric(api.SuperscriptEmYSize),
+		SuperscriptOffset: metric(api.SuperscriptEmYOffset),
+		SubscriptSize:     metric(api.SubscriptEmYSize),
+		// the 'OS/2' table uses positive values below the baseline
+		SubscriptOffset: -metric(api.SubscriptEmYOffset),
+	}
+
+	// place the em box proportionally to the font extents
+	em := fixed.I(o.Size.Ceil())
+	if extents, ok := o.Face.FontHExtents(); ok && extents.Ascender-extents.Descender > 0 {
+		out.EmBox.Ascent = fixed.Int26_6(float32(em) * extents.Ascender / (extents.Ascender - extents.Descender))
+	} else {
+		out.EmBox.Ascent = em
+	}
+	out.EmBox.Descent = out.EmBox.Ascent - em
+	return out
+}
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/opentype/api"
+	fontapi "github.com/go-text/typesetting/opentype/api/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// GlyphMetrics are the metrics of a glyph, scaled
+// to a font size as in the output of [HarfbuzzShaper.Shape].
+type GlyphMetrics struct {
+	Advance fixed.Int26_6
+	// The extents are zero if HasExtents is false.
+	XBearing, YBearing, Width, Height fixed.Int26_6
+	HasExtents                        bool
+}
+
+// glyphMetricsKey identifies a glyph at a given size
+type glyphMetricsKey struct {
+	font         *fontapi.Font
+	coords       string // binary encoding of the variation coordinates
+	xPpem, yPpem uint16
+	size         int // Size.Ceil()
+	gid          font.GID
+}
+
+// glyphMetricsEntrySize is the estimated size of a cache entry, in bytes
+const glyphMetricsEntrySize = 100
+
+// glyphMetricsEntry holds a single key-value pair for an LRU cache.
+type glyphMetricsEntry struct {
+	next, prev *glyphMetricsEntry
+	key        glyphMetricsKey
+	v          GlyphMetrics
+	used       uint64 // time of use, if the cache has a budget
+}
+
+// GlyphMetricsCache is a cache of scaled glyph advances and extents,
+// safe for concurrent use, which may be shared by the shapers
+// (see [HarfbuzzShaper.SetGlyphMetricsCache]) and renderers.
+//
+// The cache holds at most a fixed number of entries, evicting the least
+// recently used ones. An entry uses about 100 bytes.
+// Its memory may also be controlled by a [CacheBudget] shared with other caches.
+type GlyphMetricsCache struct {
+	mu         sync.Mutex
+	m          map[glyphMetricsKey]*glyphMetricsEntry
+	head, tail *glyphMetricsEntry
+	maxSize    int
+	budget     *CacheBudget // optional
+}
+
+// NewGlyphMetricsCache returns a cache holding at most [maxEntries] glyph metrics.
+// A zero [maxEntries] does not limit the number of entries, which is only
+// sensible if the cache is controlled by a [CacheBudget].
+func NewGlyphMetricsCache(maxEntries int) *GlyphMetricsCache {
+	c := &GlyphMetricsCache{
+		m:       make(map[glyphMetricsKey]*glyphMetricsEntry),
+		head:    new(glyphMetricsEntry),
+		tail:    new(glyphMetricsEntry),
+		maxSize: maxEntries,
+	}
+	c.head.prev = c.tail
+	c.tail.next = c.head
+	return c
+}
+
+// SetCacheBudget registers the cache with [budget], which must be done
+// before using the cache.
+func (c *GlyphMetricsCache) SetCacheBudget(budget *CacheBudget) {
+	c.budget = budget
+	budget.Register(c)
+}
+
+// Len returns the number of entries in the cache.
+func (c *GlyphMetricsCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.m)
+}
+
+// Advance returns the horizontal advance of [gid], at [size].
+func (c *GlyphMetricsCache) Advance(face font.Face, gid font.GID, size fixed.Int26_6) fixed.Int26_6 {
+	return c.Metrics(face, gid, size).Advance
+}
+
+// Metrics returns the horizontal advance and the extents of [gid], at [size],
+// taking into account the variation coordinates and the ppem of [face].
+func (c *GlyphMetricsCache) Metrics(face font.Face, gid font.GID, size fixed.Int26_6) GlyphMetrics {
+	key := glyphMetricsKey{
+		font:   face.Font,
+		coords: coordsKey(face.Coords),
+		xPpem:  face.XPpem,
+		yPpem:  face.YPpem,
+		size:   size.Ceil(),
+		gid:    gid,
+	}
+	c.mu.Lock()
+	if e, ok := c.m[key]; ok {
+		c.remove(e)
+		c.insert(e)
+		if c.budget != nil {
+			e.used = c.budget.Now()
+		}
+		c.mu.Unlock()
+		return e.v
+	}
+	c.mu.Unlock()
+
+	// compute the metrics without holding the lock
+	v := computeGlyphMetrics(face, gid, size)
+
+	c.mu.Lock()
+	added, evicted := false, false
+	if _, ok := c.m[key]; !ok {
+		e := &glyphMetricsEntry{key: key, v: v}
+		if c.budget != nil {
+			e.used = c.budget.Now()
+		}
+		c.m[key] = e
+		c.insert(e)
+		added = true
+		if c.maxSize > 0 && len(c.m) > c.maxSize {
+			oldest := c.tail.next
+			c.remove(oldest)
+			delete(c.m, oldest.key)
+			evicted = true
+		}
+	}
+	c.mu.Unlock()
+
+	// the budget may evict entries from this cache : it must not be locked
+	if c.budget != nil && added && !evicted {
+		c.budget.Add(glyphMetricsEntrySize)
+	}
+	return v
+}
+
+// OldestUse implements [BudgetedCache].
+func (c *GlyphMetricsCache) OldestUse() (uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.m) == 0 {
+		return 0, false
+	}
+	return c.tail.next.used, true
+}
+
+// EvictOldest implements [BudgetedCache].
+func (c *GlyphMetricsCache) EvictOldest() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.m) == 0 {
+		return 0
+	}
+	oldest := c.tail.next
+	c.remove(oldest)
+	delete(c.m, oldest.key)
+	return glyphMetricsEntrySize
+}
+
+// remove cuts e out of the lru linked list.
+func (c *GlyphMetricsCache) remove(e *glyphMetricsEntry) {
+	e.next.prev = e.prev
+	e.prev.next = e.next
+}
+
+// insert adds e to the lru linked list.
+func (c *GlyphMetricsCache) insert(e *glyphMetricsEntry) {
+	e.next = c.head
+	e.prev = c.head.prev
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// coordsKey encodes the variation coordinates, so that they may be used
+// as map key. It does not allocate for non variable fonts.
+func coordsKey(coords []float32) string {
+	if len(coords) == 0 {
+		return ""
+	}
+	buf := make([]byte, 4*len(coords))
+	for i, c := range coords {
+		binary.BigEndian.PutUint32(buf[4*i:], math.Float32bits(c))
+	}
+	return string(buf)
+}
+
+// shapingScale returns the scale used by [HarfbuzzShaper.Shape] to convert
+// font units to [size], which is rounded up to an integer number of pixels.
+func shapingScale(face font.Face, size fixed.Int26_6) api.Scale {
+	return api.Scale{Upem: face.Upem(), Ppem: float32(size.Ceil())}
+}
+
+func computeGlyphMetrics(face font.Face, gid font.GID, size fixed.Int26_6) GlyphMetrics {
+	scale := shapingScale(face, size)
+	if scale.Upem == 0 {
+		return GlyphMetrics{}
+	}
+	out := GlyphMetrics{Advance: scale.Fixed(face.HorizontalAdvance(gid))}
+	if extents, ok := face.GlyphExtents(gid); ok {
+		out.HasExtents = true
+		out.XBearing = scale.Fixed(extents.XBearing)
+		out.YBearing = scale.Fixed(extents.YBearing)
+		out.Width = scale.Fixed(extents.Width)
+		out.Height = scale.Fixed(extents.Height)
+	}
+	return out
+}
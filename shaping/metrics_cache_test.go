@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
+	fontapi "github.com/go-text/typesetting/opentype/api/font"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestGlyphMetricsCache(t *testing.T) {
+	text := []rune("Lorem ipsum, dolor sit amet.")
+	input := Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(17) + 13,
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	var shaper HarfbuzzShaper
+	out := shaper.Shape(input)
+
+	cache := NewGlyphMetricsCache(8)
+	for _, g := range out.Glyphs {
+		m := cache.Metrics(benchEnFace, g.GlyphID, input.Size)
+		if m.Advance != g.XAdvance {
+			t.Errorf("glyph %d: expected advance %s, got %s", g.GlyphID, g.XAdvance, m.Advance)
+		}
+		if !m.HasExtents || m.XBearing != g.XBearing || m.YBearing != g.YBearing || m.Width != g.Width || m.Height != g.Height {
+			t.Errorf("glyph %d: unexpected extents %v", g.GlyphID, m)
+		}
+	}
+	// the cache is bounded
+	if n := cache.Len(); n != 8 {
+		t.Errorf("expected 8 entries, got %d", n)
+	}
+
+	// the measurement fast path uses the cache
+	shaper.SetGlyphMetricsCache(cache)
+	if got := shaper.MeasureAdvance(input); got != out.Advance {
+		t.Errorf("expected advance %s, got %s", out.Advance, got)
+	}
+}
+
+func TestGlyphMetricsCacheConcurrent(t *testing.T) {
+	cache := NewGlyphMetricsCache(50)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(size fixed.Int26_6) {
+			defer wg.Done()
+			// faces are not safe for concurrent use
+			face := &fontapi.Face{Font: benchEnFace.Font}
+			for gid := font.GID(0); gid < 100; gid++ {
+				cache.Advance(face, gid, size)
+			}
+		}(fixed.I(10 + i))
+	}
+	wg.Wait()
+	if n := cache.Len(); n != 50 {
+		t.Errorf("expected 50 entries, got %d", n)
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestFontMetrics(t *testing.T) {
+	text := []rune("Hx")
+	var shaper HarfbuzzShaper
+	out := shaper.Shape(Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(20),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	})
+	metrics := out.FontMetrics()
+
+	// the metrics match the glyphs, up to rounding errors
+	close := func(a, b fixed.Int26_6) bool { return a-b <= 2 && b-a <= 2 }
+	if h := out.Glyphs[0].YBearing; !close(metrics.CapHeight, h) {
+		t.Errorf("expected cap height %s, got %s", h, metrics.CapHeight)
+	}
+	if h := out.Glyphs[1].YBearing; !close(metrics.XHeight, h) {
+		t.Errorf("expected x-height %s, got %s", h, metrics.XHeight)
+	}
+	if metrics.SuperscriptOffset <= 0 || metrics.SubscriptOffset >= 0 {
+		t.Errorf("unexpected script offsets %s %s", metrics.SuperscriptOffset, metrics.SubscriptOffset)
+	}
+	if metrics.SuperscriptSize <= 0 || metrics.SuperscriptSize >= out.Size {
+		t.Errorf("unexpected superscript size %s", metrics.SuperscriptSize)
+	}
+	if box := metrics.EmBox; box.Ascent-box.Descent != out.Size || box.Ascent <= 0 || box.Descent >= 0 {
+		t.Errorf("unexpected em box %v", box)
+	}
+
+	if (&Output{}).FontMetrics() != (FontMetrics{}) {
+		t.Error("expected zero metrics without face")
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/math/fixed"
+)
+
+// glyphTransform returns the transformation mapping the outline of [g] (in font units)
+// to its position in pixels, with the glyph origin at ([penX], [penY]).
+// Sideways glyphs are rotated by 90 degrees clockwise.
+func glyphTransform(scale float32, g Glyph, penX, penY fixed.Int26_6) api.Transform {
+	tr := api.Transform{
+		A: scale, D: scale,
+		E: fixedToFloat(penX + g.XOffset), F: fixedToFloat(penY + g.YOffset),
+	}
+	if g.Sideways { // (x, y) -> (y, -x)
+		tr.A, tr.B, tr.C, tr.D = 0, -scale, scale, 0
+	}
+	return tr
+}
+
+// AppendOutline appends to [dst] the outlines of the glyphs of [o],
+// positioned with their advances and offsets, starting at [origin], and returns the extended slice.
+//
+// The coordinates are expressed in pixels (as the other metrics of [Output]), with the
+// Y axis increasing up, so that the result may be merged with the outlines of other runs.
+// Glyphs without outlines (like bitmap only glyphs) are ignored.
+func (o *Output) AppendOutline(dst []api.Segment, origin fixed.Point26_6) []api.Segment {
+	dst, _ = o.appendOutline(dst, origin)
+	return dst
+}
+
+// appendOutline also returns the position of the pen after the last glyph.
+func (o *Output) appendOutline(dst []api.Segment, origin fixed.Point26_6) ([]api.Segment, fixed.Point26_6) {
+	if o.Face == nil {
+		return dst, origin
+	}
+	scale := api.Scale{Upem: o.Face.Upem(), Ppem: fixedToFloat(o.Size)}.Factor()
+	pen := origin
+	for _, g := range o.Glyphs {
+		if outline, ok := glyphOutline(o, g); ok {
+			tr := glyphTransform(scale, g, pen.X, pen.Y)
+			for _, seg := range outline.Segments {
+				for i := range seg.ArgsSlice() {
+					seg.Args[i] = tr.Apply(seg.Args[i])
+				}
+				dst = append(dst, seg)
+			}
+		}
+		pen.X += g.XAdvance
+		pen.Y += g.YAdvance
+	}
+	return dst, pen
+}
+
+// Outline returns the combined outline of the glyphs of [o],
+// with the origin of the first glyph at (0, 0).
+// See [Output.AppendOutline] for more details.
+func (o *Output) Outline() api.GlyphOutline {
+	return api.GlyphOutline{Segments: o.AppendOutline(nil, fixed.Point26_6{})}
+}
+
+// Outline returns the combined outline of the glyphs of [l], suitable
+// for vector exports (like SVG or PDF) of a whole line.
+//
+// The runs are drawn one after the other, in the order of [l] (which should
+// be the visual order), starting at the origin, which is on the baseline.
+// See [Output.AppendOutline] for the coordinate system.
+func (l Line) Outline() api.GlyphOutline {
+	var (
+		out api.GlyphOutline
+		pen fixed.Point26_6
+	)
+	for i := range l {
+		out.Segments, pen = l[i].appendOutline(out.Segments, pen)
+	}
+	return out
+}
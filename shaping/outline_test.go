@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestLineOutline(t *testing.T) {
+	var shaper HarfbuzzShaper
+	shape := func(s string, dir di.Direction) Output {
+		text := []rune(s)
+		return shaper.Shape(Input{
+			Text: text, RunEnd: len(text),
+			Direction: dir,
+			Face:      benchEnFace,
+			Size:      fixed.I(int(benchEnFace.Upem())),
+			Script:    language.Latin,
+			Language:  language.NewLanguage("en"),
+		})
+	}
+	// the size is chosen so that pixels are font units
+	gid, _ := benchEnFace.NominalGlyph('l')
+	glyph := benchEnFace.GlyphData(gid).(api.GlyphOutline)
+
+	run1, run2 := shape("l l", di.DirectionLTR), shape("ll", di.DirectionLTR)
+	outline := run1.Outline()
+	if len(outline.Segments) != 2*len(glyph.Segments) {
+		t.Fatalf("unexpected number of segments %d", len(outline.Segments))
+	}
+	for i, seg := range glyph.Segments {
+		if outline.Segments[i] != seg {
+			t.Fatalf("segment %d: expected %v, got %v", i, seg, outline.Segments[i])
+		}
+	}
+
+	// the runs of a line are merged, and match the SVG path
+	line := Line{run1, run2}
+	outline = line.Outline()
+	if len(outline.Segments) != 4*len(glyph.Segments) {
+		t.Fatalf("unexpected number of segments %d", len(outline.Segments))
+	}
+	if got, exp := outline.SVGPath(api.SVGPathOptions{}), line.SVGPath(api.SVGPathOptions{}); got != exp {
+		t.Errorf("expected %s, got %s", exp, got)
+	}
+	shift := fixedToFloat(run1.Advance)
+	last := outline.Segments[2*len(glyph.Segments)]
+	if exp := glyph.Segments[0].Args[0]; last.Args[0] != (api.SegmentPoint{X: exp.X + shift, Y: exp.Y}) {
+		t.Errorf("unexpected start of the second run %v", last.Args[0])
+	}
+
+	// custom origin
+	segments := run2.AppendOutline(nil, fixed.Point26_6{X: fixed.I(10), Y: fixed.I(-20)})
+	if exp := glyph.Segments[0].Args[0]; segments[0].Args[0] != (api.SegmentPoint{X: exp.X + 10, Y: exp.Y - 20}) {
+		t.Errorf("unexpected origin %v", segments[0].Args[0])
+	}
+
+	// sideways glyphs are rotated
+	sideways := ShapeVertical(&shaper, Input{
+		Text: []rune("l"), RunEnd: 1,
+		Direction: di.DirectionTTB,
+		Face:      benchEnFace,
+		Size:      fixed.I(int(benchEnFace.Upem())),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}, TextOrientationSideways)
+	g := sideways.Glyphs[0]
+	segments = sideways.Outline().Segments
+	for i, seg := range glyph.Segments {
+		p := seg.Args[0]
+		exp := api.SegmentPoint{X: p.Y + fixedToFloat(g.XOffset), Y: -p.X + fixedToFloat(g.YOffset)}
+		if segments[i].Args[0] != exp {
+			t.Errorf("segment %d: expected %v, got %v", i, exp, segments[i].Args[0])
+		}
+	}
+}
@@ -31,6 +31,15 @@ type Glyph struct {
 	GlyphCount int
 	GlyphID    font.GID
 	Mask       font.GlyphMask
+	// Color is true if the glyph has a color representation in the face
+	// (as reported by its HasColorGlyph method), which is the case for the emoji
+	// resolved to the emoji presentation. Renderers should then draw the glyph
+	// using its color layers (see the GlyphLayers method) instead of its outline.
+	Color bool
+	// Sideways is true for the glyphs of vertical runs which must be drawn
+	// rotated by 90 degrees clockwise around their (offset) origin,
+	// as produced by [ShapeVertical]. Their extents are given after rotation.
+	Sideways bool
 }
 
 // LeftSideBearing returns the distance from the glyph's X origin to
@@ -88,7 +97,10 @@ type Output struct {
 	Advance fixed.Int26_6
 	// Size is copied from the shaping.Input.Size that produced this Output.
 	Size fixed.Int26_6
-	// Glyphs are the shaped output text.
+	// Glyphs are the shaped output text, in visual order : from left to right
+	// for horizontal text and from top to bottom for vertical text.
+	// For right-to-left (or bottom-to-top) runs, this is the reverse of the
+	// logical order : see [Output.GlyphsLogical].
 	Glyphs []Glyph
 	// LineBounds describes the font's suggested line bounding dimensions. The
 	// dimensions described should contain any glyphs from the given font.
@@ -105,6 +117,12 @@ type Output struct {
 	// Runes describes the runes this output represents from the input text.
 	Runes Range
 
+	// Bytes describes the bytes this output represents from the input text,
+	// when shaped from UTF-8 text with [HarfbuzzShaper.ShapeString] or [HarfbuzzShaper.ShapeBytes].
+	// It is zero otherwise. It is updated when the output is cut by the [LineWrapper] or [Elide],
+	// whose text must then be the runes decoded from the UTF-8 text.
+	Bytes Range
+
 	// Face is the font face that this output is rendered in. This is needed in
 	// the output in order to render each run in a multi-font sequence in the
 	// correct font.
@@ -172,3 +190,85 @@ func (o *Output) RecalculateAll() {
 		Descent: lowest,
 	}
 }
+
+// AddLetterSpacing adds [spacing] after each glyph cluster (and not after each glyph,
+// so that marks stay attached to their base), updating the Advance field.
+// For vertical text, whose advances are negative, the spacing is subtracted.
+func (o *Output) AddLetterSpacing(spacing fixed.Int26_6) {
+	for i := range o.Glyphs {
+		if i+1 < len(o.Glyphs) && o.Glyphs[i+1].ClusterIndex == o.Glyphs[i].ClusterIndex {
+			continue // not the end of the cluster
+		}
+		if o.Direction.IsVertical() {
+			o.Glyphs[i].YAdvance -= spacing
+			o.Advance -= spacing
+		} else {
+			o.Glyphs[i].XAdvance += spacing
+			o.Advance += spacing
+		}
+	}
+}
+
+// GlyphIterator iterates over the glyphs of an [Output],
+// either in visual or in logical order.
+type GlyphIterator struct {
+	glyphs []Glyph
+	// reverse is true when iterating over the clusters
+	// from the end of the glyph slice
+	reverse bool
+
+	index int
+	// current cluster, in the glyph slice, used when reverse is true
+	clusterStart, clusterEnd int
+}
+
+// GlyphsVisual returns an iterator over the glyphs of [o], in visual order,
+// which is the order of the Glyphs slice : this is the order in which
+// glyphs are drawn.
+func (o *Output) GlyphsVisual() *GlyphIterator {
+	return &GlyphIterator{glyphs: o.Glyphs, index: -1}
+}
+
+// GlyphsLogical returns an iterator over the glyphs of [o], in logical (reading) order,
+// which is the order of the text they represent.
+// For right-to-left (or bottom-to-top) runs, clusters are visited from the end of the
+// Glyphs slice, but the glyphs inside a cluster keep their visual order.
+func (o *Output) GlyphsLogical() *GlyphIterator {
+	if o.Direction.Progression() != di.TowardTopLeft {
+		return o.GlyphsVisual()
+	}
+	n := len(o.Glyphs)
+	// the first call to Next starts the last cluster
+	return &GlyphIterator{glyphs: o.Glyphs, reverse: true, index: n - 1, clusterStart: n, clusterEnd: n}
+}
+
+// Next advances the iterator to the next glyph, returning
+// false at the end of the glyphs.
+func (it *GlyphIterator) Next() bool {
+	if !it.reverse {
+		it.index++
+		return it.index < len(it.glyphs)
+	}
+	if it.index+1 < it.clusterEnd {
+		it.index++
+		return true
+	}
+	// go to the previous cluster
+	if it.clusterStart == 0 {
+		return false
+	}
+	it.clusterEnd = it.clusterStart
+	it.clusterStart--
+	cluster := it.glyphs[it.clusterStart].ClusterIndex
+	for it.clusterStart > 0 && it.glyphs[it.clusterStart-1].ClusterIndex == cluster {
+		it.clusterStart--
+	}
+	it.index = it.clusterStart
+	return true
+}
+
+// Index returns the index of the current glyph in the Glyphs slice.
+func (it *GlyphIterator) Index() int { return it.index }
+
+// Glyph returns the current glyph.
+func (it *GlyphIterator) Glyph() Glyph { return it.glyphs[it.index] }
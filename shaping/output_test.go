@@ -186,3 +186,40 @@ func TestRecalculate(t *testing.T) {
 		})
 	}
 }
+
+func TestGlyphsOrder(t *testing.T) {
+	collect := func(it *shaping.GlyphIterator) (indices []int) {
+		for it.Next() {
+			indices = append(indices, it.Index())
+		}
+		return indices
+	}
+	// clusters 3, 1-2 (two glyphs) and 0, in visual order
+	out := shaping.Output{
+		Direction: di.DirectionRTL,
+		Glyphs: []shaping.Glyph{
+			{ClusterIndex: 3}, {ClusterIndex: 1}, {ClusterIndex: 1}, {ClusterIndex: 0},
+		},
+	}
+	if got := collect(out.GlyphsVisual()); !reflect.DeepEqual(got, []int{0, 1, 2, 3}) {
+		t.Errorf("unexpected visual order %v", got)
+	}
+	if got := collect(out.GlyphsLogical()); !reflect.DeepEqual(got, []int{3, 1, 2, 0}) {
+		t.Errorf("unexpected logical order %v", got)
+	}
+	it := out.GlyphsLogical()
+	it.Next()
+	if g := it.Glyph(); g.ClusterIndex != 0 {
+		t.Errorf("unexpected first logical glyph %v", g)
+	}
+
+	out.Direction = di.DirectionLTR
+	if got := collect(out.GlyphsLogical()); !reflect.DeepEqual(got, []int{0, 1, 2, 3}) {
+		t.Errorf("unexpected logical order %v", got)
+	}
+
+	out.Glyphs = nil
+	if got := collect(out.GlyphsLogical()); got != nil {
+		t.Errorf("unexpected logical order %v", got)
+	}
+}
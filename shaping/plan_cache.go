@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"sync"
+
+	"github.com/go-text/typesetting/harfbuzz"
+)
+
+// estimatedPlanSize is a rough estimate of the memory used by a shaping plan,
+// which stores the lookups and masks of the features applied to a font.
+const estimatedPlanSize = 4 << 10
+
+// planCache reports the shaping plans cached by the buffer of a [HarfbuzzShaper]
+// to a [CacheBudget].
+//
+// Since harfbuzz evicts the plans in creation order, the time of use
+// of a plan is its time of creation.
+type planCache struct {
+	// mu protects the plan cache of buf, which
+	// the budget may evict from any goroutine
+	mu     sync.Mutex
+	buf    *harfbuzz.Buffer // nil until the shaper is used
+	budget *CacheBudget
+	uses   []uint64 // creation time of the cached plans, oldest first
+}
+
+// begin locks the cache before an operation on the buffer which may
+// create or evict plans, and returns the current statistics.
+func (c *planCache) begin() harfbuzz.PlanCacheStats {
+	c.mu.Lock()
+	if c.buf == nil {
+		return harfbuzz.PlanCacheStats{}
+	}
+	return c.buf.PlanCacheStats()
+}
+
+// end records the plans created or evicted since [before] was returned by [begin],
+// unlocks the cache and updates the budget.
+func (c *planCache) end(before harfbuzz.PlanCacheStats) {
+	var after harfbuzz.PlanCacheStats
+	if c.buf != nil {
+		after = c.buf.PlanCacheStats()
+	}
+	created, evicted := after.Misses-before.Misses, after.Evictions-before.Evictions
+	// new plans are added before evicting the oldest ones
+	for i := 0; i < created; i++ {
+		c.uses = append(c.uses, c.budget.Now())
+	}
+	c.uses = c.uses[evicted:]
+	c.mu.Unlock()
+
+	// the budget may evict entries from this cache : it must not be locked
+	if evicted != 0 {
+		c.budget.Remove(evicted * estimatedPlanSize)
+	}
+	if created != 0 {
+		c.budget.Add(created * estimatedPlanSize)
+	}
+}
+
+// OldestUse implements [BudgetedCache].
+func (c *planCache) OldestUse() (uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.uses) == 0 {
+		return 0, false
+	}
+	return c.uses[0], true
+}
+
+// EvictOldest implements [BudgetedCache].
+func (c *planCache) EvictOldest() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.uses) == 0 || !c.buf.EvictOldestPlan() {
+		return 0
+	}
+	c.uses = c.uses[1:]
+	return estimatedPlanSize
+}
+
+// shaperCaches groups the font and plan caches of a [HarfbuzzShaper],
+// registered as one [BudgetedCache].
+type shaperCaches struct {
+	fonts *fontLRU
+	plans *planCache
+}
+
+// oldest returns the cache with the least recently used entry, or nil if both are empty.
+func (sc *shaperCaches) oldest() BudgetedCache {
+	fontUse, hasFont := sc.fonts.OldestUse()
+	planUse, hasPlan := sc.plans.OldestUse()
+	switch {
+	case hasFont && (!hasPlan || fontUse < planUse):
+		return sc.fonts
+	case hasPlan:
+		return sc.plans
+	default:
+		return nil
+	}
+}
+
+// OldestUse implements [BudgetedCache].
+func (sc *shaperCaches) OldestUse() (uint64, bool) {
+	if cache := sc.oldest(); cache != nil {
+		return cache.OldestUse()
+	}
+	return 0, false
+}
+
+// EvictOldest implements [BudgetedCache].
+func (sc *shaperCaches) EvictOldest() int {
+	if cache := sc.oldest(); cache != nil {
+		return cache.EvictOldest()
+	}
+	return 0
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import "golang.org/x/image/math/fixed"
+
+// LineRects are the rectangles covered by a line, relative to the
+// start of its baseline, with the Y axis pointing down. They are typically
+// used to paint backgrounds and to invalidate regions when scrolling.
+type LineRects struct {
+	// Logical spans the advance of the line, and the line height
+	// (from the ascent to the descent, plus the gap) of its runs.
+	// For vertical lines, the advance is along the Y axis, and
+	// the X axis spans from the descent to the ascent of the runs.
+	Logical fixed.Rectangle26_6
+	// Ink is the union of the bounding boxes of the glyphs, including
+	// their offsets. It is empty if no glyph has ink.
+	Ink fixed.Rectangle26_6
+}
+
+// Rects returns the logical and ink rectangles of the line,
+// whose runs are placed one after the other, in the order of the slice.
+func (l Line) Rects() LineRects {
+	var (
+		out    LineRects
+		bounds Bounds
+		pen    fixed.Int26_6
+	)
+	for i, run := range l {
+		if i == 0 {
+			bounds = run.LineBounds
+		} else {
+			bounds.Ascent = max26_6(bounds.Ascent, run.LineBounds.Ascent)
+			bounds.Descent = -max26_6(-bounds.Descent, -run.LineBounds.Descent)
+			bounds.Gap = max26_6(bounds.Gap, run.LineBounds.Gap)
+		}
+		pen = run.appendInk(&out.Ink, pen)
+	}
+	if len(l) != 0 && l[0].Direction.IsVertical() {
+		// vertical advances are negative, in coordinates growing up
+		out.Logical = fixed.Rectangle26_6{
+			Min: fixed.Point26_6{X: min26_6(bounds.Descent, bounds.Ascent)},
+			Max: fixed.Point26_6{X: max26_6(bounds.Descent, bounds.Ascent), Y: -pen},
+		}
+	} else {
+		out.Logical = fixed.Rectangle26_6{
+			Min: fixed.Point26_6{Y: -bounds.Ascent},
+			Max: fixed.Point26_6{X: pen, Y: -bounds.Descent + bounds.Gap},
+		}
+	}
+	return out
+}
+
+// appendInk adds the bounding boxes of the glyphs of [o], starting at [pen],
+// to [ink], and returns the position after the run.
+// For vertical runs, [pen] is a Y position, growing up.
+func (o *Output) appendInk(ink *fixed.Rectangle26_6, pen fixed.Int26_6) fixed.Int26_6 {
+	vertical := o.Direction.IsVertical()
+	for _, g := range o.Glyphs {
+		x0, y0 := g.XOffset+g.XBearing, g.YOffset+g.YBearing // Y up
+		if vertical {
+			y0 += pen
+			pen += g.YAdvance
+		} else {
+			x0 += pen
+			pen += g.XAdvance
+		}
+		x1, y1 := x0+g.Width, y0+g.Height
+		*ink = ink.Union(fixed.Rectangle26_6{
+			Min: fixed.Point26_6{X: min26_6(x0, x1), Y: -max26_6(y0, y1)},
+			Max: fixed.Point26_6{X: max26_6(x0, x1), Y: -min26_6(y0, y1)},
+		})
+	}
+	return pen
+}
+
+// HighlightRect is a rectangle covering the clusters of a line
+// selected by a highlight range.
+type HighlightRect struct {
+	// Highlight is the index of the range in the slice
+	// passed to [Line.HighlightRects].
+	Highlight int
+	// Rect spans the advance of the clusters and the line height,
+	// in the coordinates used by [LineRects.Logical].
+	Rect fixed.Rectangle26_6
+}
+
+// HighlightRects returns the background rectangles of the clusters of the line
+// covered by the [highlights] rune ranges (typically search matches or an
+// IME composition), in visual order.
+//
+// The runs of the line are placed one after the other, in the order of the slice,
+// which should be the visual order. Adjacent clusters of the same highlight
+// are merged, so that a range spanning runs of different directions
+// results in one rectangle per visually contiguous part.
+// A cluster is highlighted as a whole if any of its runes is covered.
+// When ranges overlap, the first one in [highlights] is used.
+func (l Line) HighlightRects(highlights []Range) []HighlightRect {
+	if len(highlights) == 0 || len(l) == 0 {
+		return nil
+	}
+	var (
+		out      []HighlightRect
+		logical  = l.Rects().Logical
+		vertical = l[0].Direction.IsVertical()
+		pen      fixed.Int26_6
+	)
+	add := func(highlight int, start, end fixed.Int26_6) {
+		if n := len(out); n != 0 && out[n-1].Highlight == highlight {
+			last := &out[n-1]
+			if vertical && last.Rect.Max.Y == -start {
+				last.Rect.Max.Y = -end
+				return
+			} else if !vertical && last.Rect.Max.X == start {
+				last.Rect.Max.X = end
+				return
+			}
+		}
+		rect := logical
+		if vertical { // advances are negative, in coordinates growing up
+			rect.Min.Y, rect.Max.Y = -start, -end
+		} else {
+			rect.Min.X, rect.Max.X = start, end
+		}
+		out = append(out, HighlightRect{Highlight: highlight, Rect: rect})
+	}
+	for _, run := range l {
+		for i := 0; i < len(run.Glyphs); {
+			// a cluster is a sequence of glyphs with the same ClusterIndex
+			g := run.Glyphs[i]
+			start := pen
+			for ; i < len(run.Glyphs) && run.Glyphs[i].ClusterIndex == g.ClusterIndex; i++ {
+				if vertical {
+					pen += run.Glyphs[i].YAdvance
+				} else {
+					pen += run.Glyphs[i].XAdvance
+				}
+			}
+			if h := highlightOf(highlights, g.ClusterIndex, g.RuneCount); h != -1 {
+				add(h, start, pen)
+			}
+		}
+	}
+	return out
+}
+
+// highlightOf returns the index of the first range of [highlights] overlapping
+// the [count] runes starting at [offset], or -1.
+func highlightOf(highlights []Range, offset, count int) int {
+	if count < 1 {
+		count = 1
+	}
+	for i, h := range highlights {
+		if h.Offset < offset+count && offset < h.Offset+h.Count {
+			return i
+		}
+	}
+	return -1
+}
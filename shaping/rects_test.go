@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestLineRects(t *testing.T) {
+	text := []rune("The quick brown fox jumps over the lazy dog, again and again.")
+	input := Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	run := (&HarfbuzzShaper{}).Shape(input)
+
+	var wrapper LineWrapper
+	lines, _ := wrapper.WrapParagraph(WrapConfig{ComputeLineRects: true}, 150, text, run)
+	rects := wrapper.LineRects()
+	if len(lines) < 3 || len(rects) != len(lines) {
+		t.Fatalf("unexpected rects %v for %d lines", rects, len(lines))
+	}
+	for i, line := range lines {
+		r := rects[i]
+		if r != line.Rects() {
+			t.Errorf("line %d: inconsistent rects", i)
+		}
+		var width fixed.Int26_6
+		for _, run := range line {
+			width += run.Advance
+		}
+		if r.Logical.Min.X != 0 || r.Logical.Max.X != width {
+			t.Errorf("line %d: unexpected logical rect %v", i, r.Logical)
+		}
+		if r.Logical.Min.Y != -run.LineBounds.Ascent || (r.Logical.Max.Y-r.Logical.Min.Y) != run.LineBounds.LineHeight() {
+			t.Errorf("line %d: unexpected logical rect %v", i, r.Logical)
+		}
+		if r.Ink.Empty() || r.Ink.Min.Y < r.Logical.Min.Y || r.Ink.Max.Y > r.Logical.Max.Y ||
+			r.Ink.Min.X < -fixed.I(1) || r.Ink.Max.X > width {
+			t.Errorf("line %d: unexpected ink rect %v (logical %v)", i, r.Ink, r.Logical)
+		}
+	}
+
+	// without the option, no rects are computed
+	wrapper.WrapParagraph(WrapConfig{}, 150, text, run)
+	if len(wrapper.LineRects()) != 0 {
+		t.Fatal("unexpected rects")
+	}
+	// a line fitting in the width
+	wrapper.WrapParagraph(WrapConfig{ComputeLineRects: true}, 10000, text, run)
+	if rects := wrapper.LineRects(); len(rects) != 1 || rects[0].Logical.Max.X != run.Advance {
+		t.Fatalf("unexpected rects %v", rects)
+	}
+
+	// vertical text
+	input.Direction = di.DirectionTTB
+	vertical := (&HarfbuzzShaper{}).Shape(input)
+	r := Line{vertical}.Rects()
+	if r.Logical.Min.Y != 0 || r.Logical.Max.Y != -vertical.Advance || (r.Logical.Max.Y-r.Logical.Min.Y) <= 0 {
+		t.Fatalf("unexpected logical rect %v", r.Logical)
+	}
+	if r.Ink.Min.Y < -fixed.I(1) || r.Ink.Max.Y > r.Logical.Max.Y+fixed.I(1) {
+		t.Fatalf("unexpected ink rect %v (logical %v)", r.Ink, r.Logical)
+	}
+}
+
+func TestLineHighlightRects(t *testing.T) {
+	var shaper HarfbuzzShaper
+	text := []rune("abc تثذرزسشص")
+	shape := func(start, end int, dir di.Direction, input Input) Output {
+		input.Text, input.RunStart, input.RunEnd, input.Direction = text, start, end, dir
+		input.Size = fixed.I(16)
+		return shaper.Shape(input)
+	}
+	en := shape(0, 4, di.DirectionLTR, Input{Face: benchEnFace, Script: language.Latin})
+	ar := shape(4, len(text), di.DirectionRTL, Input{Face: benchArFace, Script: language.Arabic})
+	line := Line{en, ar}
+	logical := line.Rects().Logical
+
+	if rects := line.HighlightRects(nil); len(rects) != 0 {
+		t.Fatalf("unexpected rects %v", rects)
+	}
+
+	// the whole line
+	rects := line.HighlightRects([]Range{{Offset: 0, Count: len(text)}})
+	if len(rects) != 1 || rects[0].Rect != logical {
+		t.Fatalf("expected %v, got %v", logical, rects)
+	}
+
+	// a range crossing the direction boundary is visually split
+	rects = line.HighlightRects([]Range{{Offset: 2, Count: 4}})
+	if len(rects) != 2 {
+		t.Fatalf("unexpected rects %v", rects)
+	}
+	ab := en.Glyphs[0].XAdvance + en.Glyphs[1].XAdvance
+	if r := rects[0].Rect; r.Min.X != ab || r.Max.X != en.Advance ||
+		r.Min.Y != logical.Min.Y || r.Max.Y != logical.Max.Y {
+		t.Errorf("unexpected first rect %v", r)
+	}
+	// the start of the RTL run is on its right
+	if r := rects[1].Rect; r.Max.X != logical.Max.X || r.Min.X <= en.Advance {
+		t.Errorf("unexpected second rect %v", r)
+	}
+
+	// the first range wins, adjacent ranges are not merged
+	rects = line.HighlightRects([]Range{{Offset: 1, Count: 1}, {Offset: 0, Count: 3}})
+	if len(rects) != 3 || rects[0].Highlight != 1 || rects[1].Highlight != 0 || rects[2].Highlight != 1 {
+		t.Fatalf("unexpected rects %v", rects)
+	}
+	if rects[0].Rect.Max.X != rects[1].Rect.Min.X || rects[1].Rect.Max.X != rects[2].Rect.Min.X {
+		t.Errorf("expected contiguous rects, got %v", rects)
+	}
+
+	// vertical lines
+	vert := shape(0, 3, di.DirectionTTB, Input{Face: benchEnFace, Script: language.Latin})
+	rects = Line{vert}.HighlightRects([]Range{{Offset: 1, Count: 2}})
+	if len(rects) != 1 || rects[0].Rect.Min.Y != -vert.Glyphs[0].YAdvance || rects[0].Rect.Max.Y != -vert.Advance {
+		t.Errorf("unexpected vertical rects %v", rects)
+	}
+}
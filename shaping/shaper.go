@@ -3,8 +3,13 @@
 package shaping
 
 import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
 	"github.com/go-text/typesetting/di"
 	"github.com/go-text/typesetting/harfbuzz"
+	"github.com/go-text/typesetting/opentype/api/font"
 	"golang.org/x/image/math/fixed"
 )
 
@@ -12,10 +17,29 @@ import (
 // Reusing this shaper type across multiple shaping operations is
 // faster and more memory-efficient than creating a new shaper
 // for each operation.
+//
+// A HarfbuzzShaper must not be copied after its first use, since
+// its caches would then be shared by the copies.
 type HarfbuzzShaper struct {
 	buf *harfbuzz.Buffer
+	// features is a buffer for the features
+	// applied to the current input
+	features []harfbuzz.Feature
+	// runeIndices is a buffer used by ShapeString and ShapeBytes
+	// to map byte offsets to rune indices
+	runeIndices []int
+	// coverages caches the glyphs involved in layout lookups,
+	// used by MeasureAdvance
+	coverages map[*font.Font]*glyphSet
+	// metrics is an optional, shared cache
+	metrics *GlyphMetricsCache
 
 	fonts fontLRU
+	// planCacheSize is applied to buf when it is created
+	planCacheSize int
+	// plans and caches are only set with a budget
+	plans  *planCache
+	caches *shaperCaches
 }
 
 // SetFontCacheSize adjusts the size of the font cache within the shaper.
@@ -25,6 +49,62 @@ func (h *HarfbuzzShaper) SetFontCacheSize(size int) {
 	h.fonts.maxSize = size
 }
 
+// SetCacheBudget registers the font and shaping plan caches of the shaper with [budget],
+// which then controls their size, in addition to [HarfbuzzShaper.SetFontCacheSize]
+// and [HarfbuzzShaper.SetPlanCacheSize] (a zero cache size does not limit the number of entries).
+// It must be called before using the shaper. Call [CacheBudget.Unregister]
+// with the result of [HarfbuzzShaper.BudgetedCache] when the shaper is no longer used.
+func (h *HarfbuzzShaper) SetCacheBudget(budget *CacheBudget) {
+	h.fonts.setBudget(budget)
+	h.plans = &planCache{budget: budget}
+	h.caches = &shaperCaches{fonts: &h.fonts, plans: h.plans}
+	budget.Register(h.caches)
+}
+
+// BudgetedCache returns the caches of the shaper, as registered by
+// [HarfbuzzShaper.SetCacheBudget], or nil if the shaper has no budget.
+func (h *HarfbuzzShaper) BudgetedCache() BudgetedCache {
+	if h.caches == nil {
+		return nil
+	}
+	return h.caches
+}
+
+// SetPlanCacheSize limits the number of shaping plans cached by the shaper,
+// evicting the oldest ones when needed. A zero size (the default) does not limit the cache.
+// Plans are compiled for each combination of face, script, language, direction
+// and font features, and reused by the subsequent shaping calls.
+func (h *HarfbuzzShaper) SetPlanCacheSize(size int) {
+	h.planCacheSize = size
+	if h.buf == nil {
+		return
+	}
+	if h.plans != nil {
+		defer h.plans.end(h.plans.begin())
+	}
+	h.buf.SetPlanCacheSize(size)
+}
+
+// PlanCacheStats returns the statistics of the shaping plans cache,
+// which may be used to tune [HarfbuzzShaper.SetPlanCacheSize].
+func (h *HarfbuzzShaper) PlanCacheStats() harfbuzz.PlanCacheStats {
+	if h.buf == nil {
+		return harfbuzz.PlanCacheStats{}
+	}
+	if h.plans != nil {
+		h.plans.mu.Lock()
+		defer h.plans.mu.Unlock()
+	}
+	return h.buf.PlanCacheStats()
+}
+
+// SetGlyphMetricsCache sets the cache used to look up glyph advances
+// in [HarfbuzzShaper.MeasureAdvance]. The same cache may be shared by several shapers.
+// A nil cache disables caching.
+func (h *HarfbuzzShaper) SetGlyphMetricsCache(cache *GlyphMetricsCache) {
+	h.metrics = cache
+}
+
 var _ Shaper = (*HarfbuzzShaper)(nil)
 
 // Shaper describes the signature of a font shaping operation.
@@ -55,12 +135,7 @@ func clamp(val, low, high int) int {
 
 // Shape turns an input into an output.
 func (t *HarfbuzzShaper) Shape(input Input) Output {
-	// Prepare to shape the text.
-	if t.buf == nil {
-		t.buf = harfbuzz.NewBuffer()
-	} else {
-		t.buf.Clear()
-	}
+	t.resetBuffer()
 
 	runes, start, end := input.Text, input.RunStart, input.RunEnd
 	if end < start {
@@ -70,19 +145,173 @@ func (t *HarfbuzzShaper) Shape(input Input) Output {
 	start = clamp(start, 0, len(runes))
 	end = clamp(end, 0, len(runes))
 	t.buf.AddRunes(runes, start, end-start)
-	switch input.Direction {
+	t.features = appendLocaleFeatures(t.features[:0], input)
+	t.features = appendFontFeatures(t.features, input.FontFeatures)
+
+	out := t.shape(input)
+	countClusters(out.Glyphs, input.RunEnd, input.Direction)
+	out.Runes.Offset = input.RunStart
+	out.Runes.Count = input.RunEnd - input.RunStart
+	out.RecalculateAll()
+	return out
+}
+
+// ShapeRun shapes exactly the run input.Text[input.RunStart:input.RunEnd] with input.Face,
+// for callers which have already split the text by face, script and direction.
+// The face, script, language, direction and font features are used as is,
+// no font fallback is performed, and the returned [Output] always covers
+// the whole run.
+//
+// Contrary to [HarfbuzzShaper.Shape], which tries to guess what the caller
+// wanted, an error is returned if the input is invalid.
+func (t *HarfbuzzShaper) ShapeRun(input Input) (Output, error) {
+	if input.Face == nil {
+		return Output{}, errors.New("shaping: missing face")
+	}
+	if input.RunStart < 0 || input.RunStart > input.RunEnd || input.RunEnd > len(input.Text) {
+		return Output{}, fmt.Errorf("shaping: invalid run [%d, %d) for text of length %d", input.RunStart, input.RunEnd, len(input.Text))
+	}
+	if input.Direction > di.DirectionBTT {
+		return Output{}, fmt.Errorf("shaping: invalid direction %d", input.Direction)
+	}
+	return t.Shape(input), nil
+}
+
+// ShapeString is the same as [HarfbuzzShaper.Shape], but takes its text from [text],
+// encoded in UTF-8, avoiding the conversion to a []rune slice : input.Text is ignored, and
+// input.RunStart and input.RunEnd are byte offsets into [text], which must be on rune boundaries.
+//
+// The cluster indices of the returned glyphs and the Runes field are expressed
+// in runes, as for [HarfbuzzShaper.Shape], and the Bytes field gives the byte range
+// of the shaped run. The runes preceding the run are counted on each call.
+func (t *HarfbuzzShaper) ShapeString(input Input, text string) Output {
+	t.resetBuffer()
+
+	start, end := clampRun(input.RunStart, input.RunEnd, len(text))
+	t.buf.AddString(text, start, end-start)
+	input.RunStart, input.RunEnd = start, end
+	t.features = appendLocaleFeaturesString(t.features[:0], input, text)
+	t.features = appendFontFeatures(t.features, input.FontFeatures)
+
+	out := t.shape(input)
+
+	// convert the clusters from byte offsets to rune indices
+	runeStart := utf8.RuneCountInString(text[:start])
+	t.resizeRuneIndices(end - start)
+	runeIndex := runeStart
+	for i := range text[start:end] {
+		t.runeIndices[i] = runeIndex
+		runeIndex++
+	}
+	return t.finishUTF8(out, input, runeStart, runeIndex)
+}
+
+// ShapeBytes is the same as [HarfbuzzShaper.ShapeString], for UTF-8 text stored in a
+// byte slice.
+func (t *HarfbuzzShaper) ShapeBytes(input Input, text []byte) Output {
+	t.resetBuffer()
+
+	start, end := clampRun(input.RunStart, input.RunEnd, len(text))
+	t.buf.AddBytes(text, start, end-start)
+	input.RunStart, input.RunEnd = start, end
+	t.features = appendLocaleFeaturesBytes(t.features[:0], input, text)
+	t.features = appendFontFeatures(t.features, input.FontFeatures)
+
+	out := t.shape(input)
+
+	// convert the clusters from byte offsets to rune indices
+	runeStart := utf8.RuneCount(text[:start])
+	t.resizeRuneIndices(end - start)
+	runeIndex := runeStart
+	for i := start; i < end; {
+		_, size := utf8.DecodeRune(text[i:end])
+		t.runeIndices[i-start] = runeIndex
+		runeIndex++
+		i += size
+	}
+	return t.finishUTF8(out, input, runeStart, runeIndex)
+}
+
+// clampRun returns the run [start, end), ordered and clamped to [0, length].
+func clampRun(start, end, length int) (int, int) {
+	if end < start {
+		end, start = start, end
+	}
+	return clamp(start, 0, length), clamp(end, 0, length)
+}
+
+func (t *HarfbuzzShaper) resizeRuneIndices(size int) {
+	if cap(t.runeIndices) < size {
+		t.runeIndices = make([]int, size)
+	}
+	t.runeIndices = t.runeIndices[:size]
+}
+
+// finishUTF8 converts the clusters of [out], which are byte offsets, to
+// the rune indices stored in t.runeIndices, where [input] has been clamped to
+// the runes [runeStart, runeEnd).
+func (t *HarfbuzzShaper) finishUTF8(out Output, input Input, runeStart, runeEnd int) Output {
+	for i := range out.Glyphs {
+		out.Glyphs[i].ClusterIndex = t.runeIndices[out.Glyphs[i].ClusterIndex-input.RunStart]
+	}
+
+	countClusters(out.Glyphs, runeEnd, input.Direction)
+	out.Runes = Range{Offset: runeStart, Count: runeEnd - runeStart}
+	out.Bytes = Range{Offset: input.RunStart, Count: input.RunEnd - input.RunStart}
+	out.RecalculateAll()
+	return out
+}
+
+// resetBuffer prepares the buffer to shape new text
+func (t *HarfbuzzShaper) resetBuffer() {
+	if t.buf == nil {
+		t.buf = harfbuzz.NewBuffer()
+		t.buf.SetPlanCacheSize(t.planCacheSize)
+		if t.plans != nil {
+			t.plans.mu.Lock()
+			t.plans.buf = t.buf
+			t.plans.mu.Unlock()
+		}
+	} else {
+		t.buf.Clear()
+	}
+}
+
+// HarfbuzzDirection returns the equivalent direction used by harfbuzz.
+// Invalid values default to harfbuzz.LeftToRight.
+func HarfbuzzDirection(d di.Direction) harfbuzz.Direction {
+	switch d {
 	case di.DirectionRTL:
-		t.buf.Props.Direction = harfbuzz.RightToLeft
+		return harfbuzz.RightToLeft
 	case di.DirectionBTT:
-		t.buf.Props.Direction = harfbuzz.BottomToTop
+		return harfbuzz.BottomToTop
 	case di.DirectionTTB:
-		t.buf.Props.Direction = harfbuzz.TopToBottom
+		return harfbuzz.TopToBottom
 	default:
-		// Default to LTR.
-		t.buf.Props.Direction = harfbuzz.LeftToRight
+		return harfbuzz.LeftToRight
 	}
+}
+
+// appendFontFeatures appends the user provided [features], applied to
+// the whole run. Since they come after the locale features, they override them.
+func appendFontFeatures(dst []harfbuzz.Feature, features []FontFeature) []harfbuzz.Feature {
+	for _, f := range features {
+		dst = append(dst, harfbuzz.Feature{Tag: f.Tag, Value: f.Value, Start: harfbuzz.FeatureGlobalStart, End: harfbuzz.FeatureGlobalEnd})
+	}
+	return dst
+}
+
+// shape shapes the text added to the buffer, with the features
+// stored in t.features, returning an Output
+// whose glyphs cluster indices are the ones provided to the buffer.
+// The Runes field and the glyphs rune and glyph counts are not set.
+func (t *HarfbuzzShaper) shape(input Input) Output {
+	t.buf.Props.Direction = HarfbuzzDirection(input.Direction)
 	t.buf.Props.Language = input.Language
 	t.buf.Props.Script = input.Script
+	if input.DisableLocaleFeatures {
+		t.buf.Props.Language = ""
+	}
 
 	// reuse font when possible
 	font, ok := t.fonts.Get(input.Face.Font)
@@ -95,9 +324,17 @@ func (t *HarfbuzzShaper) Shape(input Input) Output {
 	font.YScale = font.XScale
 
 	// Actually use harfbuzz to shape the text.
-	t.buf.Shape(font, nil)
+	if t.plans != nil {
+		stats := t.plans.begin()
+		t.buf.Shape(font, t.features)
+		t.plans.end(stats)
+	} else {
+		t.buf.Shape(font, t.features)
+	}
 
 	// Convert the shaped text into an Output.
+	// Most fonts have no color glyph : only check the glyphs of the others.
+	hasColor := input.Face.HasColorTables()
 	glyphs := make([]Glyph, len(t.buf.Info))
 	for i := range glyphs {
 		g := t.buf.Info[i].Glyph
@@ -105,6 +342,7 @@ func (t *HarfbuzzShaper) Shape(input Input) Output {
 			ClusterIndex: t.buf.Info[i].Cluster,
 			GlyphID:      g,
 			Mask:         t.buf.Info[i].Mask,
+			Color:        hasColor && input.Face.HasColorGlyph(g),
 		}
 		extents, ok := font.GlyphExtents(g)
 		if !ok {
@@ -121,7 +359,6 @@ func (t *HarfbuzzShaper) Shape(input Input) Output {
 		glyphs[i].XOffset = fixed.I(int(t.buf.Pos[i].XOffset)) >> scaleShift
 		glyphs[i].YOffset = fixed.I(int(t.buf.Pos[i].YOffset)) >> scaleShift
 	}
-	countClusters(glyphs, input.RunEnd, input.Direction)
 	out := Output{
 		Glyphs:    glyphs,
 		Direction: input.Direction,
@@ -134,9 +371,6 @@ func (t *HarfbuzzShaper) Shape(input Input) Output {
 		Descent: fixed.I(int(fontExtents.Descender)) >> scaleShift,
 		Gap:     fixed.I(int(fontExtents.LineGap)) >> scaleShift,
 	}
-	out.Runes.Offset = input.RunStart
-	out.Runes.Count = input.RunEnd - input.RunStart
-	out.RecalculateAll()
 	return out
 }
 
@@ -168,10 +402,10 @@ func countClusters(glyphs []Glyph, textLen int, dir di.Direction) {
 			if nextCluster == -1 {
 				nextCluster = textLen
 			}
-			switch dir {
-			case di.DirectionLTR:
+			switch dir.Progression() {
+			case di.FromTopLeft: // LTR and TTB
 				runesInCluster = nextCluster - currentCluster
-			case di.DirectionRTL:
+			case di.TowardTopLeft: // RTL and BTT
 				runesInCluster = previousCluster - currentCluster
 			}
 			previousCluster = g
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"reflect"
 	"runtime"
 	"testing"
+	"unicode/utf8"
 
 	td "github.com/go-text/typesetting-utils/opentype"
 	"github.com/go-text/typesetting/di"
 	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/harfbuzz"
 	"github.com/go-text/typesetting/language"
 	apiFont "github.com/go-text/typesetting/opentype/api/font"
 	"github.com/go-text/typesetting/opentype/api/metadata"
@@ -60,6 +63,130 @@ func TestShape(t *testing.T) {
 	}
 }
 
+func TestShapeString(t *testing.T) {
+	for _, test := range []struct {
+		text       string
+		start, end int // in runes
+		dir        di.Direction
+		face       font.Face
+		script     language.Script
+	}{
+		{"Hé, ﬁnal café", 0, 13, di.DirectionLTR, benchEnFace, language.Latin},
+		{"Hé, ﬁnal café", 3, 10, di.DirectionLTR, benchEnFace, language.Latin},
+		{"تسجّل يتكلّم", 0, 12, di.DirectionRTL, benchArFace, language.Arabic},
+		{"تسجّل يتكلّم", 2, 9, di.DirectionRTL, benchArFace, language.Arabic},
+	} {
+		runes := []rune(test.text)
+		input := Input{
+			Text:      runes,
+			RunStart:  test.start,
+			RunEnd:    test.end,
+			Direction: test.dir,
+			Face:      test.face,
+			Size:      16 * 72,
+			Script:    test.script,
+			Language:  language.NewLanguage("en"),
+		}
+		var shaper HarfbuzzShaper
+		exp := shaper.Shape(input)
+
+		byteStart, byteEnd := len(string(runes[:test.start])), len(string(runes[:test.end]))
+		input.Text = nil
+		input.RunStart, input.RunEnd = byteStart, byteEnd
+		got := shaper.ShapeString(input, test.text)
+
+		if expected := (Range{Offset: byteStart, Count: byteEnd - byteStart}); got.Bytes != expected {
+			t.Errorf("expected bytes %v, got %v", expected, got.Bytes)
+		}
+		got.Bytes = Range{}
+		if !reflect.DeepEqual(exp, got) {
+			t.Errorf("%q: ShapeString differs from Shape:\n%v\n%v", test.text, exp.Glyphs, got.Glyphs)
+		}
+
+		got = shaper.ShapeBytes(input, []byte(test.text))
+		got.Bytes = Range{}
+		if !reflect.DeepEqual(exp, got) {
+			t.Errorf("%q: ShapeBytes differs from Shape:\n%v\n%v", test.text, exp.Glyphs, got.Glyphs)
+		}
+	}
+
+	// shaping the runs of a text in order, or not, gives the same rune offsets
+	text := "Hé, ﬁnal café. Hé, ﬁnal café."
+	bytesText := []byte(text)
+	var shaper HarfbuzzShaper
+	input := Input{Direction: di.DirectionLTR, Face: benchEnFace, Size: 16 * 72, Script: language.Latin}
+	for _, run := range [][2]int{{0, 5}, {5, 12}, {12, 17}, {0, 12}, {17, len(text)}, {5, 12}} {
+		input.RunStart, input.RunEnd = run[0], run[1]
+		expected := Range{Offset: utf8.RuneCountInString(text[:run[0]]), Count: utf8.RuneCountInString(text[run[0]:run[1]])}
+		if got := shaper.ShapeString(input, text).Runes; got != expected {
+			t.Errorf("run %v: expected runes %v, got %v", run, expected, got)
+		}
+		if got := shaper.ShapeBytes(input, bytesText).Runes; got != expected {
+			t.Errorf("run %v: expected runes %v, got %v", run, expected, got)
+		}
+	}
+
+	// a buffer reused for another text of the same length
+	input.RunStart, input.RunEnd = 0, 5
+	shaper.ShapeBytes(input, bytesText)
+	copy(bytesText, "abcdefghijklmnopqrstuvwxyz0123456789")
+	input.RunStart, input.RunEnd = 5, 12
+	if got, expected := shaper.ShapeBytes(input, bytesText).Runes, (Range{Offset: 5, Count: 7}); got != expected {
+		t.Errorf("reused buffer: expected runes %v, got %v", expected, got)
+	}
+}
+
+func TestShapeRun(t *testing.T) {
+	text := []rune("Lorem ipsum.")
+	input := Input{
+		Text:      text,
+		RunStart:  6,
+		RunEnd:    8,
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      16 * 72,
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	var shaper HarfbuzzShaper
+	got, err := shaper.ShapeRun(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := shaper.Shape(input); !reflect.DeepEqual(exp, got) {
+		t.Errorf("ShapeRun differs from Shape:\n%v\n%v", exp.Glyphs, got.Glyphs)
+	}
+	if expected := (Range{Offset: 6, Count: 2}); got.Runes != expected {
+		t.Errorf("expected runes %v, got %v", expected, got.Runes)
+	}
+
+	for i, invalid := range []Input{
+		{Text: text, RunStart: 8, RunEnd: 6, Face: benchEnFace},
+		{Text: text, RunStart: -1, RunEnd: 6, Face: benchEnFace},
+		{Text: text, RunStart: 0, RunEnd: 13, Face: benchEnFace},
+		{Text: text, RunStart: 0, RunEnd: 12},
+		{Text: text, RunStart: 0, RunEnd: 12, Face: benchEnFace, Direction: 4},
+	} {
+		if _, err := shaper.ShapeRun(invalid); err == nil {
+			t.Errorf("expected error for invalid input %d", i)
+		}
+	}
+}
+
+func TestHarfbuzzDirection(t *testing.T) {
+	for d, expected := range map[di.Direction]harfbuzz.Direction{
+		di.DirectionLTR: harfbuzz.LeftToRight,
+		di.DirectionRTL: harfbuzz.RightToLeft,
+		di.DirectionTTB: harfbuzz.TopToBottom,
+		di.DirectionBTT: harfbuzz.BottomToTop,
+		4:               harfbuzz.LeftToRight,
+	} {
+		if got := HarfbuzzDirection(d); got != expected {
+			t.Errorf("direction %d: expected %d, got %d", d, expected, got)
+		}
+	}
+}
+
 func TestCountClusters(t *testing.T) {
 	type testcase struct {
 		name     string
@@ -198,6 +325,20 @@ func TestCountClusters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "ttb",
+			textLen:  3,
+			dir:      di.DirectionTTB,
+			glyphs:   []Glyph{{ClusterIndex: 0}, {ClusterIndex: 1}},
+			expected: []Glyph{{ClusterIndex: 0, RuneCount: 1, GlyphCount: 1}, {ClusterIndex: 1, RuneCount: 2, GlyphCount: 1}},
+		},
+		{
+			name:     "btt",
+			textLen:  3,
+			dir:      di.DirectionBTT,
+			glyphs:   []Glyph{{ClusterIndex: 1}, {ClusterIndex: 0}},
+			expected: []Glyph{{ClusterIndex: 1, RuneCount: 2, GlyphCount: 1}, {ClusterIndex: 0, RuneCount: 1, GlyphCount: 1}},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			countClusters(tc.glyphs, tc.textLen, tc.dir)
@@ -212,6 +353,30 @@ func TestCountClusters(t *testing.T) {
 	}
 }
 
+func TestPlanCacheStats(t *testing.T) {
+	var shaper HarfbuzzShaper
+	shaper.SetPlanCacheSize(1)
+	input := Input{
+		Text:      []rune("hello"),
+		RunEnd:    5,
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      16 * 72,
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	shaper.Shape(input)
+	shaper.Shape(input)
+	if stats := shaper.PlanCacheStats(); stats != (harfbuzz.PlanCacheStats{Hits: 1, Misses: 1, Size: 1}) {
+		t.Fatalf("unexpected stats %v", stats)
+	}
+	input.Direction = di.DirectionRTL
+	shaper.Shape(input)
+	if stats := shaper.PlanCacheStats(); stats != (harfbuzz.PlanCacheStats{Hits: 1, Misses: 2, Evictions: 1, Size: 1}) {
+		t.Fatalf("unexpected stats %v", stats)
+	}
+}
+
 func BenchmarkShaping(b *testing.B) {
 	for _, langInfo := range benchLangs {
 		for _, size := range []int{10, 100, 1000} {
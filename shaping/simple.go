@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"unicode"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/opentype/api"
+	otFont "github.com/go-text/typesetting/opentype/api/font"
+	"github.com/go-text/typesetting/opentype/loader"
+	"github.com/go-text/typesetting/opentype/tables"
+	"golang.org/x/image/math/fixed"
+)
+
+// SimpleShaper is a minimal [Shaper], which maps each rune to the nominal glyph
+// of the face (using the 'cmap' table), positioned with its advance
+// (from the 'hmtx' or 'vmtx' tables) and adjusted by the pair kerning found in
+// the 'kern' feature of the 'GPOS' table, or in the 'kern' table.
+//
+// It does not apply ligatures, contextual forms, mark positioning nor
+// the font features of the input, and is thus only suitable for simple scripts,
+// like Latin, Greek or Cyrillic, without combining marks.
+// In exchange, it avoids the cost of the complete shaping engine of [HarfbuzzShaper],
+// which is useful in constrained environments.
+//
+// The zero value is ready to use. A SimpleShaper is not safe for concurrent use.
+type SimpleShaper struct {
+	// kerns caches the pair kerning of the fonts
+	kerns map[font.Font]*pairKerning
+}
+
+var _ Shaper = (*SimpleShaper)(nil)
+
+// Shape implements [Shaper]. Runes without glyph in the face are mapped to the glyph 0,
+// and variation selectors are merged with the previous rune.
+func (s *SimpleShaper) Shape(input Input) Output {
+	start, end := input.RunStart, input.RunEnd
+	if end < start {
+		end, start = start, end
+	}
+	start = clamp(start, 0, len(input.Text))
+	end = clamp(end, 0, len(input.Text))
+
+	out := Output{
+		Direction: input.Direction,
+		Face:      input.Face,
+		Size:      input.Size,
+		Runes:     Range{Offset: start, Count: end - start},
+	}
+	face := input.Face
+	scale := shapingScale(face, input.Size)
+	if face == nil || scale.Upem == 0 {
+		return out
+	}
+	vertical := input.Direction.IsVertical()
+
+	// map the runes, in logical order
+	glyphs := make([]Glyph, 0, end-start)
+	for i := start; i < end; i++ {
+		r := input.Text[i]
+		if n := len(glyphs); n != 0 && isVariationSelector(r) {
+			if gid, ok := face.VariationGlyph(input.Text[i-1], r); ok {
+				glyphs[n-1].GlyphID = gid
+			}
+			continue
+		}
+		gid, _ := face.NominalGlyph(r)
+		glyphs = append(glyphs, Glyph{ClusterIndex: i, GlyphID: gid})
+	}
+
+	hasColor := face.HasColorTables()
+	for i := range glyphs {
+		g := &glyphs[i]
+		gid := g.GlyphID
+		g.Color = hasColor && face.HasColorGlyph(gid)
+		if extents, ok := face.GlyphExtents(gid); ok {
+			g.XBearing = scale.Fixed(extents.XBearing)
+			g.YBearing = scale.Fixed(extents.YBearing)
+			g.Width = scale.Fixed(extents.Width)
+			g.Height = scale.Fixed(extents.Height)
+		}
+		if vertical {
+			g.YAdvance = scale.Fixed(face.VerticalAdvance(gid))
+			// offsets are relative to the vertical origin
+			ox, oy := verticalOrigin(face, gid)
+			g.XOffset, g.YOffset = -scale.Fixed(ox), -scale.Fixed(oy)
+		} else {
+			g.XAdvance = scale.Fixed(face.HorizontalAdvance(gid))
+		}
+	}
+	if !vertical {
+		s.pairKerning(face.Font).apply(glyphs, scale)
+	}
+
+	if input.Direction.Progression() == di.TowardTopLeft {
+		for i, j := 0, len(glyphs)-1; i < j; i, j = i+1, j-1 {
+			glyphs[i], glyphs[j] = glyphs[j], glyphs[i]
+		}
+	}
+	out.Glyphs = glyphs
+
+	if vertical {
+		extents, ok := face.FontVExtents()
+		if !ok {
+			extents = api.FontExtents{Ascender: float32(scale.Upem) / 2, Descender: -float32(scale.Upem) / 2}
+		}
+		out.LineBounds = scaledBounds(scale, extents)
+	} else {
+		extents, ok := face.FontHExtents()
+		if !ok {
+			extents = api.FontExtents{Ascender: float32(scale.Upem) * 0.8, Descender: -float32(scale.Upem) * 0.2}
+		}
+		out.LineBounds = scaledBounds(scale, extents)
+	}
+
+	countClusters(out.Glyphs, end, input.Direction)
+	out.RecalculateAll()
+	return out
+}
+
+func scaledBounds(scale api.Scale, extents api.FontExtents) Bounds {
+	return Bounds{
+		Ascent:  scale.Fixed(extents.Ascender),
+		Descent: scale.Fixed(extents.Descender),
+		Gap:     scale.Fixed(extents.LineGap),
+	}
+}
+
+func isVariationSelector(r rune) bool {
+	return unicode.Is(unicode.Variation_Selector, r)
+}
+
+// verticalOrigin returns the position of the vertical origin of [gid]
+// relative to its horizontal origin, in font units.
+func verticalOrigin(face font.Face, gid font.GID) (x, y float32) {
+	if x, y, ok := face.GlyphVOrigin(gid); ok {
+		return float32(x), float32(y)
+	}
+	// use the same fallback as harfbuzz
+	x = face.HorizontalAdvance(gid) / 2
+	if extents, ok := face.FontHExtents(); ok {
+		return x, extents.Ascender
+	}
+	return x, float32(face.Upem()) * 0.8
+}
+
+// maxKerningCacheSize is the maximum number of fonts
+// whose pair kerning is cached by a [SimpleShaper]
+const maxKerningCacheSize = 16
+
+// pairKerning returns the (cached) pair kerning of [ft]
+func (s *SimpleShaper) pairKerning(ft font.Font) *pairKerning {
+	if kern, ok := s.kerns[ft]; ok {
+		return kern
+	}
+	if s.kerns == nil || len(s.kerns) >= maxKerningCacheSize {
+		s.kerns = make(map[font.Font]*pairKerning)
+	}
+	kern := newPairKerning(ft)
+	s.kerns[ft] = kern
+	return kern
+}
+
+var tagKern = loader.MustNewTag("kern")
+
+// pairKerning stores the kerning pairs of a font, either
+// as GPOS lookups or as 'kern' subtables.
+type pairKerning struct {
+	// lookups are the subtables of the GPOS lookups
+	// used by the 'kern' feature, in lookup order
+	lookups [][]tables.PairPos
+	// kern are the horizontal subtables of the 'kern' table,
+	// only used when there is no GPOS kerning
+	kern []otFont.SimpleKerns
+}
+
+func newPairKerning(ft font.Font) *pairKerning {
+	var out pairKerning
+	// collect the lookups of all the 'kern' features
+	var lookupIndices []uint16
+	for _, feature := range ft.GPOS.Features {
+		if feature.Tag != tagKern {
+			continue
+		}
+		for _, index := range feature.LookupListIndices {
+			if !containsUint16(lookupIndices, index) {
+				lookupIndices = append(lookupIndices, index)
+			}
+		}
+	}
+	sortUint16(lookupIndices)
+	for _, index := range lookupIndices {
+		if int(index) >= len(ft.GPOS.Lookups) {
+			continue
+		}
+		var pairs []tables.PairPos
+		for _, subtable := range ft.GPOS.Lookups[index].Subtables {
+			if pair, ok := subtable.(tables.PairPos); ok {
+				pairs = append(pairs, pair)
+			}
+		}
+		if len(pairs) != 0 {
+			out.lookups = append(out.lookups, pairs)
+		}
+	}
+	if len(out.lookups) != 0 {
+		return &out
+	}
+
+	for _, subtable := range ft.Kern {
+		if !subtable.IsHorizontal() || subtable.IsCrossStream() {
+			continue
+		}
+		if kerns, ok := subtable.Data.(otFont.SimpleKerns); ok {
+			out.kern = append(out.kern, kerns)
+		}
+	}
+	return &out
+}
+
+// apply adjusts the positions of the horizontal, logically ordered [glyphs]
+func (pk *pairKerning) apply(glyphs []Glyph, scale api.Scale) {
+	if len(glyphs) < 2 {
+		return
+	}
+	for _, kern := range pk.kern {
+		for i := 0; i+1 < len(glyphs); i++ {
+			if v := kern.KernPair(glyphs[i].GlyphID, glyphs[i+1].GlyphID); v != 0 {
+				glyphs[i].XAdvance += scaleKerning(scale, v)
+			}
+		}
+	}
+	for _, lookup := range pk.lookups {
+		for i := 0; i+1 < len(glyphs); i++ {
+			first, second := &glyphs[i], &glyphs[i+1]
+			for _, subtable := range lookup {
+				f1, f2, v1, v2, ok := pairValues(subtable, first.GlyphID, second.GlyphID)
+				if !ok {
+					continue
+				}
+				applyValueRecord(first, f1, v1, scale)
+				applyValueRecord(second, f2, v2, scale)
+				if f2 != 0 { // the second glyph can't start a pair
+					i++
+				}
+				break
+			}
+		}
+	}
+}
+
+// pairValues returns the adjustments of the pair ([first], [second]),
+// or false if it is not covered by [subtable].
+func pairValues(subtable tables.PairPos, first, second font.GID) (f1, f2 tables.ValueFormat, v1, v2 tables.ValueRecord, ok bool) {
+	index, covered := subtable.Cov().Index(tables.GlyphID(first))
+	if !covered {
+		return 0, 0, v1, v2, false
+	}
+	switch data := subtable.Data.(type) {
+	case tables.PairPosData1:
+		if index >= len(data.PairSets) {
+			return 0, 0, v1, v2, false
+		}
+		record := data.PairSets[index].FindGlyph(tables.GlyphID(second))
+		if record == nil {
+			return 0, 0, v1, v2, false
+		}
+		return data.ValueFormat1, data.ValueFormat2, record.ValueRecord1, record.ValueRecord2, true
+	case tables.PairPosData2:
+		class1, _ := data.ClassDef1.Class(tables.GlyphID(first))
+		class2, _ := data.ClassDef2.Class(tables.GlyphID(second))
+		record := data.Record(class1, class2)
+		return data.ValueFormat1, data.ValueFormat2, record.ValueRecord1, record.ValueRecord2, true
+	}
+	return 0, 0, v1, v2, false
+}
+
+// applyValueRecord applies the horizontal adjustments of [v] to [g]
+func applyValueRecord(g *Glyph, format tables.ValueFormat, v tables.ValueRecord, scale api.Scale) {
+	if format&tables.XPlacement != 0 {
+		g.XOffset += scaleKerning(scale, v.XPlacement)
+	}
+	if format&tables.YPlacement != 0 {
+		g.YOffset += scaleKerning(scale, v.YPlacement)
+	}
+	if format&tables.XAdvance != 0 {
+		g.XAdvance += scaleKerning(scale, v.XAdvance)
+	}
+}
+
+// scaleKerning converts the kerning adjustment [v] from font units to pixels,
+// truncating as harfbuzz does for GPOS values.
+func scaleKerning(scale api.Scale, v int16) fixed.Int26_6 {
+	return fixed.Int26_6(int32(v) * int32(scale.Ppem*64) / int32(scale.Upem))
+}
+
+func containsUint16(values []uint16, v uint16) bool {
+	for _, w := range values {
+		if v == w {
+			return true
+		}
+	}
+	return false
+}
+
+func sortUint16(values []uint16) {
+	for i := 1; i < len(values); i++ {
+		for j := i; j > 0 && values[j] < values[j-1]; j-- {
+			values[j], values[j-1] = values[j-1], values[j]
+		}
+	}
+}
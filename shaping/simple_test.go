@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestSimpleShaperMatchesHarfbuzz(t *testing.T) {
+	var (
+		simple SimpleShaper
+		hb     HarfbuzzShaper
+	)
+	roboto := loadOpentypeFont(t, "../font/testdata/Roboto-Regular.ttf")
+	for _, test := range []struct {
+		text string
+		dir  di.Direction
+		face font.Face
+	}{
+		{"AVATAR Tower, WAVE Yo.", di.DirectionLTR, benchEnFace},
+		{"Hello world", di.DirectionLTR, benchEnFace},
+		{"Hello world", di.DirectionRTL, benchEnFace},
+		{"AVATAR Tower, WAVE Yo.", di.DirectionLTR, roboto}, // with GPOS kerning
+	} {
+		text := []rune(test.text)
+		input := Input{
+			Text: text, RunEnd: len(text),
+			Direction: test.dir,
+			Face:      test.face,
+			Size:      fixed.I(16),
+			Script:    language.Latin,
+			Language:  language.NewLanguage("en"),
+		}
+		got, expected := simple.Shape(input), hb.Shape(input)
+		if len(got.Glyphs) != len(expected.Glyphs) {
+			t.Fatalf("%q: expected %d glyphs, got %d", test.text, len(expected.Glyphs), len(got.Glyphs))
+		}
+		for i, g := range got.Glyphs {
+			exp := expected.Glyphs[i]
+			exp.Mask = 0 // the simple shaper does not report glyph flags
+			if g != exp {
+				t.Errorf("%q: glyph %d: expected %v, got %v", test.text, i, exp, g)
+			}
+		}
+		if got.Advance != expected.Advance || got.LineBounds != expected.LineBounds || got.GlyphBounds != expected.GlyphBounds {
+			t.Errorf("%q: unexpected metrics", test.text)
+		}
+		if got.Runes != expected.Runes {
+			t.Errorf("%q: expected runes %v, got %v", test.text, expected.Runes, got.Runes)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+// Span is a range of a paragraph sharing the same style.
+// The zero values of the style fields select the style of the paragraph.
+type Span struct {
+	// Start and End are the (rune) indices of the span
+	// in the paragraph text.
+	Start, End int
+
+	Face     font.Face
+	Size     fixed.Int26_6
+	Language language.Language
+	// FontFeatures are applied after the ones of the paragraph.
+	FontFeatures []FontFeature
+	// LetterSpacing is added after each glyph cluster
+	// (see [Output.AddLetterSpacing]).
+	LetterSpacing fixed.Int26_6
+}
+
+// ShapeSpans shapes a paragraph made of styled spans : [paragraph] provides the
+// text, the direction and the default style, and [spans], which must be sorted and
+// must not overlap, override it on some ranges of paragraph.Text[RunStart:RunEnd].
+// Each span is also split by script (see [SplitByScript]).
+//
+// The whole paragraph text is provided as context to the shaper, so that, for instance,
+// Arabic letters are joined across spans. The returned runs are in logical
+// order, as expected by [LineWrapper].
+func ShapeSpans(shaper Shaper, paragraph Input, spans []Span) []Output {
+	var out []Output
+	shapeRange := func(start, end int, span Span) {
+		if start >= end {
+			return
+		}
+		input := paragraph
+		input.RunStart, input.RunEnd = start, end
+		if span.Face != nil {
+			input.Face = span.Face
+		}
+		if span.Size != 0 {
+			input.Size = span.Size
+		}
+		if span.Language != "" {
+			input.Language = span.Language
+		}
+		if len(span.FontFeatures) != 0 {
+			input.FontFeatures = append(append([]FontFeature(nil), paragraph.FontFeatures...), span.FontFeatures...)
+		}
+		for _, item := range SplitByScript(input) {
+			run := shaper.Shape(item)
+			if span.LetterSpacing != 0 {
+				run.AddLetterSpacing(span.LetterSpacing)
+			}
+			out = append(out, run)
+		}
+	}
+
+	pos := paragraph.RunStart
+	for _, span := range spans {
+		start := clamp(span.Start, pos, paragraph.RunEnd)
+		end := clamp(span.End, start, paragraph.RunEnd)
+		// runes between spans use the paragraph style
+		shapeRange(pos, start, Span{})
+		shapeRange(start, end, span)
+		pos = end
+	}
+	shapeRange(pos, paragraph.RunEnd, Span{})
+	return out
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/loader"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestShapeSpans(t *testing.T) {
+	text := []rune("Hello bold world")
+	paragraph := Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(12),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	spans := []Span{{Start: 6, End: 10, Size: fixed.I(20), LetterSpacing: fixed.I(1)}}
+	var shaper HarfbuzzShaper
+	runs := ShapeSpans(&shaper, paragraph, spans)
+	if len(runs) != 3 {
+		t.Fatalf("expected 3 runs, got %d", len(runs))
+	}
+	expectedRanges := []Range{{0, 6}, {6, 4}, {10, 6}}
+	for i, run := range runs {
+		if run.Runes != expectedRanges[i] {
+			t.Errorf("run %d: expected runes %v, got %v", i, expectedRanges[i], run.Runes)
+		}
+	}
+	if runs[0].Size != fixed.I(12) || runs[1].Size != fixed.I(20) || runs[2].Size != fixed.I(12) {
+		t.Errorf("unexpected sizes")
+	}
+
+	bold := paragraph
+	bold.RunStart, bold.RunEnd, bold.Size = 6, 10, fixed.I(20)
+	if expected := shaper.Shape(bold).Advance + 4*fixed.I(1); runs[1].Advance != expected {
+		t.Errorf("expected advance %s, got %s", expected, runs[1].Advance)
+	}
+
+	// the runs may be wrapped
+	var wrapper LineWrapper
+	lines, _ := wrapper.WrapParagraph(WrapConfig{}, 60, text, runs...)
+	if len(lines) < 2 {
+		t.Errorf("expected several lines, got %d", len(lines))
+	}
+
+	// without spans, the text is shaped as a whole
+	if runs := ShapeSpans(&shaper, paragraph, nil); len(runs) != 1 || runs[0].Advance != shaper.Shape(paragraph).Advance {
+		t.Errorf("unexpected runs %v", runs)
+	}
+}
+
+func TestFontFeatures(t *testing.T) {
+	text := []rune("سلام")
+	input := Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionRTL,
+		Face:      benchArFace,
+		Size:      fixed.I(12),
+		Script:    language.Arabic,
+		Language:  language.NewLanguage("ar"),
+	}
+	var shaper HarfbuzzShaper
+	joined := shaper.Shape(input)
+	input.FontFeatures = []FontFeature{
+		{Tag: loader.MustNewTag("init"), Value: 0},
+		{Tag: loader.MustNewTag("medi"), Value: 0},
+		{Tag: loader.MustNewTag("fina"), Value: 0},
+	}
+	isolated := shaper.Shape(input)
+	if len(joined.Glyphs) != len(isolated.Glyphs) {
+		t.Fatalf("unexpected glyphs %v %v", joined.Glyphs, isolated.Glyphs)
+	}
+	same := true
+	for i := range joined.Glyphs {
+		same = same && joined.Glyphs[i].GlyphID == isolated.Glyphs[i].GlyphID
+	}
+	if same {
+		t.Error("expected the features to be disabled")
+	}
+}
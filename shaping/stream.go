@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import "io"
+
+// DefaultMaxChunkRunes is the chunk size used by [StreamWrapper]
+// when [StreamConfig.MaxChunkRunes] is zero.
+const DefaultMaxChunkRunes = 4096
+
+// StreamConfig configures the layout of a document by [StreamWrapper].
+type StreamConfig struct {
+	// Input is the template used to shape the text : its Text, RunStart and RunEnd
+	// fields are ignored, and its Script field is only used for text
+	// without script specific runes (see [SplitByScript]).
+	// Bidirectional text is not supported : Input.Direction is used for the whole document.
+	Input Input
+	// Faces, if not nil, selects the face used for each rune (see [SplitByFace]).
+	// Otherwise, Input.Face is used.
+	Faces Fontmap
+	// Wrap are the line wrapping settings applied to each chunk.
+	// Truncation is not supported, and TruncateAfterLines is ignored : the
+	// layout may be stopped from the line callback instead.
+	Wrap WrapConfig
+	// MaxWidth is the width available for the lines.
+	MaxWidth int
+	// MaxChunkRunes is the maximum number of runes shaped at once.
+	// Paragraphs longer than this limit are split, after the last white space
+	// of the chunk if any, which may produce a shorter line at the end of
+	// the chunk. A zero value means [DefaultMaxChunkRunes].
+	MaxChunkRunes int
+}
+
+// LineFunc is called with each line of a document, in order.
+// [chunk] is the text of the current chunk, to which the Runes fields of [line]
+// are relative, and [offset] is the index (in runes) of the start of the chunk
+// in the document.
+// [line] and [chunk] are only valid until the function returns.
+// Returning false stops the layout.
+type LineFunc func(line Line, chunk []rune, offset int) bool
+
+// StreamWrapper shapes and wraps long documents, by chunks of
+// bounded size cut at paragraph boundaries, so that the memory used
+// does not depend on the document length.
+//
+// Reusing a StreamWrapper for multiple documents should improve performance.
+type StreamWrapper struct {
+	// Shaper is used to shape the chunks. If nil, a [HarfbuzzShaper] is used.
+	Shaper Shaper
+
+	wrapper LineWrapper
+	// chunk is the storage for the text of the current chunk
+	chunk []rune
+	// runs is the storage for the shaped items of the current chunk
+	runs []Output
+}
+
+// isParagraphSeparator returns true for the runes ending a paragraph.
+func isParagraphSeparator(r rune) bool {
+	return r == '\n' || r == '\r' || r == '\u0085' || r == '\u2029'
+}
+
+// Layout reads the document from [text], and calls [emit] for each line.
+// Paragraph separators are not included in the chunks, and a CRLF sequence
+// is a single separator. An empty paragraph produces one line without glyphs,
+// except at the end of the document.
+// An error is returned if reading [text] fails with an error other than [io.EOF].
+func (sw *StreamWrapper) Layout(config StreamConfig, text io.RuneReader, emit LineFunc) error {
+	if sw.Shaper == nil {
+		sw.Shaper = &HarfbuzzShaper{}
+	}
+	maxRunes := config.MaxChunkRunes
+	if maxRunes <= 0 {
+		maxRunes = DefaultMaxChunkRunes
+	}
+	config.Wrap.TruncateAfterLines = 0
+
+	sw.chunk = sw.chunk[:0]
+	offset := 0 // of the current chunk in the document
+	afterCR := false
+	for {
+		r, _, err := text.ReadRune()
+		if err == io.EOF {
+			if len(sw.chunk) != 0 {
+				sw.layoutChunk(config, offset, emit)
+			}
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if r == '\n' && afterCR && len(sw.chunk) == 0 {
+			// CRLF ends one paragraph only
+			afterCR = false
+			offset++
+			continue
+		}
+		afterCR = r == '\r'
+
+		if isParagraphSeparator(r) {
+			if !sw.layoutChunk(config, offset, emit) {
+				return nil
+			}
+			offset += len(sw.chunk) + 1
+			sw.chunk = sw.chunk[:0]
+			continue
+		}
+
+		sw.chunk = append(sw.chunk, r)
+		if len(sw.chunk) < maxRunes {
+			continue
+		}
+
+		// the paragraph is too long : cut it after the last space
+		cut := len(sw.chunk)
+		for i := len(sw.chunk); i > 0; i-- {
+			if isBreakingSpace(sw.chunk[i-1]) {
+				cut = i
+				break
+			}
+		}
+		full := sw.chunk
+		sw.chunk = full[:cut]
+		if !sw.layoutChunk(config, offset, emit) {
+			return nil
+		}
+		offset += cut
+		// the remainder starts the next chunk
+		sw.chunk = full[:copy(full, full[cut:])]
+	}
+}
+
+// layoutChunk shapes and wraps the current chunk, returning false
+// if [emit] stopped the layout.
+func (sw *StreamWrapper) layoutChunk(config StreamConfig, offset int, emit LineFunc) bool {
+	input := config.Input
+	input.Text = sw.chunk
+	input.RunStart, input.RunEnd = 0, len(sw.chunk)
+
+	sw.runs = appendShapedItems(sw.runs[:0], sw.Shaper, input, config.Faces)
+
+	sw.wrapper.Prepare(config.Wrap, sw.chunk, sw.runs...)
+	for done := false; !done; {
+		var line Line
+		line, _, done = sw.wrapper.WrapNextLine(config.MaxWidth)
+		if !emit(line, sw.chunk, offset) {
+			return false
+		}
+	}
+	return true
+}
+
+// appendShapedItems splits the text of [input] by face (if [faces] is not nil)
+// and script, shapes the items and appends them to [dst].
+func appendShapedItems(dst []Output, shaper Shaper, input Input, faces Fontmap) []Output {
+	if input.RunStart == input.RunEnd {
+		if faces != nil && input.Face == nil {
+			// use the face of the paragraph separator for empty lines
+			input.Face = faces.ResolveFace('\n')
+		}
+		return append(dst, shaper.Shape(input))
+	}
+	items := []Input{input}
+	if faces != nil {
+		items = SplitByFace(input, faces)
+	}
+	for _, item := range items {
+		for _, run := range SplitByScript(item) {
+			dst = append(dst, shaper.Shape(run))
+		}
+	}
+	return dst
+}
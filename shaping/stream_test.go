@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestStreamWrapper(t *testing.T) {
+	config := StreamConfig{
+		Input: Input{
+			Direction: di.DirectionLTR,
+			Face:      benchEnFace,
+			Size:      fixed.I(16),
+			Script:    language.Latin,
+			Language:  language.NewLanguage("en"),
+		},
+		MaxWidth: 100,
+	}
+	paragraphs := []string{
+		"The quick brown fox jumps over the lazy dog, again and again.",
+		"",
+		"Short.",
+		"Lorem ipsum dolor sit amet, consectetur adipiscing elit.",
+	}
+	document := strings.Join(paragraphs[:2], "\n") + "\r\n" + strings.Join(paragraphs[2:], " ")
+
+	// reference : wrap each paragraph separately
+	var (
+		shaper   HarfbuzzShaper
+		wrapper  LineWrapper
+		expected []string
+	)
+	for _, paragraph := range paragraphs {
+		text := []rune(paragraph)
+		input := config.Input
+		input.Text, input.RunStart, input.RunEnd = text, 0, len(text)
+		lines, _ := wrapper.WrapParagraph(config.Wrap, config.MaxWidth, text, shaper.Shape(input))
+		for _, line := range lines {
+			expected = append(expected, lineText(line, text))
+		}
+	}
+
+	var (
+		sw  StreamWrapper
+		got []string
+	)
+	err := sw.Layout(config, strings.NewReader(document), func(line Line, chunk []rune, offset int) bool {
+		text := lineText(line, chunk)
+		if string([]rune(document)[offset+line[0].Runes.Offset:][:len([]rune(text))]) != text {
+			t.Errorf("invalid offset %d for line %q", offset, text)
+		}
+		got = append(got, text)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, "|") != strings.Join(expected, "|") {
+		t.Fatalf("expected lines %q, got %q", expected, got)
+	}
+
+	// stop after two lines
+	got = got[:0]
+	sw.Layout(config, strings.NewReader(document), func(line Line, chunk []rune, offset int) bool {
+		got = append(got, lineText(line, chunk))
+		return len(got) < 2
+	})
+	if len(got) != 2 {
+		t.Fatalf("expected 2 lines, got %q", got)
+	}
+
+	// long paragraphs are split in chunks, after spaces
+	config.MaxChunkRunes = 20
+	var chunks []int
+	sw.Layout(config, strings.NewReader(paragraphs[0]), func(line Line, chunk []rune, offset int) bool {
+		if len(chunk) > config.MaxChunkRunes {
+			t.Errorf("chunk too long: %q", string(chunk))
+		}
+		if len(chunks) == 0 || chunks[len(chunks)-1] != offset {
+			chunks = append(chunks, offset)
+		}
+		return true
+	})
+	if len(chunks) < 3 || chunks[1] != 20 {
+		t.Fatalf("unexpected chunks %v", chunks)
+	}
+}
+
+// lineText returns the text covered by [line]
+func lineText(line Line, text []rune) string {
+	if len(line) == 0 {
+		return ""
+	}
+	start := line[0].Runes.Offset
+	last := line[len(line)-1].Runes
+	return string(text[start : last.Offset+last.Count])
+}
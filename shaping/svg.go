@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/math/fixed"
+)
+
+// glyphOutline returns the outline of the glyph, if any,
+// including the fallback outlines of the SVG and bitmap glyphs.
+func glyphOutline(run *Output, g Glyph) (api.GlyphOutline, bool) {
+	switch data := run.Face.GlyphData(g.GlyphID).(type) {
+	case api.GlyphOutline:
+		return data, true
+	case api.GlyphSVG:
+		return data.Outline, true
+	case api.GlyphBitmap:
+		if data.Outline != nil {
+			return *data.Outline, true
+		}
+	}
+	return api.GlyphOutline{}, false
+}
+
+func fixedToFloat(v fixed.Int26_6) float32 { return float32(v) / 64 }
+
+// AppendSVGPath appends to [dst] the SVG path data (the content of the "d" attribute)
+// of the outlines of the glyphs of [l], and returns the extended slice.
+//
+// The runs are drawn one after the other, in the order of [l] (which should
+// be the visual order), starting at the origin, which is on the baseline.
+// Before applying [opts], the coordinates are expressed in pixels (as the other metrics
+// of [Output]), and the Y axis increases up : use [api.SVGPathOptions.FlipY] to obtain
+// upright glyphs in the SVG coordinate system.
+// Glyphs without outlines (like bitmap only glyphs) are ignored.
+func (l Line) AppendSVGPath(dst []byte, opts api.SVGPathOptions) []byte {
+	userTransform := opts.Combined()
+	var penX, penY fixed.Int26_6
+	for i := range l {
+		run := &l[i]
+		if run.Face == nil {
+			continue
+		}
+		scale := api.Scale{Upem: run.Face.Upem(), Ppem: fixedToFloat(run.Size)}.Factor()
+		for _, g := range run.Glyphs {
+			if outline, ok := glyphOutline(run, g); ok {
+				tr := userTransform.Mul(glyphTransform(scale, g, penX, penY))
+				dst = outline.AppendSVGPath(dst, api.SVGPathOptions{Transform: &tr})
+			}
+			penX += g.XAdvance
+			penY += g.YAdvance
+		}
+	}
+	return dst
+}
+
+// SVGPath returns the SVG path data of the outlines of the glyphs of [l].
+// See [Line.AppendSVGPath] for more details.
+func (l Line) SVGPath(opts api.SVGPathOptions) string {
+	return string(l.AppendSVGPath(nil, opts))
+}
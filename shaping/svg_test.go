@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/api"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestLineSVGPath(t *testing.T) {
+	text := []rune("l l")
+	var shaper HarfbuzzShaper
+	run := shaper.Shape(Input{
+		Text: text, RunEnd: len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(int(benchEnFace.Upem())),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	})
+	// the size is chosen so that pixels are font units
+	gid, _ := benchEnFace.NominalGlyph('l')
+	outline := benchEnFace.GlyphData(gid).(api.GlyphOutline)
+	path := Line{run}.SVGPath(api.SVGPathOptions{})
+
+	// the first glyph is at the origin
+	first := outline.SVGPath(api.SVGPathOptions{})
+	if !strings.HasPrefix(path, first) {
+		t.Fatalf("expected %s to start with %s", path, first)
+	}
+	// the second one is shifted, the space has no outline
+	shift := fixedToFloat(run.Glyphs[0].XAdvance + run.Glyphs[1].XAdvance)
+	second := outline.SVGPath(api.SVGPathOptions{Transform: &api.Transform{A: 1, D: 1, E: shift}})
+	if path != first+second {
+		t.Errorf("expected %s, got %s", first+second, path)
+	}
+
+	// flipping Y
+	flipped := Line{run}.SVGPath(api.SVGPathOptions{FlipY: true})
+	if expected := outline.SVGPath(api.SVGPathOptions{FlipY: true}); !strings.HasPrefix(flipped, expected) {
+		t.Errorf("expected %s to start with %s", flipped, expected)
+	}
+}
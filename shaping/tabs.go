@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"github.com/go-text/typesetting/opentype/loader"
+	"golang.org/x/image/math/fixed"
+)
+
+// TabularNumbers is the 'tnum' feature, which selects digits with
+// the same advance (when supported by the font) : it should be added
+// to the FontFeatures of the cells aligned in columns.
+var TabularNumbers = FontFeature{Tag: loader.MustNewTag("tnum"), Value: 1}
+
+// TabAlignment specifies how a cell is placed relatively to its tab stop.
+type TabAlignment uint8
+
+const (
+	// TabLeft places the start of the cell at the stop.
+	TabLeft TabAlignment = iota
+	// TabRight places the end of the cell at the stop.
+	TabRight
+	// TabCenter centers the cell on the stop.
+	TabCenter
+	// TabDecimal places the decimal separator of the cell at the stop.
+	// Cells without separator are placed as if it followed their text,
+	// so that integers are aligned on their units.
+	TabDecimal
+)
+
+// TabStop is a horizontal position on which the cells of a column are aligned.
+type TabStop struct {
+	// Position is the distance from the start of the row.
+	Position fixed.Int26_6
+	Align    TabAlignment
+	// Decimal is the separator used by [TabDecimal] ; '.' is used if it is zero.
+	Decimal rune
+}
+
+// Cell is a shaped cell of a row, made of one horizontal, left-to-right run.
+type Cell struct {
+	// Text is the text used to shape [Run], to which its Runes field
+	// refer. It is only required for [TabDecimal].
+	Text []rune
+	Run  Output
+}
+
+// anchor returns the advance between the start of the cell and its alignment point.
+func (c Cell) anchor(stop TabStop) fixed.Int26_6 {
+	switch stop.Align {
+	case TabRight:
+		return c.Run.Advance
+	case TabCenter:
+		return c.Run.Advance / 2
+	case TabDecimal:
+		return c.decimalAdvance(stop.Decimal)
+	default:
+		return 0
+	}
+}
+
+// decimalAdvance returns the advance before the first [decimal] separator,
+// or the whole advance.
+func (c Cell) decimalAdvance(decimal rune) fixed.Int26_6 {
+	if decimal == 0 {
+		decimal = '.'
+	}
+	separator := -1
+	for i := c.Run.Runes.Offset; i < c.Run.Runes.Offset+c.Run.Runes.Count && i < len(c.Text); i++ {
+		if c.Text[i] == decimal {
+			separator = i
+			break
+		}
+	}
+	var advance fixed.Int26_6
+	for _, g := range c.Run.Glyphs {
+		if g.ClusterIndex == separator {
+			break
+		}
+		advance += g.XAdvance
+	}
+	return advance
+}
+
+// LayoutRow returns the horizontal positions of the cells of [row],
+// relative to the start of the row : the cell i is aligned on [stops][i].
+// A cell never overlaps the previous one : it is moved after it if needed.
+// The cells without stop are placed right after the previous one.
+func LayoutRow(row []Cell, stops []TabStop) []fixed.Int26_6 {
+	out := make([]fixed.Int26_6, len(row))
+	var pen fixed.Int26_6 // end of the previous cell
+	for i, cell := range row {
+		x := pen
+		if i < len(stops) {
+			x = stops[i].Position - cell.anchor(stops[i])
+			if x < pen {
+				x = pen
+			}
+		}
+		out[i] = x
+		pen = x + cell.Run.Advance
+	}
+	return out
+}
+
+// ColumnStops computes the tab stops for the columns of [rows], so that
+// the cells of each column are aligned (as specified by [aligns]) and do
+// not overlap : the widest cell of a column is separated from the next column by [gap].
+// Missing alignments default to [TabLeft], and the separator for [TabDecimal] is '.'.
+func ColumnStops(rows [][]Cell, aligns []TabAlignment, gap fixed.Int26_6) []TabStop {
+	columns := 0
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	stops := make([]TabStop, columns)
+	var start fixed.Int26_6 // of the current column
+	for j := range stops {
+		stop := TabStop{}
+		if j < len(aligns) {
+			stop.Align = aligns[j]
+		}
+		// the extent of the column, before and after the alignment point
+		var before, after fixed.Int26_6
+		for _, row := range rows {
+			if j >= len(row) {
+				continue
+			}
+			anchor := row[j].anchor(stop)
+			if anchor > before {
+				before = anchor
+			}
+			if a := row[j].Run.Advance - anchor; a > after {
+				after = a
+			}
+		}
+		stop.Position = start + before
+		stops[j] = stop
+		start = stop.Position + after + gap
+	}
+	return stops
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestTabStops(t *testing.T) {
+	var shaper HarfbuzzShaper
+	cell := func(s string) Cell {
+		text := []rune(s)
+		return Cell{Text: text, Run: shaper.Shape(Input{
+			Text:         text,
+			RunStart:     0,
+			RunEnd:       len(text),
+			Direction:    di.DirectionLTR,
+			Face:         benchEnFace,
+			Size:         fixed.I(12),
+			Script:       language.Latin,
+			Language:     language.NewLanguage("en"),
+			FontFeatures: []FontFeature{TabularNumbers},
+		})}
+	}
+	rows := [][]Cell{
+		{cell("Coffee"), cell("3.5"), cell("x2")},
+		{cell("Croissant"), cell("12.25"), cell("x10")},
+		{cell("Water"), cell("1")},
+	}
+	gap := fixed.I(8)
+	stops := ColumnStops(rows, []TabAlignment{TabLeft, TabDecimal, TabRight}, gap)
+	if len(stops) != 3 {
+		t.Fatalf("unexpected stops %v", stops)
+	}
+
+	var decimalX []fixed.Int26_6
+	for _, row := range rows {
+		xs := LayoutRow(row, stops)
+		if xs[0] != 0 {
+			t.Errorf("unexpected position %s", xs[0])
+		}
+		for i := 1; i < len(xs); i++ {
+			if xs[i] < xs[i-1]+row[i-1].Run.Advance {
+				t.Errorf("overlapping cells")
+			}
+		}
+		// decimal separators are aligned
+		decimalX = append(decimalX, xs[1]+row[1].decimalAdvance('.'))
+		if len(row) == 3 && xs[2]+row[2].Run.Advance != stops[2].Position {
+			t.Errorf("unexpected right edge %s", xs[2]+row[2].Run.Advance)
+		}
+	}
+	if decimalX[0] != decimalX[1] || decimalX[1] != decimalX[2] || decimalX[0] != stops[1].Position {
+		t.Errorf("decimal separators are not aligned: %v", decimalX)
+	}
+	// the first column is as wide as its widest cell
+	if stops[1].Position-rows[1][1].decimalAdvance('.') != rows[1][0].Run.Advance+gap {
+		t.Errorf("unexpected second column %s", stops[1].Position)
+	}
+
+	// cells are moved to avoid overlaps
+	xs := LayoutRow([]Cell{cell("A very long cell"), cell("1")}, []TabStop{{}, {Position: fixed.I(10)}})
+	if xs[1] <= fixed.I(10) {
+		t.Errorf("unexpected position %s", xs[1])
+	}
+}
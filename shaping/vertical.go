@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"unicode"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/harfbuzz"
+	"github.com/go-text/typesetting/language"
+	"github.com/go-text/typesetting/opentype/loader"
+	"github.com/go-text/typesetting/opentype/tables"
+	"github.com/go-text/typesetting/unicodedata"
+)
+
+// TextOrientation selects the orientation of the glyphs of vertical text,
+// similar to the CSS 'text-orientation' property.
+type TextOrientation uint8
+
+const (
+	// TextOrientationMixed keeps upright the runes which are upright
+	// in vertical text, according to their Vertical_Orientation property (see [unicodedata.LookupVerticalOrientation]),
+	// and rotates the other ones, typically Latin letters and digits.
+	// Runes which have a vertical alternate form only when it is provided
+	// by the font (like brackets) are upright if the font does provide it.
+	TextOrientationMixed TextOrientation = iota
+	// TextOrientationUpright keeps all the glyphs upright.
+	TextOrientationUpright
+	// TextOrientationSideways rotates all the glyphs,
+	// which are shaped as horizontal text.
+	TextOrientationSideways
+)
+
+// ShapeVertical shapes the top to bottom [input], keeping its glyphs
+// upright or rotating them according to [orientation].
+//
+// The upright segments of the run are shaped vertically, and the sideways
+// ones are shaped horizontally (in the natural direction of input.Script),
+// and then rotated : their glyphs have the Sideways field set, and
+// their advances, offsets and extents are converted to the vertical layout,
+// with the horizontal baseline centered on the vertical line.
+//
+// Other directions are shaped with upright glyphs.
+func ShapeVertical(shaper Shaper, input Input, orientation TextOrientation) Output {
+	if input.Direction != di.DirectionTTB || orientation == TextOrientationUpright {
+		return shaper.Shape(input)
+	}
+
+	start, end := input.RunStart, input.RunEnd
+	if end < start {
+		end, start = start, end
+	}
+	start = clamp(start, 0, len(input.Text))
+	end = clamp(end, 0, len(input.Text))
+
+	var (
+		vert       *verticalAlternates // lazily computed
+		out        Output
+		isFirst    = true
+		segStart   = start
+		segUpright bool
+	)
+	upright := func(r rune) bool {
+		if orientation == TextOrientationSideways {
+			return false
+		}
+		switch unicodedata.LookupVerticalOrientation(r) {
+		case unicodedata.VerticalUpright, unicodedata.VerticalTransformedUpright:
+			return true
+		case unicodedata.VerticalTransformedRotated:
+			if vert == nil {
+				vert = newVerticalAlternates(input.Face)
+			}
+			gid, ok := input.Face.NominalGlyph(r)
+			return ok && vert.has(gid)
+		default:
+			return false
+		}
+	}
+	flush := func(segEnd int) {
+		segment := input
+		segment.RunStart, segment.RunEnd = segStart, segEnd
+		var shaped Output
+		if segUpright {
+			shaped = shaper.Shape(segment)
+		} else {
+			segment.Direction = ResolveScriptLayout(input.Script, di.Horizontal).Direction
+			shaped = shaper.Shape(segment)
+			rotateSideways(&shaped)
+		}
+		if isFirst {
+			out = shaped
+			isFirst = false
+			return
+		}
+		out.Glyphs = append(out.Glyphs, shaped.Glyphs...)
+		out.LineBounds.Ascent = max26_6(out.LineBounds.Ascent, shaped.LineBounds.Ascent)
+		out.LineBounds.Descent = -max26_6(-out.LineBounds.Descent, -shaped.LineBounds.Descent)
+		out.LineBounds.Gap = max26_6(out.LineBounds.Gap, shaped.LineBounds.Gap)
+	}
+
+	for i := start; i < end; i++ {
+		r := input.Text[i]
+		if i != start && inheritsOrientation(r) {
+			continue
+		}
+		u := upright(r)
+		if i == start {
+			segUpright = u
+		} else if u != segUpright {
+			flush(i)
+			segStart, segUpright = i, u
+		}
+	}
+	flush(end)
+
+	out.Direction = input.Direction
+	out.Runes = Range{Offset: start, Count: end - start}
+	out.RecalculateAll()
+	return out
+}
+
+// inheritsOrientation returns true for the runes which
+// use the orientation of the previous rune
+func inheritsOrientation(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me) || r == 0x200D || isVariationSelector(r)
+}
+
+// rotateSideways converts the horizontal run [o] to a vertical run,
+// whose glyphs are rotated by 90 degrees clockwise.
+func rotateSideways(o *Output) {
+	// center the line on the vertical axis
+	center := (o.LineBounds.Ascent + o.LineBounds.Descent) / 2
+	for i := range o.Glyphs {
+		g := &o.Glyphs[i]
+		// a rotation of (x, y) (with Y up) is (y, -x)
+		*g = Glyph{
+			XBearing:     g.YBearing + g.Height,
+			YBearing:     -g.XBearing,
+			Width:        -g.Height,
+			Height:       -g.Width,
+			YAdvance:     -g.XAdvance,
+			XOffset:      g.YOffset - center,
+			YOffset:      -g.XOffset,
+			ClusterIndex: g.ClusterIndex,
+			RuneCount:    g.RuneCount,
+			GlyphCount:   g.GlyphCount,
+			GlyphID:      g.GlyphID,
+			Mask:         g.Mask,
+			Color:        g.Color,
+			Sideways:     true,
+		}
+	}
+	half := (o.LineBounds.Ascent - o.LineBounds.Descent) / 2
+	o.LineBounds = Bounds{Ascent: half, Descent: -half, Gap: o.LineBounds.Gap}
+}
+
+var (
+	tagVert = loader.MustNewTag("vert")
+	tagVrt2 = loader.MustNewTag("vrt2")
+)
+
+// verticalAlternates stores the single substitutions of
+// the 'vert' and 'vrt2' features of a font.
+type verticalAlternates struct {
+	subtables []tables.SingleSubs
+}
+
+func newVerticalAlternates(face font.Face) *verticalAlternates {
+	var out verticalAlternates
+	gsub := face.GSUB
+	for _, feature := range gsub.Features {
+		if feature.Tag != tagVert && feature.Tag != tagVrt2 {
+			continue
+		}
+		for _, index := range feature.LookupListIndices {
+			if int(index) >= len(gsub.Lookups) {
+				continue
+			}
+			for _, subtable := range gsub.Lookups[index].Subtables {
+				if single, ok := subtable.(tables.SingleSubs); ok {
+					out.subtables = append(out.subtables, single)
+				}
+			}
+		}
+	}
+	return &out
+}
+
+// has returns true if [gid] has a vertical alternate
+func (va *verticalAlternates) has(gid font.GID) bool {
+	for _, subtable := range va.subtables {
+		if _, ok := subtable.Data.Cov().Index(tables.GlyphID(gid)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveScriptLayout returns the layout to use for text written
+// in [script], when displayed along [axis].
+//
+// For the horizontal axis, the text is shaped in the natural direction of
+// the script, with upright glyphs. Scripts written in both directions
+// (like Runic) default to left-to-right, except Old Hungarian.
+//
+// For the vertical axis :
+//   - the scripts natively written vertically with upright glyphs, like Han, Kana or Hangul,
+//     are shaped top to bottom, with lines stacked from right to left.
+//   - Mongolian and Phags-pa, whose fonts are designed for horizontal shaping,
+//     are shaped left to right, rotated clockwise, with lines stacked from left to right.
+//   - Ogham, written from bottom to top, is shaped left to right and rotated counter-clockwise.
+//   - the other scripts are shaped horizontally, rotated clockwise, with lines stacked
+//     from right to left, as with the CSS 'text-orientation: mixed' property.
+func ResolveScriptLayout(script language.Script, axis di.Axis) di.ScriptLayout {
+	horizontal := di.DirectionLTR
+	switch harfbuzz.HorizontalDirection(script) {
+	case harfbuzz.RightToLeft:
+		horizontal = di.DirectionRTL
+	case 0: // both directions
+		if script == language.Old_Hungarian {
+			horizontal = di.DirectionRTL
+		}
+	}
+
+	if axis == di.Horizontal {
+		return di.ScriptLayout{Direction: horizontal, Orientation: di.OrientationUpright, LineProgression: di.FromTopLeft}
+	}
+
+	switch script {
+	case language.Han, language.Hiragana, language.Katakana, language.Bopomofo, language.Hangul,
+		language.Yi, language.Tangut, language.Nushu, language.Khitan_Small_Script:
+		return di.ScriptLayout{Direction: di.DirectionTTB, Orientation: di.OrientationUpright, LineProgression: di.TowardTopLeft}
+	case language.Mongolian, language.Phags_Pa:
+		return di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationSidewaysRight, LineProgression: di.FromTopLeft}
+	case language.Ogham:
+		return di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationSidewaysLeft, LineProgression: di.FromTopLeft}
+	default:
+		return di.ScriptLayout{Direction: horizontal, Orientation: di.OrientationSidewaysRight, LineProgression: di.TowardTopLeft}
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package shaping
+
+import (
+	"testing"
+
+	"github.com/go-text/typesetting/di"
+	"github.com/go-text/typesetting/language"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestShapeVertical(t *testing.T) {
+	var shaper HarfbuzzShaper
+	text := []rune("ab©c±d")
+	input := Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionTTB,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("en"),
+	}
+	horizontal := input
+	horizontal.Direction = di.DirectionLTR
+	hOut := shaper.Shape(horizontal)
+	vOut := shaper.Shape(input)
+
+	// upright : same as regular vertical shaping
+	out := ShapeVertical(&shaper, input, TextOrientationUpright)
+	if out.Advance != vOut.Advance || len(out.Glyphs) != len(vOut.Glyphs) {
+		t.Fatalf("unexpected upright output")
+	}
+
+	// sideways : the horizontal glyphs, rotated
+	out = ShapeVertical(&shaper, input, TextOrientationSideways)
+	if out.Direction != di.DirectionTTB || out.Runes != (Range{Count: len(text)}) {
+		t.Fatalf("unexpected output %v %v", out.Direction, out.Runes)
+	}
+	if len(out.Glyphs) != len(hOut.Glyphs) || out.Advance != -hOut.Advance {
+		t.Fatalf("unexpected sideways output: %d glyphs, advance %v", len(out.Glyphs), out.Advance)
+	}
+	for i, g := range out.Glyphs {
+		h := hOut.Glyphs[i]
+		if !g.Sideways || g.GlyphID != h.GlyphID || g.YAdvance != -h.XAdvance || g.XAdvance != 0 {
+			t.Errorf("glyph %d: unexpected %v", i, g)
+		}
+		if g.Width != -h.Height || g.Height != -h.Width {
+			t.Errorf("glyph %d: unexpected extents %v", i, g)
+		}
+	}
+	if out.LineBounds.Ascent != -out.LineBounds.Descent {
+		t.Errorf("expected centered line bounds, got %v", out.LineBounds)
+	}
+
+	// mixed : © and ± are upright
+	out = ShapeVertical(&shaper, input, TextOrientationMixed)
+	if len(out.Glyphs) != len(text) {
+		t.Fatalf("unexpected number of glyphs %d", len(out.Glyphs))
+	}
+	var advance fixed.Int26_6
+	for i, g := range out.Glyphs {
+		upright := text[i] == '©' || text[i] == '±'
+		if g.Sideways == upright || g.ClusterIndex != i || g.RuneCount != 1 {
+			t.Errorf("glyph %d: unexpected %v", i, g)
+		}
+		if upright && g.YAdvance != vOut.Glyphs[i].YAdvance {
+			t.Errorf("glyph %d: expected vertical advance %v, got %v", i, vOut.Glyphs[i].YAdvance, g.YAdvance)
+		}
+		advance += g.YAdvance
+	}
+	if out.Advance != advance {
+		t.Errorf("expected advance %v, got %v", advance, out.Advance)
+	}
+
+	// horizontal text is not affected
+	out = ShapeVertical(&shaper, horizontal, TextOrientationSideways)
+	if out.Advance != hOut.Advance || out.Glyphs[0].Sideways {
+		t.Errorf("unexpected horizontal output")
+	}
+}
+
+func TestResolveScriptLayout(t *testing.T) {
+	for _, test := range []struct {
+		script   language.Script
+		axis     di.Axis
+		expected di.ScriptLayout
+	}{
+		{language.Latin, di.Horizontal, di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationUpright, LineProgression: di.FromTopLeft}},
+		{language.Arabic, di.Horizontal, di.ScriptLayout{Direction: di.DirectionRTL, Orientation: di.OrientationUpright, LineProgression: di.FromTopLeft}},
+		{language.Mongolian, di.Horizontal, di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationUpright, LineProgression: di.FromTopLeft}},
+		{language.Runic, di.Horizontal, di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationUpright, LineProgression: di.FromTopLeft}},
+		{language.Old_Hungarian, di.Horizontal, di.ScriptLayout{Direction: di.DirectionRTL, Orientation: di.OrientationUpright, LineProgression: di.FromTopLeft}},
+		{language.Han, di.Vertical, di.ScriptLayout{Direction: di.DirectionTTB, Orientation: di.OrientationUpright, LineProgression: di.TowardTopLeft}},
+		{language.Hangul, di.Vertical, di.ScriptLayout{Direction: di.DirectionTTB, Orientation: di.OrientationUpright, LineProgression: di.TowardTopLeft}},
+		{language.Mongolian, di.Vertical, di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationSidewaysRight, LineProgression: di.FromTopLeft}},
+		{language.Phags_Pa, di.Vertical, di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationSidewaysRight, LineProgression: di.FromTopLeft}},
+		{language.Ogham, di.Vertical, di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationSidewaysLeft, LineProgression: di.FromTopLeft}},
+		{language.Latin, di.Vertical, di.ScriptLayout{Direction: di.DirectionLTR, Orientation: di.OrientationSidewaysRight, LineProgression: di.TowardTopLeft}},
+		{language.Hebrew, di.Vertical, di.ScriptLayout{Direction: di.DirectionRTL, Orientation: di.OrientationSidewaysRight, LineProgression: di.TowardTopLeft}},
+	} {
+		if got := ResolveScriptLayout(test.script, test.axis); got != test.expected {
+			t.Errorf("%s (vertical: %v): expected %v, got %v", test.script, test.axis, test.expected, got)
+		}
+	}
+}
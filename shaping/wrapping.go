@@ -2,6 +2,7 @@ package shaping
 
 import (
 	"sort"
+	"unicode/utf8"
 
 	"github.com/go-text/typesetting/di"
 	"github.com/go-text/typesetting/segmenter"
@@ -17,10 +18,12 @@ type glyphIndex = int
 // The indicies are relative to the region of runes covered by the input run.
 // To translate an absolute rune index in text into a rune index into the returned
 // mapping, subtract run.Runes.Offset first. If the provided buf is large enough to
-// hold the return value, it will be used instead of allocating a new slice.
+// hold the return value, it will be used instead of allocating a new slice, so that
+// callers mapping several runs may reuse the same storage.
 func mapRunesToClusterIndices(dir di.Direction, runes Range, glyphs []Glyph, buf []glyphIndex) []glyphIndex {
 	if runes.Count <= 0 {
-		return nil
+		// keep the storage of buf for later calls
+		return buf[:0]
 	}
 	var mapping []glyphIndex
 	if cap(buf) >= runes.Count {
@@ -100,7 +103,8 @@ func mapRuneToClusterIndex(dir di.Direction, runes Range, glyphs []Glyph, runeId
 
 func mapRunesToClusterIndices2(dir di.Direction, runes Range, glyphs []Glyph, buf []glyphIndex) []glyphIndex {
 	if runes.Count <= 0 {
-		return nil
+		// keep the storage of buf for later calls
+		return buf[:0]
 	}
 	var mapping []glyphIndex
 	if cap(buf) >= runes.Count {
@@ -155,7 +159,8 @@ func mapRunesToClusterIndices2(dir di.Direction, runes Range, glyphs []Glyph, bu
 
 func mapRunesToClusterIndices3(dir di.Direction, runes Range, glyphs []Glyph, buf []glyphIndex) []glyphIndex {
 	if runes.Count <= 0 {
-		return nil
+		// keep the storage of buf for later calls
+		return buf[:0]
 	}
 	var mapping []glyphIndex
 	if cap(buf) >= runes.Count {
@@ -220,8 +225,9 @@ func inclusiveGlyphRange(dir di.Direction, start, breakAfter int, runeToGlyph []
 }
 
 // cutRun returns the sub-run of run containing glyphs corresponding to the provided
-// _inclusive_ rune range.
-func cutRun(run Output, mapping []glyphIndex, startRune, endRune int) Output {
+// _inclusive_ rune range. [text] is the text indexed by the Runes field of [run],
+// used to update its Bytes field.
+func cutRun(text []rune, run Output, mapping []glyphIndex, startRune, endRune int) Output {
 	// Convert the rune range of interest into an inclusive range within the
 	// current run's runes.
 	runeStart := startRune - run.Runes.Offset
@@ -241,16 +247,37 @@ func cutRun(run Output, mapping []glyphIndex, startRune, endRune int) Output {
 	// Construct a run out of the inclusive glyph range.
 	run.Glyphs = run.Glyphs[glyphStart : glyphEnd+1]
 	run.RecomputeAdvance()
+	if run.Bytes.Count != 0 {
+		run.Bytes.Offset += utf8Len(text[run.Runes.Offset : run.Runes.Offset+runeStart])
+		run.Bytes.Count = utf8Len(text[run.Runes.Offset+runeStart : run.Runes.Offset+runeEnd+1])
+	}
 	run.Runes.Offset = run.Runes.Offset + runeStart
 	run.Runes.Count = runeEnd - runeStart + 1
 	return run
 }
 
+// utf8Len returns the number of bytes required to encode [text] in UTF-8,
+// where invalid runes are encoded as U+FFFD.
+func utf8Len(text []rune) int {
+	n := 0
+	for _, r := range text {
+		if size := utf8.RuneLen(r); size > 0 {
+			n += size
+		} else {
+			n += utf8.RuneLen(utf8.RuneError)
+		}
+	}
+	return n
+}
+
 // breakOption represets a location within the rune slice at which
 // it may be safe to break a line of text.
 type breakOption struct {
 	// breakAtRune is the index at which it is safe to break.
 	breakAtRune int
+	// kind classifies the break, and may be used
+	// to prefer some breaks over others.
+	kind segmenter.BreakKind
 }
 
 // isValid returns whether a given option violates shaping rules (like breaking
@@ -275,28 +302,28 @@ func (option breakOption) isValid(runeToGlyph []int, out Output) bool {
 
 // breaker generates line breaking candidates for a text.
 type breaker struct {
-	segmenter  *segmenter.LineIterator
+	segmenter  segmenter.BreakIterator
 	totalRunes int
 }
 
 // newBreaker returns a breaker initialized to break the provided text.
-func newBreaker(seg *segmenter.Segmenter, text []rune) *breaker {
+func newBreaker(seg *segmenter.Segmenter, text []rune) breaker {
 	seg.Init(text)
-	br := &breaker{
-		segmenter:  seg.LineIterator(),
+	return breaker{
+		segmenter:  *seg.BreakIterator(),
 		totalRunes: len(text),
 	}
-	return br
 }
 
 // next returns a naive break candidate which may be invalid.
 func (b *breaker) next() (option breakOption, ok bool) {
 	if b.segmenter.Next() {
-		currentSegment := b.segmenter.Line()
-		// Note : we dont use penalties for Mandatory Breaks so far,
-		// we could add it with currentSegment.IsMandatoryBreak
+		currentBreak := b.segmenter.Break()
+		// Note : we dont use penalties so far,
+		// we could add them with currentBreak.Kind
 		option := breakOption{
-			breakAtRune: currentSegment.Offset + len(currentSegment.Text) - 1,
+			breakAtRune: currentBreak.Position - 1,
+			kind:        currentBreak.Kind,
 		}
 		return option, true
 	}
@@ -331,6 +358,40 @@ type WrapConfig struct {
 	// to indicate that further paragraphs of text were truncated. This field has
 	// no effect if TruncateAfterLines is zero.
 	TextContinues bool
+	// Kinsoku selects the East Asian line breaking prohibitions
+	// applied on top of the default Unicode line breaking rules.
+	Kinsoku segmenter.Kinsoku
+	// Strictness selects the line breaking tailoring (similar to
+	// the CSS line-break property) applied to Chinese and Japanese text.
+	// The breaks it allows are still subject to Kinsoku.
+	Strictness segmenter.LineStrictness
+	// WordBreak selects how lines may be broken inside words
+	// (similar to the CSS word-break property).
+	WordBreak segmenter.WordBreakMode
+	// Hyphen, if provided, will be inserted at the end of lines broken
+	// at a soft hyphen (U+00AD), which is otherwise invisible.
+	// The inserted run covers no runes : the soft hyphen itself
+	// stays in the preceding run.
+	Hyphen Output
+	// ComputeLineRects, if true, computes the rectangles of each
+	// wrapped line, which are then returned by [LineWrapper.LineRects].
+	ComputeLineRects bool
+	// Exclusions are areas of the paragraph which reduce the width available
+	// to the lines they overlap. The lines are stacked from the top of the paragraph,
+	// using the height of their logical rectangle (see [Line.Rects]), and
+	// their placements are returned by [LineWrapper.LinePlacements].
+	// Exclusions are only supported for horizontal text.
+	Exclusions []Exclusion
+	// BaselineGrid, if not zero, snaps the baseline of each line to a multiple
+	// of BaselineGrid, measured from the top of the paragraph, by rounding the
+	// line heights up to a multiple of the grid. The resulting positions
+	// are returned by [LineWrapper.LineBaselines].
+	// The baseline grid is only supported for horizontal text.
+	BaselineGrid fixed.Int26_6
+	// Justification, if enabled, stretches the wrapped lines (except the last one)
+	// so that they fill the maximum width. The runs of the justified lines are copies
+	// of the shaped runs, with adjusted advances.
+	Justification Justification
 }
 
 // WithTruncator returns a copy of WrapConfig with the Truncator field set to the
@@ -340,7 +401,19 @@ func (w WrapConfig) WithTruncator(shaper Shaper, input Input) WrapConfig {
 	return w
 }
 
+// WithHyphen returns a copy of WrapConfig with the Hyphen field set to the
+// result of shaping input with shaper.
+func (w WrapConfig) WithHyphen(shaper Shaper, input Input) WrapConfig {
+	w.Hyphen = shaper.Shape(input)
+	return w
+}
+
+const softHyphen = 0x00AD
+
 // runMapper efficiently maps a run to glyph clusters.
+// Its storage is reused between runs and paragraphs, so that
+// wrapping the same text again (for instance when the available
+// width changes) does not allocate new mappings.
 type runMapper struct {
 	// valid indicates that the mapping field is populated.
 	valid bool
@@ -373,7 +446,7 @@ type LineWrapper struct {
 	seg segmenter.Segmenter
 
 	// breaker provides line-breaking candidates.
-	breaker *breaker
+	breaker breaker
 
 	// mapper tracks rune->glyphCluster mappings.
 	mapper runMapper
@@ -392,6 +465,20 @@ type LineWrapper struct {
 	lineStartRune int
 	// more indicates that the iteration API has more data to return.
 	more bool
+	// paragraph is the text being wrapped.
+	paragraph []rune
+	// rects are the rectangles of the wrapped lines,
+	// if config.ComputeLineRects is true
+	rects []LineRects
+	// placements are the placements of the wrapped lines,
+	// if config.Exclusions is not empty
+	placements []LinePlacement
+	// baselines are the snapped baselines of the wrapped lines,
+	// if config.BaselineGrid is not zero
+	baselines []LineBaseline
+	// lineTop is the top of the next line, used with config.Exclusions
+	// and config.BaselineGrid
+	lineTop fixed.Int26_6
 }
 
 // Prepare initializes the LineWrapper for the given paragraph and shaped text.
@@ -399,22 +486,43 @@ type LineWrapper struct {
 func (l *LineWrapper) Prepare(config WrapConfig, paragraph []rune, shapedRuns ...Output) {
 	l.config = config
 	l.truncating = l.config.TruncateAfterLines > 0
+	l.seg.Kinsoku = config.Kinsoku
+	l.seg.Strictness = config.Strictness
+	l.seg.WordBreak = config.WordBreak
 	l.breaker = newBreaker(&l.seg, paragraph)
+	l.paragraph = paragraph
 	l.glyphRuns = shapedRuns
 	l.isUnused = false
 	l.currentRun = 0
 	l.lineStartRune = 0
 	l.more = true
 	l.mapper.valid = false
+	l.rects = l.rects[:0]
+	l.placements = l.placements[:0]
+	l.baselines = l.baselines[:0]
+	l.lineTop = 0
 }
 
+// LineRects returns the rectangles of the lines wrapped since the last call
+// to [LineWrapper.Prepare] (or [LineWrapper.WrapParagraph]), in order, if
+// the ComputeLineRects field of the config is true. See also [Line.Rects].
+//
+// The returned slice is only valid until the next call to Prepare.
+func (l *LineWrapper) LineRects() []LineRects { return l.rects }
+
 // WrapParagraph wraps the paragraph's shaped glyphs to a constant maxWidth.
 // It is equivalent to iteratively invoking WrapLine with a constant maxWidth.
 // If the config has a non-zero TruncateAfterLines, WrapParagraph will return at most
 // that many lines. The truncated return value is the count of runes truncated from
 // the end of the text.
 func (l *LineWrapper) WrapParagraph(config WrapConfig, maxWidth int, paragraph []rune, shapedRuns ...Output) (_ []Line, truncated int) {
-	if len(shapedRuns) == 1 && shapedRuns[0].Advance.Ceil() < maxWidth && !(config.TextContinues && config.TruncateAfterLines == 1) {
+	if len(shapedRuns) == 1 && shapedRuns[0].Advance.Ceil() < maxWidth && !(config.TextContinues && config.TruncateAfterLines == 1) && len(config.Exclusions) == 0 && config.BaselineGrid == 0 {
+		l.rects = l.rects[:0]
+		l.placements = l.placements[:0]
+		l.baselines = l.baselines[:0]
+		if config.ComputeLineRects {
+			l.rects = append(l.rects, Line(shapedRuns).Rects())
+		}
 		return []Line{shapedRuns}, 0
 	}
 	l.Prepare(config, paragraph, shapedRuns...)
@@ -428,6 +536,13 @@ func (l *LineWrapper) WrapParagraph(config WrapConfig, maxWidth int, paragraph [
 	return lines, truncated
 }
 
+// breaksAtSoftHyphen returns true if breaking after the rune at breakAtRune
+// requires inserting the hyphen.
+func (l *LineWrapper) breaksAtSoftHyphen(breakAtRune int) bool {
+	return len(l.config.Hyphen.Glyphs) != 0 && breakAtRune >= 0 && breakAtRune < len(l.paragraph)-1 &&
+		l.paragraph[breakAtRune] == softHyphen
+}
+
 // nextBreakOption returns the next rune offset at which the line can be broken,
 // if any. If it returns false, there are no more candidates.
 func (l *LineWrapper) nextBreakOption() (breakOption, bool) {
@@ -476,7 +591,7 @@ func (l *LineWrapper) fillUntil(option breakOption, startRunIdx int, startWidth
 			// If part of this run has already been used on a previous line, trim
 			// the runes corresponding to those glyphs off.
 			l.mapper.mapRun(startRunIdx, run)
-			run = cutRun(run, l.mapper.mapping, l.lineStartRune, run.Runes.Count+run.Runes.Offset)
+			run = cutRun(l.paragraph, run, l.mapper.mapping, l.lineStartRune, run.Runes.Count+run.Runes.Offset)
 		}
 		// While the run being processed doesn't contain the current line breaking
 		// candidate, just append it to the candidate line.
@@ -498,15 +613,21 @@ func (l *LineWrapper) fillUntil(option breakOption, startRunIdx int, startWidth
 // The truncated return value is the count of runes truncated from the end of the line,
 // if this line was truncated.
 func (l *LineWrapper) WrapNextLine(maxWidth int) (finalLine Line, truncated int, done bool) {
+	wrapping := l.more
+	var placement LinePlacement
+	if wrapping && len(l.config.Exclusions) != 0 && len(l.glyphRuns) != 0 {
+		placement = l.placeLine(maxWidth)
+		maxWidth = placement.Width
+	}
 	defer func() {
 		if len(finalLine) > 0 {
 			finalRun := finalLine[len(finalLine)-1]
 			l.lineStartRune = finalRun.Runes.Count + finalRun.Runes.Offset
 		}
 		done = done || l.lineStartRune >= l.breaker.totalRunes
+		insertTruncator := false
 		if l.truncating {
 			l.config.TruncateAfterLines--
-			insertTruncator := false
 			if l.config.TruncateAfterLines == 0 {
 				done = true
 				truncated = l.breaker.totalRunes - l.lineStartRune
@@ -516,9 +637,34 @@ func (l *LineWrapper) WrapNextLine(maxWidth int) (finalLine Line, truncated int,
 				finalLine = append(finalLine, l.config.Truncator)
 			}
 		}
+		if !insertTruncator && len(finalLine) > 0 && l.breaksAtSoftHyphen(l.lineStartRune-1) {
+			hyphen := l.config.Hyphen
+			hyphen.Runes = Range{Offset: l.lineStartRune}
+			finalLine = append(finalLine, hyphen)
+		}
 		if done {
 			l.more = false
 		}
+		if wrapping && l.config.Justification.Enabled {
+			finalLine = l.justify(finalLine, maxWidth, done)
+		}
+		if wrapping && l.config.ComputeLineRects {
+			l.rects = append(l.rects, finalLine.Rects())
+		}
+		if wrapping && (len(l.config.Exclusions) != 0 || l.config.BaselineGrid != 0) {
+			logical := finalLine.Rects().Logical
+			top, height := l.lineTop, logical.Max.Y-logical.Min.Y
+			if len(l.config.Exclusions) != 0 {
+				l.placements = append(l.placements, placement)
+				top = placement.Top
+			}
+			if l.config.BaselineGrid != 0 {
+				baseline := snapLine(l.config.BaselineGrid, top, logical)
+				l.baselines = append(l.baselines, baseline)
+				top, height = roundUpTo(top, l.config.BaselineGrid), baseline.Height
+			}
+			l.lineTop = top + height
+		}
 	}()
 	if !l.more {
 		return nil, truncated, true
@@ -572,8 +718,16 @@ func (l *LineWrapper) WrapNextLine(maxWidth int) (finalLine Line, truncated int,
 			// Reject invalid line break candidate and acquire a new one.
 			continue
 		}
-		candidateRun := cutRun(run, l.mapper.mapping, l.lineStartRune, option.breakAtRune)
-		candidateLineWidth := (candidateRun.Advance + lineWidth).Ceil()
+		candidateRun := cutRun(l.paragraph, run, l.mapper.mapping, l.lineStartRune, option.breakAtRune)
+		candidateAdvance := candidateRun.Advance + lineWidth
+		if l.breaksAtSoftHyphen(option.breakAtRune) {
+			candidateAdvance += l.config.Hyphen.Advance
+		}
+		candidateLineWidth := candidateAdvance.Ceil()
+		if candidateLineWidth > truncatedMaxWidth {
+			// the justification may shrink the spaces so that the line fits
+			candidateLineWidth = (candidateAdvance - l.shrinkableWidth(lineCandidate, candidateRun)).Ceil()
+		}
 		if candidateLineWidth > maxWidth {
 			// The run doesn't fit on the line.
 			if len(bestCandidate) < 1 {
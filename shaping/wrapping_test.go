@@ -1628,6 +1628,36 @@ func TestWrappingLatinE2E(t *testing.T) {
 	}
 }
 
+func TestWrappingBytes(t *testing.T) {
+	text := "Lorem ipsum dolor sit amet, café élémentaire, sed do eiusmod tempor incididunt ut labore."
+	runes := []rune(text)
+	var shaper HarfbuzzShaper
+	input := Input{Direction: di.DirectionLTR, Face: benchEnFace, Size: 16 * 72, Script: language.Latin}
+	// shape two runs, so that the second one is cut
+	middle := len("Lorem ipsum dolor sit amet, café ")
+	input.RunStart, input.RunEnd = 0, middle
+	first := shaper.ShapeString(input, text)
+	input.RunStart, input.RunEnd = middle, len(text)
+	second := shaper.ShapeString(input, text)
+
+	var l LineWrapper
+	lines, _ := l.WrapParagraph(WrapConfig{}, 150, runes, first, second)
+	if len(lines) < 3 {
+		t.Fatalf("unexpected lines %d", len(lines))
+	}
+	for i, line := range lines {
+		for _, run := range line {
+			expected := Range{
+				Offset: len(string(runes[:run.Runes.Offset])),
+				Count:  len(string(runes[run.Runes.Offset : run.Runes.Offset+run.Runes.Count])),
+			}
+			if run.Bytes != expected {
+				t.Errorf("line %d: expected bytes %v, got %v", i, expected, run.Bytes)
+			}
+		}
+	}
+}
+
 // TestWrappingTruncation checks that the line wrapper's truncation features
 // behave as expected.
 func TestWrappingTruncation(t *testing.T) {
@@ -2004,10 +2034,10 @@ func cutRunInto(run Output, parts int) []Output {
 	runesPerPart := run.Runes.Count / parts
 	partStart := 0
 	for i := 0; i < parts-1; i++ {
-		outs = append(outs, cutRun(run, mapping, partStart, partStart+runesPerPart-1))
+		outs = append(outs, cutRun(nil, run, mapping, partStart, partStart+runesPerPart-1))
 		partStart += runesPerPart
 	}
-	outs = append(outs, cutRun(run, mapping, partStart, run.Runes.Count-1))
+	outs = append(outs, cutRun(nil, run, mapping, partStart, run.Runes.Count-1))
 	return outs
 }
 
@@ -2111,3 +2141,98 @@ func BenchmarkWrappingHappyPath(b *testing.B) {
 const benchParagraphLatin = `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua. Porttitor eget dolor morbi non arcu risus quis. Nibh sit amet commodo nulla. Posuere ac ut consequat semper viverra nam libero justo. Risus in hendrerit gravida rutrum quisque. Natoque penatibus et magnis dis parturient montes nascetur. In metus vulputate eu scelerisque felis imperdiet proin fermentum. Mattis rhoncus urna neque viverra. Elit pellentesque habitant morbi tristique. Nisl nunc mi ipsum faucibus vitae aliquet nec. Sed augue lacus viverra vitae congue eu consequat. At quis risus sed vulputate odio ut. Sit amet volutpat consequat mauris nunc congue nisi. Dignissim cras tincidunt lobortis feugiat. Faucibus turpis in eu mi bibendum. Odio aenean sed adipiscing diam donec adipiscing tristique. Fermentum leo vel orci porta non pulvinar. Ut venenatis tellus in metus vulputate eu scelerisque felis imperdiet. Et netus et malesuada fames ac turpis. Venenatis urna cursus eget nunc scelerisque viverra mauris in. Risus ultricies tristique nulla aliquet enim tortor. Risus pretium quam vulputate dignissim suspendisse in. Interdum velit euismod in pellentesque massa placerat duis ultricies lacus. Proin gravida hendrerit lectus a. Auctor augue mauris augue neque gravida in fermentum et. Laoreet sit amet cursus sit amet dictum. In fermentum et sollicitudin ac orci phasellus egestas tellus rutrum. Tempus imperdiet nulla malesuada pellentesque elit eget gravida. Consequat id porta nibh venenatis cras sed. Vulputate ut pharetra sit amet aliquam. Congue mauris rhoncus aenean vel elit. Risus quis varius quam quisque id diam vel quam elementum. Pretium lectus quam id leo in vitae. Sed sed risus pretium quam vulputate dignissim suspendisse in est. Velit laoreet id donec ultrices. Nunc sed velit dignissim sodales ut. Nunc scelerisque viverra mauris in aliquam sem fringilla ut. Sed enim ut sem viverra aliquet eget sit. Convallis posuere morbi leo urna molestie at. Aliquam id diam maecenas ultricies mi eget mauris. Ipsum dolor sit amet consectetur adipiscing elit ut aliquam. Accumsan tortor posuere ac ut consequat semper. Viverra vitae congue eu consequat ac felis donec et odio. Scelerisque in dictum non consectetur a. Consequat nisl vel pretium lectus quam id leo in vitae. Morbi tristique senectus et netus et malesuada fames ac turpis. Ac orci phasellus egestas tellus. Tempus egestas sed sed risus. Ullamcorper morbi tincidunt ornare massa eget egestas purus. Nibh venenatis cras sed felis eget velit.`
 
 const benchParagraphArabic = `و سأعرض مثال حي لهذا، من منا لم يتحمل جهد بدني شاق إلا من أجل الحصول على ميزة أو فائدة؟ ولكن من لديه الحق أن ينتقد شخص ما أراد أن يشعر بالسعادة التي لا تشوبها عواقب أليمة أو آخر أراد أن يتجنب الألم الذي ربما تنجم عنه بعض المتعة ؟ علي الجانب الآخر نشجب ونستنكر هؤلاء الرجال المفتونون بنشوة اللحظة الهائمون في رغباتهم فلا يدركون ما يعقبها من الألم والأسي المحتم، واللوم كذلك يشمل هؤلاء الذين أخفقوا في واجباتهم نتيجة لضعف إرادتهم فيتساوي مع هؤلاء الذين يتجنبون وينأون عن تحمل الكدح والألم . من المفترض أن نفرق بين هذه الحالات بكل سهولة ومرونة. في ذاك الوقت عندما تكون قدرتنا علي الاختيار غير مقيدة بشرط وعندما لا نجد ما يمنعنا أن نفعل الأفضل فها نحن نرحب بالسرور والسعادة ونتجنب كل ما يبعث إلينا الألم. في بعض الأحيان ونظراً للالتزامات التي يفرضها علينا الواجب والعمل سنتنازل غالباً ونرفض الشعور بالسرور ونقبل ما يجلبه إلينا الأسى. الإنسان الحكيم عليه أن يمسك زمام الأمور ويختار إما أن يرفض مصادر السعادة من أجل ما هو أكثر أهمية أو يتحمل الألم من أجل ألا يتحمل ما هو أسوأ. و سأعرض مثال حي لهذا، من منا لم يتحمل جهد بدني شاق إلا من أجل الحصول على ميزة أو فائدة؟ ولكن من لديه الحق أن ينتقد شخص ما أراد أن يشعر بالسعادة التي لا تشوبها عواقب أليمة أو آخر أراد أن يتجنب الألم الذي ربما تنجم عنه بعض المتعة ؟ علي الجانب الآخر نشجب ونستنكر هؤلاء الرجال المفتونون بنشوة اللحظة الهائمون في رغباتهم فلا يدركون ما يعقبها من الألم والأسي المحتم، واللوم كذلك يشمل هؤلاء الذين أخفقوا في واجباتهم نتيجة لضعف إرادتهم فيتساوي مع هؤلاء الذين يتجنبون وينأون عن تحمل الكدح والألم . من المفترض أن نفرق بين هذه الحالات بكل سهولة ومرونة. في ذاك الوقت عندما تكون قدرتنا علي الاختيار غير مقيدة بشرط وعندما لا نجد ما يمنعنا أن نفعل الأفضل فها نحن نرحب بالسرور والسعادة ونتجنب كل ما يبعث إلينا الألم. في بعض الأحيان ونظراً للالتزامات التي يفرضها علينا الواجب والعمل سنتنازل غالباً ونرفض الشعور بالسرور ونقبل ما يجلبه إلينا الأسى. الإنسان الحكيم عليه أن يمسك زمام الأمور ويختار إما أن يرفض مصادر السعادة من أجل ما هو أكثر أهمية أو يتحمل الألم من أجل ألا يتحمل ما هو أسوأ.`
+
+// TestWrappingSoftHyphen checks that a hyphen is inserted only when a line
+// is broken at a soft hyphen.
+func TestWrappingSoftHyphen(t *testing.T) {
+	shape := func(s string) Output {
+		text := []rune(s)
+		var shaper HarfbuzzShaper
+		return shaper.Shape(Input{
+			Text:      text,
+			RunStart:  0,
+			RunEnd:    len(text),
+			Direction: di.DirectionLTR,
+			Face:      benchEnFace,
+			Size:      fixed.I(16),
+			Script:    language.Latin,
+			Language:  language.NewLanguage("EN"),
+		})
+	}
+	textInput := []rune("extra\u00ADordinary")
+	out := shape(string(textInput))
+	hyphen := shape("-")
+	prefixWidth := shape("extra").Advance.Ceil()
+
+	var l LineWrapper
+	config := WrapConfig{Hyphen: hyphen}
+
+	// wide enough : the soft hyphen is invisible
+	lines, _ := l.WrapParagraph(config, out.Advance.Ceil()+10, textInput, out)
+	if len(lines) != 1 || len(lines[0]) != 1 {
+		t.Fatalf("expected a single line with one run, got %v", lines)
+	}
+
+	// too narrow : break at the soft hyphen and display the hyphen
+	lines, _ = l.WrapParagraph(config, prefixWidth+hyphen.Advance.Ceil()+1, textInput, out)
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	first := lines[0]
+	if len(first) != 2 {
+		t.Fatalf("expected the hyphen run to be appended, got %d runs", len(first))
+	}
+	if got := first[1]; got.Runes.Count != 0 || len(got.Glyphs) != len(hyphen.Glyphs) {
+		t.Errorf("unexpected hyphen run %v", got.Runes)
+	}
+	if first[0].Runes.Count != 6 {
+		t.Errorf("expected the soft hyphen to stay on the first line, got %v", first[0].Runes)
+	}
+	total := 0
+	for _, line := range lines {
+		for _, run := range line {
+			total += run.Runes.Count
+		}
+	}
+	if total != len(textInput) {
+		t.Errorf("expected runs to cover %d runes, got %d", len(textInput), total)
+	}
+
+	// without a configured hyphen, nothing is inserted
+	lines, _ = l.WrapParagraph(WrapConfig{}, prefixWidth+hyphen.Advance.Ceil()+1, textInput, out)
+	if len(lines) != 2 || len(lines[0]) != 1 {
+		t.Errorf("unexpected hyphen insertion: %v", lines)
+	}
+}
+
+// TestRunMapperReuse checks that mapping runs does not allocate
+// once the storage of the mapper is large enough, even across empty runs.
+func TestRunMapperReuse(t *testing.T) {
+	text := []rune(benchParagraphLatin)
+	var shaper HarfbuzzShaper
+	out := shaper.Shape(Input{
+		Text:      text,
+		RunStart:  0,
+		RunEnd:    len(text),
+		Direction: di.DirectionLTR,
+		Face:      benchEnFace,
+		Size:      fixed.I(16),
+		Script:    language.Latin,
+		Language:  language.NewLanguage("EN"),
+	})
+	runs := append(cutRunInto(out, 4), Output{}, out)
+
+	var mapper runMapper
+	mapper.mapRun(len(runs)-1, out)
+	allocs := testing.AllocsPerRun(10, func() {
+		for i, run := range runs {
+			mapper.mapRun(i, run)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocations, got %f", allocs)
+	}
+	if len(mapper.mapping) != out.Runes.Count {
+		t.Errorf("unexpected mapping length %d", len(mapper.mapping))
+	}
+}
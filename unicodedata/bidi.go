@@ -0,0 +1,1305 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package unicodedata
+
+import "unicode"
+
+// Code generated by typesettings-utils/generators/unicodedata/cmd/main.go DO NOT EDIT.
+
+// BidiClass: AL
+var BidiAL = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0608, Hi: 0x060b, Stride: 3},
+		{Lo: 0x060d, Hi: 0x061b, Stride: 14},
+		{Lo: 0x061c, Hi: 0x064a, Stride: 1},
+		{Lo: 0x066d, Hi: 0x066f, Stride: 1},
+		{Lo: 0x0671, Hi: 0x06d5, Stride: 1},
+		{Lo: 0x06e5, Hi: 0x06e6, Stride: 1},
+		{Lo: 0x06ee, Hi: 0x06ef, Stride: 1},
+		{Lo: 0x06fa, Hi: 0x0710, Stride: 1},
+		{Lo: 0x0712, Hi: 0x072f, Stride: 1},
+		{Lo: 0x074b, Hi: 0x07a5, Stride: 1},
+		{Lo: 0x07b1, Hi: 0x07bf, Stride: 1},
+		{Lo: 0x0860, Hi: 0x086a, Stride: 1},
+		{Lo: 0x0870, Hi: 0x088e, Stride: 1},
+		{Lo: 0x08a0, Hi: 0x08c9, Stride: 1},
+		{Lo: 0xfb50, Hi: 0xfd3d, Stride: 1},
+		{Lo: 0xfd50, Hi: 0xfdce, Stride: 1},
+		{Lo: 0xfdf0, Hi: 0xfdfc, Stride: 1},
+		{Lo: 0xfe70, Hi: 0xfefe, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10d00, Hi: 0x10d23, Stride: 1},
+		{Lo: 0x10f30, Hi: 0x10f45, Stride: 1},
+		{Lo: 0x10f51, Hi: 0x10f59, Stride: 1},
+		{Lo: 0x1ec71, Hi: 0x1ecb4, Stride: 1},
+		{Lo: 0x1ed01, Hi: 0x1ed3d, Stride: 1},
+		{Lo: 0x1ee00, Hi: 0x1eeef, Stride: 1},
+		{Lo: 0x1eef2, Hi: 0x1eeff, Stride: 1},
+	},
+}
+
+// BidiClass: AN
+var BidiAN = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+		{Lo: 0x0660, Hi: 0x0669, Stride: 1},
+		{Lo: 0x066b, Hi: 0x066c, Stride: 1},
+		{Lo: 0x06dd, Hi: 0x0890, Stride: 435},
+		{Lo: 0x0891, Hi: 0x08e2, Stride: 81},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10d30, Hi: 0x10d39, Stride: 1},
+		{Lo: 0x10e60, Hi: 0x10e7e, Stride: 1},
+	},
+}
+
+// BidiClass: B
+var BidiB = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x000a, Hi: 0x000d, Stride: 3},
+		{Lo: 0x001c, Hi: 0x001e, Stride: 1},
+		{Lo: 0x0085, Hi: 0x2029, Stride: 8100},
+	},
+	LatinOffset: 2,
+}
+
+// BidiClass: BN
+var BidiBN = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0000, Hi: 0x0008, Stride: 1},
+		{Lo: 0x000e, Hi: 0x001b, Stride: 1},
+		{Lo: 0x007f, Hi: 0x0084, Stride: 1},
+		{Lo: 0x0086, Hi: 0x009f, Stride: 1},
+		{Lo: 0x00ad, Hi: 0x180e, Stride: 5985},
+		{Lo: 0x200b, Hi: 0x200d, Stride: 1},
+		{Lo: 0x2060, Hi: 0x2065, Stride: 1},
+		{Lo: 0x206a, Hi: 0x206f, Stride: 1},
+		{Lo: 0xfdd0, Hi: 0xfdef, Stride: 1},
+		{Lo: 0xfeff, Hi: 0xfff0, Stride: 241},
+		{Lo: 0xfff1, Hi: 0xfff8, Stride: 1},
+		{Lo: 0xfffe, Hi: 0xffff, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1bca0, Hi: 0x1bca3, Stride: 1},
+		{Lo: 0x1d173, Hi: 0x1d17a, Stride: 1},
+		{Lo: 0x1fffe, Hi: 0x1ffff, Stride: 1},
+		{Lo: 0x2fffe, Hi: 0x2ffff, Stride: 1},
+		{Lo: 0x3fffe, Hi: 0x3ffff, Stride: 1},
+		{Lo: 0x4fffe, Hi: 0x4ffff, Stride: 1},
+		{Lo: 0x5fffe, Hi: 0x5ffff, Stride: 1},
+		{Lo: 0x6fffe, Hi: 0x6ffff, Stride: 1},
+		{Lo: 0x7fffe, Hi: 0x7ffff, Stride: 1},
+		{Lo: 0x8fffe, Hi: 0x8ffff, Stride: 1},
+		{Lo: 0x9fffe, Hi: 0x9ffff, Stride: 1},
+		{Lo: 0xafffe, Hi: 0xaffff, Stride: 1},
+		{Lo: 0xbfffe, Hi: 0xbffff, Stride: 1},
+		{Lo: 0xcfffe, Hi: 0xcffff, Stride: 1},
+		{Lo: 0xdfffe, Hi: 0xe00ff, Stride: 1},
+		{Lo: 0xe01f0, Hi: 0xe0fff, Stride: 1},
+		{Lo: 0xefffe, Hi: 0xeffff, Stride: 1},
+		{Lo: 0xffffe, Hi: 0xfffff, Stride: 1},
+		{Lo: 0x10fffe, Hi: 0x10ffff, Stride: 1},
+	},
+	LatinOffset: 4,
+}
+
+// BidiClass: CS
+var BidiCS = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x002c, Hi: 0x002e, Stride: 2},
+		{Lo: 0x002f, Hi: 0x003a, Stride: 11},
+		{Lo: 0x00a0, Hi: 0x060c, Stride: 1388},
+		{Lo: 0x202f, Hi: 0x2044, Stride: 21},
+		{Lo: 0xfe50, Hi: 0xfe52, Stride: 2},
+		{Lo: 0xfe55, Hi: 0xff0c, Stride: 183},
+		{Lo: 0xff0e, Hi: 0xff0f, Stride: 1},
+		{Lo: 0xff1a, Hi: 0xff1a, Stride: 1},
+	},
+	LatinOffset: 2,
+}
+
+// BidiClass: EN
+var BidiEN = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0030, Hi: 0x0039, Stride: 1},
+		{Lo: 0x00b2, Hi: 0x00b3, Stride: 1},
+		{Lo: 0x00b9, Hi: 0x06f0, Stride: 1591},
+		{Lo: 0x06f1, Hi: 0x06f9, Stride: 1},
+		{Lo: 0x2070, Hi: 0x2074, Stride: 4},
+		{Lo: 0x2075, Hi: 0x2079, Stride: 1},
+		{Lo: 0x2080, Hi: 0x2089, Stride: 1},
+		{Lo: 0x2488, Hi: 0x249b, Stride: 1},
+		{Lo: 0xff10, Hi: 0xff19, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x102e1, Hi: 0x102fb, Stride: 1},
+		{Lo: 0x1d7ce, Hi: 0x1d7ff, Stride: 1},
+		{Lo: 0x1f100, Hi: 0x1f10a, Stride: 1},
+		{Lo: 0x1fbf0, Hi: 0x1fbf9, Stride: 1},
+	},
+	LatinOffset: 2,
+}
+
+// BidiClass: ES
+var BidiES = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x002b, Hi: 0x002d, Stride: 2},
+		{Lo: 0x207a, Hi: 0x207b, Stride: 1},
+		{Lo: 0x208a, Hi: 0x208b, Stride: 1},
+		{Lo: 0x2212, Hi: 0xfb29, Stride: 55575},
+		{Lo: 0xfe62, Hi: 0xfe63, Stride: 1},
+		{Lo: 0xff0b, Hi: 0xff0d, Stride: 2},
+	},
+	LatinOffset: 1,
+}
+
+// BidiClass: ET
+var BidiET = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0023, Hi: 0x0025, Stride: 1},
+		{Lo: 0x00a2, Hi: 0x00a5, Stride: 1},
+		{Lo: 0x00b0, Hi: 0x00b1, Stride: 1},
+		{Lo: 0x058f, Hi: 0x0609, Stride: 122},
+		{Lo: 0x060a, Hi: 0x066a, Stride: 96},
+		{Lo: 0x09f2, Hi: 0x09f3, Stride: 1},
+		{Lo: 0x09fb, Hi: 0x0af1, Stride: 246},
+		{Lo: 0x0bf9, Hi: 0x0e3f, Stride: 582},
+		{Lo: 0x17db, Hi: 0x2030, Stride: 2133},
+		{Lo: 0x2031, Hi: 0x2034, Stride: 1},
+		{Lo: 0x20a0, Hi: 0x20cf, Stride: 1},
+		{Lo: 0x212e, Hi: 0x2213, Stride: 229},
+		{Lo: 0xa838, Hi: 0xa839, Stride: 1},
+		{Lo: 0xfe5f, Hi: 0xfe69, Stride: 10},
+		{Lo: 0xfe6a, Hi: 0xff03, Stride: 153},
+		{Lo: 0xff04, Hi: 0xff05, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe1, Stride: 1},
+		{Lo: 0xffe5, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x11fdd, Hi: 0x11fe0, Stride: 1},
+		{Lo: 0x1e2ff, Hi: 0x1e2ff, Stride: 1},
+	},
+	LatinOffset: 3,
+}
+
+// BidiClass: FSI
+var BidiFSI = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2068, Hi: 0x2068, Stride: 1},
+	},
+}
+
+// BidiClass: L
+var BidiL = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0041, Hi: 0x005a, Stride: 1},
+		{Lo: 0x0061, Hi: 0x007a, Stride: 1},
+		{Lo: 0x00aa, Hi: 0x00b5, Stride: 11},
+		{Lo: 0x00ba, Hi: 0x00c0, Stride: 6},
+		{Lo: 0x00c1, Hi: 0x00d6, Stride: 1},
+		{Lo: 0x00d8, Hi: 0x00f6, Stride: 1},
+		{Lo: 0x00f8, Hi: 0x02b8, Stride: 1},
+		{Lo: 0x02bb, Hi: 0x02c1, Stride: 1},
+		{Lo: 0x02d0, Hi: 0x02d1, Stride: 1},
+		{Lo: 0x02e0, Hi: 0x02e4, Stride: 1},
+		{Lo: 0x02ee, Hi: 0x0370, Stride: 130},
+		{Lo: 0x0371, Hi: 0x0373, Stride: 1},
+		{Lo: 0x0376, Hi: 0x037d, Stride: 1},
+		{Lo: 0x037f, Hi: 0x0383, Stride: 1},
+		{Lo: 0x0386, Hi: 0x0388, Stride: 2},
+		{Lo: 0x0389, Hi: 0x03f5, Stride: 1},
+		{Lo: 0x03f7, Hi: 0x0482, Stride: 1},
+		{Lo: 0x048a, Hi: 0x0589, Stride: 1},
+		{Lo: 0x058b, Hi: 0x058c, Stride: 1},
+		{Lo: 0x0903, Hi: 0x0939, Stride: 1},
+		{Lo: 0x093b, Hi: 0x093d, Stride: 2},
+		{Lo: 0x093e, Hi: 0x0940, Stride: 1},
+		{Lo: 0x0949, Hi: 0x094c, Stride: 1},
+		{Lo: 0x094e, Hi: 0x0950, Stride: 1},
+		{Lo: 0x0958, Hi: 0x0961, Stride: 1},
+		{Lo: 0x0964, Hi: 0x0980, Stride: 1},
+		{Lo: 0x0982, Hi: 0x09bb, Stride: 1},
+		{Lo: 0x09bd, Hi: 0x09c0, Stride: 1},
+		{Lo: 0x09c5, Hi: 0x09cc, Stride: 1},
+		{Lo: 0x09ce, Hi: 0x09e1, Stride: 1},
+		{Lo: 0x09e4, Hi: 0x09f1, Stride: 1},
+		{Lo: 0x09f4, Hi: 0x09fa, Stride: 1},
+		{Lo: 0x09fc, Hi: 0x09fd, Stride: 1},
+		{Lo: 0x09ff, Hi: 0x0a00, Stride: 1},
+		{Lo: 0x0a03, Hi: 0x0a3b, Stride: 1},
+		{Lo: 0x0a3d, Hi: 0x0a40, Stride: 1},
+		{Lo: 0x0a43, Hi: 0x0a46, Stride: 1},
+		{Lo: 0x0a49, Hi: 0x0a4a, Stride: 1},
+		{Lo: 0x0a4e, Hi: 0x0a50, Stride: 1},
+		{Lo: 0x0a52, Hi: 0x0a6f, Stride: 1},
+		{Lo: 0x0a72, Hi: 0x0a74, Stride: 1},
+		{Lo: 0x0a76, Hi: 0x0a80, Stride: 1},
+		{Lo: 0x0a83, Hi: 0x0abb, Stride: 1},
+		{Lo: 0x0abd, Hi: 0x0ac0, Stride: 1},
+		{Lo: 0x0ac6, Hi: 0x0ac9, Stride: 3},
+		{Lo: 0x0aca, Hi: 0x0acc, Stride: 1},
+		{Lo: 0x0ace, Hi: 0x0ae1, Stride: 1},
+		{Lo: 0x0ae4, Hi: 0x0af0, Stride: 1},
+		{Lo: 0x0af2, Hi: 0x0af9, Stride: 1},
+		{Lo: 0x0b00, Hi: 0x0b02, Stride: 2},
+		{Lo: 0x0b03, Hi: 0x0b3b, Stride: 1},
+		{Lo: 0x0b3d, Hi: 0x0b3e, Stride: 1},
+		{Lo: 0x0b40, Hi: 0x0b45, Stride: 5},
+		{Lo: 0x0b46, Hi: 0x0b4c, Stride: 1},
+		{Lo: 0x0b4e, Hi: 0x0b54, Stride: 1},
+		{Lo: 0x0b57, Hi: 0x0b61, Stride: 1},
+		{Lo: 0x0b64, Hi: 0x0b81, Stride: 1},
+		{Lo: 0x0b83, Hi: 0x0bbf, Stride: 1},
+		{Lo: 0x0bc1, Hi: 0x0bcc, Stride: 1},
+		{Lo: 0x0bce, Hi: 0x0bf2, Stride: 1},
+		{Lo: 0x0bfb, Hi: 0x0bff, Stride: 1},
+		{Lo: 0x0c01, Hi: 0x0c03, Stride: 1},
+		{Lo: 0x0c05, Hi: 0x0c3b, Stride: 1},
+		{Lo: 0x0c3d, Hi: 0x0c41, Stride: 4},
+		{Lo: 0x0c42, Hi: 0x0c45, Stride: 1},
+		{Lo: 0x0c49, Hi: 0x0c4e, Stride: 5},
+		{Lo: 0x0c4f, Hi: 0x0c54, Stride: 1},
+		{Lo: 0x0c57, Hi: 0x0c61, Stride: 1},
+		{Lo: 0x0c64, Hi: 0x0c77, Stride: 1},
+		{Lo: 0x0c7f, Hi: 0x0c80, Stride: 1},
+		{Lo: 0x0c82, Hi: 0x0cbb, Stride: 1},
+		{Lo: 0x0cbd, Hi: 0x0ccb, Stride: 1},
+		{Lo: 0x0cce, Hi: 0x0ce1, Stride: 1},
+		{Lo: 0x0ce4, Hi: 0x0cff, Stride: 1},
+		{Lo: 0x0d02, Hi: 0x0d3a, Stride: 1},
+		{Lo: 0x0d3d, Hi: 0x0d40, Stride: 1},
+		{Lo: 0x0d45, Hi: 0x0d4c, Stride: 1},
+		{Lo: 0x0d4e, Hi: 0x0d61, Stride: 1},
+		{Lo: 0x0d64, Hi: 0x0d80, Stride: 1},
+		{Lo: 0x0d82, Hi: 0x0dc9, Stride: 1},
+		{Lo: 0x0dcb, Hi: 0x0dd1, Stride: 1},
+		{Lo: 0x0dd5, Hi: 0x0dd7, Stride: 2},
+		{Lo: 0x0dd8, Hi: 0x0e30, Stride: 1},
+		{Lo: 0x0e32, Hi: 0x0e33, Stride: 1},
+		{Lo: 0x0e3b, Hi: 0x0e3e, Stride: 1},
+		{Lo: 0x0e40, Hi: 0x0e46, Stride: 1},
+		{Lo: 0x0e4f, Hi: 0x0eb0, Stride: 1},
+		{Lo: 0x0eb2, Hi: 0x0eb3, Stride: 1},
+		{Lo: 0x0ebd, Hi: 0x0ec7, Stride: 1},
+		{Lo: 0x0ecf, Hi: 0x0f17, Stride: 1},
+		{Lo: 0x0f1a, Hi: 0x0f34, Stride: 1},
+		{Lo: 0x0f36, Hi: 0x0f38, Stride: 2},
+		{Lo: 0x0f3e, Hi: 0x0f70, Stride: 1},
+		{Lo: 0x0f7f, Hi: 0x0f85, Stride: 6},
+		{Lo: 0x0f88, Hi: 0x0f8c, Stride: 1},
+		{Lo: 0x0f98, Hi: 0x0fbd, Stride: 37},
+		{Lo: 0x0fbe, Hi: 0x0fc5, Stride: 1},
+		{Lo: 0x0fc7, Hi: 0x102c, Stride: 1},
+		{Lo: 0x1031, Hi: 0x1038, Stride: 7},
+		{Lo: 0x103b, Hi: 0x103c, Stride: 1},
+		{Lo: 0x103f, Hi: 0x1057, Stride: 1},
+		{Lo: 0x105a, Hi: 0x105d, Stride: 1},
+		{Lo: 0x1061, Hi: 0x1070, Stride: 1},
+		{Lo: 0x1075, Hi: 0x1081, Stride: 1},
+		{Lo: 0x1083, Hi: 0x1084, Stride: 1},
+		{Lo: 0x1087, Hi: 0x108c, Stride: 1},
+		{Lo: 0x108e, Hi: 0x109c, Stride: 1},
+		{Lo: 0x109e, Hi: 0x135c, Stride: 1},
+		{Lo: 0x1360, Hi: 0x138f, Stride: 1},
+		{Lo: 0x139a, Hi: 0x13ff, Stride: 1},
+		{Lo: 0x1401, Hi: 0x167f, Stride: 1},
+		{Lo: 0x1681, Hi: 0x169a, Stride: 1},
+		{Lo: 0x169d, Hi: 0x1711, Stride: 1},
+		{Lo: 0x1715, Hi: 0x1731, Stride: 1},
+		{Lo: 0x1734, Hi: 0x1751, Stride: 1},
+		{Lo: 0x1754, Hi: 0x1771, Stride: 1},
+		{Lo: 0x1774, Hi: 0x17b3, Stride: 1},
+		{Lo: 0x17b6, Hi: 0x17be, Stride: 8},
+		{Lo: 0x17bf, Hi: 0x17c5, Stride: 1},
+		{Lo: 0x17c7, Hi: 0x17c8, Stride: 1},
+		{Lo: 0x17d4, Hi: 0x17da, Stride: 1},
+		{Lo: 0x17dc, Hi: 0x17de, Stride: 2},
+		{Lo: 0x17df, Hi: 0x17ef, Stride: 1},
+		{Lo: 0x17fa, Hi: 0x17ff, Stride: 1},
+		{Lo: 0x1810, Hi: 0x1884, Stride: 1},
+		{Lo: 0x1887, Hi: 0x18a8, Stride: 1},
+		{Lo: 0x18aa, Hi: 0x191f, Stride: 1},
+		{Lo: 0x1923, Hi: 0x1926, Stride: 1},
+		{Lo: 0x1929, Hi: 0x1931, Stride: 1},
+		{Lo: 0x1933, Hi: 0x1938, Stride: 1},
+		{Lo: 0x193c, Hi: 0x193f, Stride: 1},
+		{Lo: 0x1941, Hi: 0x1943, Stride: 1},
+		{Lo: 0x1946, Hi: 0x19dd, Stride: 1},
+		{Lo: 0x1a00, Hi: 0x1a16, Stride: 1},
+		{Lo: 0x1a19, Hi: 0x1a1a, Stride: 1},
+		{Lo: 0x1a1c, Hi: 0x1a55, Stride: 1},
+		{Lo: 0x1a57, Hi: 0x1a5f, Stride: 8},
+		{Lo: 0x1a61, Hi: 0x1a63, Stride: 2},
+		{Lo: 0x1a64, Hi: 0x1a6d, Stride: 9},
+		{Lo: 0x1a6e, Hi: 0x1a72, Stride: 1},
+		{Lo: 0x1a7d, Hi: 0x1a7e, Stride: 1},
+		{Lo: 0x1a80, Hi: 0x1aaf, Stride: 1},
+		{Lo: 0x1acf, Hi: 0x1aff, Stride: 1},
+		{Lo: 0x1b04, Hi: 0x1b33, Stride: 1},
+		{Lo: 0x1b35, Hi: 0x1b3b, Stride: 6},
+		{Lo: 0x1b3d, Hi: 0x1b41, Stride: 1},
+		{Lo: 0x1b43, Hi: 0x1b6a, Stride: 1},
+		{Lo: 0x1b74, Hi: 0x1b7f, Stride: 1},
+		{Lo: 0x1b82, Hi: 0x1ba1, Stride: 1},
+		{Lo: 0x1ba6, Hi: 0x1ba7, Stride: 1},
+		{Lo: 0x1baa, Hi: 0x1bae, Stride: 4},
+		{Lo: 0x1baf, Hi: 0x1be5, Stride: 1},
+		{Lo: 0x1be7, Hi: 0x1bea, Stride: 3},
+		{Lo: 0x1beb, Hi: 0x1bec, Stride: 1},
+		{Lo: 0x1bee, Hi: 0x1bf2, Stride: 4},
+		{Lo: 0x1bf3, Hi: 0x1c2b, Stride: 1},
+		{Lo: 0x1c34, Hi: 0x1c35, Stride: 1},
+		{Lo: 0x1c38, Hi: 0x1ccf, Stride: 1},
+		{Lo: 0x1cd3, Hi: 0x1ce1, Stride: 14},
+		{Lo: 0x1ce9, Hi: 0x1cec, Stride: 1},
+		{Lo: 0x1cee, Hi: 0x1cf3, Stride: 1},
+		{Lo: 0x1cf5, Hi: 0x1cf7, Stride: 1},
+		{Lo: 0x1cfa, Hi: 0x1dbf, Stride: 1},
+		{Lo: 0x1e00, Hi: 0x1fbc, Stride: 1},
+		{Lo: 0x1fbe, Hi: 0x1fc2, Stride: 4},
+		{Lo: 0x1fc3, Hi: 0x1fcc, Stride: 1},
+		{Lo: 0x1fd0, Hi: 0x1fdc, Stride: 1},
+		{Lo: 0x1fe0, Hi: 0x1fec, Stride: 1},
+		{Lo: 0x1ff0, Hi: 0x1ffc, Stride: 1},
+		{Lo: 0x1fff, Hi: 0x200e, Stride: 15},
+		{Lo: 0x2071, Hi: 0x2073, Stride: 1},
+		{Lo: 0x207f, Hi: 0x208f, Stride: 16},
+		{Lo: 0x2090, Hi: 0x209f, Stride: 1},
+		{Lo: 0x20f1, Hi: 0x20ff, Stride: 1},
+		{Lo: 0x2102, Hi: 0x2107, Stride: 5},
+		{Lo: 0x210a, Hi: 0x2113, Stride: 1},
+		{Lo: 0x2115, Hi: 0x2119, Stride: 4},
+		{Lo: 0x211a, Hi: 0x211d, Stride: 1},
+		{Lo: 0x2124, Hi: 0x212a, Stride: 2},
+		{Lo: 0x212b, Hi: 0x212d, Stride: 1},
+		{Lo: 0x212f, Hi: 0x2139, Stride: 1},
+		{Lo: 0x213c, Hi: 0x213f, Stride: 1},
+		{Lo: 0x2145, Hi: 0x2149, Stride: 1},
+		{Lo: 0x214e, Hi: 0x214f, Stride: 1},
+		{Lo: 0x2160, Hi: 0x2188, Stride: 1},
+		{Lo: 0x218c, Hi: 0x218f, Stride: 1},
+		{Lo: 0x2336, Hi: 0x237a, Stride: 1},
+		{Lo: 0x2395, Hi: 0x2427, Stride: 146},
+		{Lo: 0x2428, Hi: 0x243f, Stride: 1},
+		{Lo: 0x244b, Hi: 0x245f, Stride: 1},
+		{Lo: 0x249c, Hi: 0x24e9, Stride: 1},
+		{Lo: 0x26ac, Hi: 0x2800, Stride: 340},
+		{Lo: 0x2801, Hi: 0x28ff, Stride: 1},
+		{Lo: 0x2b74, Hi: 0x2b75, Stride: 1},
+		{Lo: 0x2b96, Hi: 0x2c00, Stride: 106},
+		{Lo: 0x2c01, Hi: 0x2ce4, Stride: 1},
+		{Lo: 0x2ceb, Hi: 0x2cee, Stride: 1},
+		{Lo: 0x2cf2, Hi: 0x2cf8, Stride: 1},
+		{Lo: 0x2d00, Hi: 0x2d7e, Stride: 1},
+		{Lo: 0x2d80, Hi: 0x2ddf, Stride: 1},
+		{Lo: 0x2e5e, Hi: 0x2e7f, Stride: 1},
+		{Lo: 0x2e9a, Hi: 0x2ef4, Stride: 90},
+		{Lo: 0x2ef5, Hi: 0x2eff, Stride: 1},
+		{Lo: 0x2fd6, Hi: 0x2fef, Stride: 1},
+		{Lo: 0x2ffc, Hi: 0x2fff, Stride: 1},
+		{Lo: 0x3005, Hi: 0x3007, Stride: 1},
+		{Lo: 0x3021, Hi: 0x3029, Stride: 1},
+		{Lo: 0x302e, Hi: 0x302f, Stride: 1},
+		{Lo: 0x3031, Hi: 0x3035, Stride: 1},
+		{Lo: 0x3038, Hi: 0x303c, Stride: 1},
+		{Lo: 0x3040, Hi: 0x3098, Stride: 1},
+		{Lo: 0x309d, Hi: 0x309f, Stride: 1},
+		{Lo: 0x30a1, Hi: 0x30fa, Stride: 1},
+		{Lo: 0x30fc, Hi: 0x31bf, Stride: 1},
+		{Lo: 0x31e4, Hi: 0x321c, Stride: 1},
+		{Lo: 0x321f, Hi: 0x324f, Stride: 1},
+		{Lo: 0x3260, Hi: 0x327b, Stride: 1},
+		{Lo: 0x327f, Hi: 0x32b0, Stride: 1},
+		{Lo: 0x32c0, Hi: 0x32cb, Stride: 1},
+		{Lo: 0x32d0, Hi: 0x3376, Stride: 1},
+		{Lo: 0x337b, Hi: 0x33dd, Stride: 1},
+		{Lo: 0x33e0, Hi: 0x33fe, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0xa48f, Stride: 1},
+		{Lo: 0xa4c7, Hi: 0xa60c, Stride: 1},
+		{Lo: 0xa610, Hi: 0xa66e, Stride: 1},
+		{Lo: 0xa680, Hi: 0xa69d, Stride: 1},
+		{Lo: 0xa6a0, Hi: 0xa6ef, Stride: 1},
+		{Lo: 0xa6f2, Hi: 0xa6ff, Stride: 1},
+		{Lo: 0xa722, Hi: 0xa787, Stride: 1},
+		{Lo: 0xa789, Hi: 0xa801, Stride: 1},
+		{Lo: 0xa803, Hi: 0xa805, Stride: 1},
+		{Lo: 0xa807, Hi: 0xa80a, Stride: 1},
+		{Lo: 0xa80c, Hi: 0xa824, Stride: 1},
+		{Lo: 0xa827, Hi: 0xa82d, Stride: 6},
+		{Lo: 0xa82e, Hi: 0xa837, Stride: 1},
+		{Lo: 0xa83a, Hi: 0xa873, Stride: 1},
+		{Lo: 0xa878, Hi: 0xa8c3, Stride: 1},
+		{Lo: 0xa8c6, Hi: 0xa8df, Stride: 1},
+		{Lo: 0xa8f2, Hi: 0xa8fe, Stride: 1},
+		{Lo: 0xa900, Hi: 0xa925, Stride: 1},
+		{Lo: 0xa92e, Hi: 0xa946, Stride: 1},
+		{Lo: 0xa952, Hi: 0xa97f, Stride: 1},
+		{Lo: 0xa983, Hi: 0xa9b2, Stride: 1},
+		{Lo: 0xa9b4, Hi: 0xa9b5, Stride: 1},
+		{Lo: 0xa9ba, Hi: 0xa9bb, Stride: 1},
+		{Lo: 0xa9be, Hi: 0xa9e4, Stride: 1},
+		{Lo: 0xa9e6, Hi: 0xaa28, Stride: 1},
+		{Lo: 0xaa2f, Hi: 0xaa30, Stride: 1},
+		{Lo: 0xaa33, Hi: 0xaa34, Stride: 1},
+		{Lo: 0xaa37, Hi: 0xaa42, Stride: 1},
+		{Lo: 0xaa44, Hi: 0xaa4b, Stride: 1},
+		{Lo: 0xaa4d, Hi: 0xaa7b, Stride: 1},
+		{Lo: 0xaa7d, Hi: 0xaaaf, Stride: 1},
+		{Lo: 0xaab1, Hi: 0xaab5, Stride: 4},
+		{Lo: 0xaab6, Hi: 0xaab9, Stride: 3},
+		{Lo: 0xaaba, Hi: 0xaabd, Stride: 1},
+		{Lo: 0xaac0, Hi: 0xaac2, Stride: 2},
+		{Lo: 0xaac3, Hi: 0xaaeb, Stride: 1},
+		{Lo: 0xaaee, Hi: 0xaaf5, Stride: 1},
+		{Lo: 0xaaf7, Hi: 0xab69, Stride: 1},
+		{Lo: 0xab6c, Hi: 0xabe4, Stride: 1},
+		{Lo: 0xabe6, Hi: 0xabe7, Stride: 1},
+		{Lo: 0xabe9, Hi: 0xabec, Stride: 1},
+		{Lo: 0xabee, Hi: 0xd7ff, Stride: 1},
+		{Lo: 0xe000, Hi: 0xfb1c, Stride: 1},
+		{Lo: 0xfe1a, Hi: 0xfe1f, Stride: 1},
+		{Lo: 0xfe53, Hi: 0xfe67, Stride: 20},
+		{Lo: 0xfe6c, Hi: 0xfe6f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xff21, Stride: 33},
+		{Lo: 0xff22, Hi: 0xff3a, Stride: 1},
+		{Lo: 0xff41, Hi: 0xff5a, Stride: 1},
+		{Lo: 0xff66, Hi: 0xffdf, Stride: 1},
+		{Lo: 0xffe7, Hi: 0xffef, Stride: 8},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10000, Hi: 0x10100, Stride: 1},
+		{Lo: 0x10102, Hi: 0x1013f, Stride: 1},
+		{Lo: 0x1018d, Hi: 0x1018f, Stride: 1},
+		{Lo: 0x1019d, Hi: 0x1019f, Stride: 1},
+		{Lo: 0x101a1, Hi: 0x101fc, Stride: 1},
+		{Lo: 0x101fe, Hi: 0x102df, Stride: 1},
+		{Lo: 0x102fc, Hi: 0x10375, Stride: 1},
+		{Lo: 0x1037b, Hi: 0x107ff, Stride: 1},
+		{Lo: 0x11000, Hi: 0x11002, Stride: 2},
+		{Lo: 0x11003, Hi: 0x11037, Stride: 1},
+		{Lo: 0x11047, Hi: 0x11051, Stride: 1},
+		{Lo: 0x11066, Hi: 0x1106f, Stride: 1},
+		{Lo: 0x11071, Hi: 0x11072, Stride: 1},
+		{Lo: 0x11075, Hi: 0x1107e, Stride: 1},
+		{Lo: 0x11082, Hi: 0x110b2, Stride: 1},
+		{Lo: 0x110b7, Hi: 0x110b8, Stride: 1},
+		{Lo: 0x110bb, Hi: 0x110c1, Stride: 1},
+		{Lo: 0x110c3, Hi: 0x110ff, Stride: 1},
+		{Lo: 0x11103, Hi: 0x11126, Stride: 1},
+		{Lo: 0x1112c, Hi: 0x11135, Stride: 9},
+		{Lo: 0x11136, Hi: 0x11172, Stride: 1},
+		{Lo: 0x11174, Hi: 0x1117f, Stride: 1},
+		{Lo: 0x11182, Hi: 0x111b5, Stride: 1},
+		{Lo: 0x111bf, Hi: 0x111c8, Stride: 1},
+		{Lo: 0x111cd, Hi: 0x111ce, Stride: 1},
+		{Lo: 0x111d0, Hi: 0x1122e, Stride: 1},
+		{Lo: 0x11232, Hi: 0x11233, Stride: 1},
+		{Lo: 0x11235, Hi: 0x11238, Stride: 3},
+		{Lo: 0x11239, Hi: 0x1123d, Stride: 1},
+		{Lo: 0x1123f, Hi: 0x11240, Stride: 1},
+		{Lo: 0x11242, Hi: 0x112de, Stride: 1},
+		{Lo: 0x112e0, Hi: 0x112e2, Stride: 1},
+		{Lo: 0x112eb, Hi: 0x112ff, Stride: 1},
+		{Lo: 0x11302, Hi: 0x1133a, Stride: 1},
+		{Lo: 0x1133d, Hi: 0x1133f, Stride: 1},
+		{Lo: 0x11341, Hi: 0x11365, Stride: 1},
+		{Lo: 0x1136d, Hi: 0x1136f, Stride: 1},
+		{Lo: 0x11375, Hi: 0x11437, Stride: 1},
+		{Lo: 0x11440, Hi: 0x11441, Stride: 1},
+		{Lo: 0x11445, Hi: 0x11447, Stride: 2},
+		{Lo: 0x11448, Hi: 0x1145d, Stride: 1},
+		{Lo: 0x1145f, Hi: 0x114b2, Stride: 1},
+		{Lo: 0x114b9, Hi: 0x114bb, Stride: 2},
+		{Lo: 0x114bc, Hi: 0x114be, Stride: 1},
+		{Lo: 0x114c1, Hi: 0x114c4, Stride: 3},
+		{Lo: 0x114c5, Hi: 0x115b1, Stride: 1},
+		{Lo: 0x115b6, Hi: 0x115bb, Stride: 1},
+		{Lo: 0x115be, Hi: 0x115c1, Stride: 3},
+		{Lo: 0x115c2, Hi: 0x115db, Stride: 1},
+		{Lo: 0x115de, Hi: 0x11632, Stride: 1},
+		{Lo: 0x1163b, Hi: 0x1163c, Stride: 1},
+		{Lo: 0x1163e, Hi: 0x11641, Stride: 3},
+		{Lo: 0x11642, Hi: 0x1165f, Stride: 1},
+		{Lo: 0x1166d, Hi: 0x116aa, Stride: 1},
+		{Lo: 0x116ac, Hi: 0x116ae, Stride: 2},
+		{Lo: 0x116af, Hi: 0x116b6, Stride: 7},
+		{Lo: 0x116b8, Hi: 0x1171c, Stride: 1},
+		{Lo: 0x11720, Hi: 0x11721, Stride: 1},
+		{Lo: 0x11726, Hi: 0x1172c, Stride: 6},
+		{Lo: 0x1172d, Hi: 0x1182e, Stride: 1},
+		{Lo: 0x11838, Hi: 0x1183b, Stride: 3},
+		{Lo: 0x1183c, Hi: 0x1193a, Stride: 1},
+		{Lo: 0x1193d, Hi: 0x1193f, Stride: 2},
+		{Lo: 0x11940, Hi: 0x11942, Stride: 1},
+		{Lo: 0x11944, Hi: 0x119d3, Stride: 1},
+		{Lo: 0x119d8, Hi: 0x119d9, Stride: 1},
+		{Lo: 0x119dc, Hi: 0x119df, Stride: 1},
+		{Lo: 0x119e1, Hi: 0x11a00, Stride: 1},
+		{Lo: 0x11a07, Hi: 0x11a08, Stride: 1},
+		{Lo: 0x11a0b, Hi: 0x11a32, Stride: 1},
+		{Lo: 0x11a39, Hi: 0x11a3a, Stride: 1},
+		{Lo: 0x11a3f, Hi: 0x11a46, Stride: 1},
+		{Lo: 0x11a48, Hi: 0x11a50, Stride: 1},
+		{Lo: 0x11a57, Hi: 0x11a58, Stride: 1},
+		{Lo: 0x11a5c, Hi: 0x11a89, Stride: 1},
+		{Lo: 0x11a97, Hi: 0x11a9a, Stride: 3},
+		{Lo: 0x11a9b, Hi: 0x11c2f, Stride: 1},
+		{Lo: 0x11c37, Hi: 0x11c3e, Stride: 7},
+		{Lo: 0x11c3f, Hi: 0x11c91, Stride: 1},
+		{Lo: 0x11ca8, Hi: 0x11ca9, Stride: 1},
+		{Lo: 0x11cb1, Hi: 0x11cb7, Stride: 3},
+		{Lo: 0x11cb8, Hi: 0x11d30, Stride: 1},
+		{Lo: 0x11d37, Hi: 0x11d39, Stride: 1},
+		{Lo: 0x11d3b, Hi: 0x11d3e, Stride: 3},
+		{Lo: 0x11d46, Hi: 0x11d48, Stride: 2},
+		{Lo: 0x11d49, Hi: 0x11d8f, Stride: 1},
+		{Lo: 0x11d92, Hi: 0x11d94, Stride: 1},
+		{Lo: 0x11d96, Hi: 0x11d98, Stride: 2},
+		{Lo: 0x11d99, Hi: 0x11ef2, Stride: 1},
+		{Lo: 0x11ef5, Hi: 0x11eff, Stride: 1},
+		{Lo: 0x11f02, Hi: 0x11f35, Stride: 1},
+		{Lo: 0x11f3b, Hi: 0x11f3f, Stride: 1},
+		{Lo: 0x11f41, Hi: 0x11f43, Stride: 2},
+		{Lo: 0x11f44, Hi: 0x11fd4, Stride: 1},
+		{Lo: 0x11ff2, Hi: 0x1343f, Stride: 1},
+		{Lo: 0x13441, Hi: 0x13446, Stride: 1},
+		{Lo: 0x13456, Hi: 0x16aef, Stride: 1},
+		{Lo: 0x16af5, Hi: 0x16b2f, Stride: 1},
+		{Lo: 0x16b37, Hi: 0x16f4e, Stride: 1},
+		{Lo: 0x16f50, Hi: 0x16f8e, Stride: 1},
+		{Lo: 0x16f93, Hi: 0x16fe1, Stride: 1},
+		{Lo: 0x16fe3, Hi: 0x16fe5, Stride: 2},
+		{Lo: 0x16fe6, Hi: 0x1bc9c, Stride: 1},
+		{Lo: 0x1bc9f, Hi: 0x1bca4, Stride: 5},
+		{Lo: 0x1bca5, Hi: 0x1ceff, Stride: 1},
+		{Lo: 0x1cf2e, Hi: 0x1cf2f, Stride: 1},
+		{Lo: 0x1cf47, Hi: 0x1d166, Stride: 1},
+		{Lo: 0x1d16a, Hi: 0x1d172, Stride: 1},
+		{Lo: 0x1d183, Hi: 0x1d184, Stride: 1},
+		{Lo: 0x1d18c, Hi: 0x1d1a9, Stride: 1},
+		{Lo: 0x1d1ae, Hi: 0x1d1e8, Stride: 1},
+		{Lo: 0x1d1eb, Hi: 0x1d1ff, Stride: 1},
+		{Lo: 0x1d246, Hi: 0x1d2ff, Stride: 1},
+		{Lo: 0x1d357, Hi: 0x1d6da, Stride: 1},
+		{Lo: 0x1d6dc, Hi: 0x1d714, Stride: 1},
+		{Lo: 0x1d716, Hi: 0x1d74e, Stride: 1},
+		{Lo: 0x1d750, Hi: 0x1d788, Stride: 1},
+		{Lo: 0x1d78a, Hi: 0x1d7c2, Stride: 1},
+		{Lo: 0x1d7c4, Hi: 0x1d7cd, Stride: 1},
+		{Lo: 0x1d800, Hi: 0x1d9ff, Stride: 1},
+		{Lo: 0x1da37, Hi: 0x1da3a, Stride: 1},
+		{Lo: 0x1da6d, Hi: 0x1da74, Stride: 1},
+		{Lo: 0x1da76, Hi: 0x1da83, Stride: 1},
+		{Lo: 0x1da85, Hi: 0x1da9a, Stride: 1},
+		{Lo: 0x1daa0, Hi: 0x1dab0, Stride: 16},
+		{Lo: 0x1dab1, Hi: 0x1dfff, Stride: 1},
+		{Lo: 0x1e007, Hi: 0x1e019, Stride: 18},
+		{Lo: 0x1e01a, Hi: 0x1e022, Stride: 8},
+		{Lo: 0x1e025, Hi: 0x1e02b, Stride: 6},
+		{Lo: 0x1e02c, Hi: 0x1e08e, Stride: 1},
+		{Lo: 0x1e090, Hi: 0x1e12f, Stride: 1},
+		{Lo: 0x1e137, Hi: 0x1e2ad, Stride: 1},
+		{Lo: 0x1e2af, Hi: 0x1e2eb, Stride: 1},
+		{Lo: 0x1e2f0, Hi: 0x1e2fe, Stride: 1},
+		{Lo: 0x1e300, Hi: 0x1e4eb, Stride: 1},
+		{Lo: 0x1e4f0, Hi: 0x1e7ff, Stride: 1},
+		{Lo: 0x1f02c, Hi: 0x1f02f, Stride: 1},
+		{Lo: 0x1f094, Hi: 0x1f09f, Stride: 1},
+		{Lo: 0x1f0af, Hi: 0x1f0b0, Stride: 1},
+		{Lo: 0x1f0c0, Hi: 0x1f0d0, Stride: 16},
+		{Lo: 0x1f0f6, Hi: 0x1f0ff, Stride: 1},
+		{Lo: 0x1f110, Hi: 0x1f12e, Stride: 1},
+		{Lo: 0x1f130, Hi: 0x1f169, Stride: 1},
+		{Lo: 0x1f170, Hi: 0x1f1ac, Stride: 1},
+		{Lo: 0x1f1ae, Hi: 0x1f25f, Stride: 1},
+		{Lo: 0x1f266, Hi: 0x1f2ff, Stride: 1},
+		{Lo: 0x1f6d8, Hi: 0x1f6db, Stride: 1},
+		{Lo: 0x1f6ed, Hi: 0x1f6ef, Stride: 1},
+		{Lo: 0x1f6fd, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f777, Hi: 0x1f77a, Stride: 1},
+		{Lo: 0x1f7da, Hi: 0x1f7df, Stride: 1},
+		{Lo: 0x1f7ec, Hi: 0x1f7ef, Stride: 1},
+		{Lo: 0x1f7f1, Hi: 0x1f7ff, Stride: 1},
+		{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+		{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+		{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+		{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+		{Lo: 0x1f8ae, Hi: 0x1f8af, Stride: 1},
+		{Lo: 0x1f8b2, Hi: 0x1f8ff, Stride: 1},
+		{Lo: 0x1fa54, Hi: 0x1fa5f, Stride: 1},
+		{Lo: 0x1fa6e, Hi: 0x1fa6f, Stride: 1},
+		{Lo: 0x1fa7d, Hi: 0x1fa7f, Stride: 1},
+		{Lo: 0x1fa89, Hi: 0x1fa8f, Stride: 1},
+		{Lo: 0x1fabe, Hi: 0x1fac6, Stride: 8},
+		{Lo: 0x1fac7, Hi: 0x1facd, Stride: 1},
+		{Lo: 0x1fadc, Hi: 0x1fadf, Stride: 1},
+		{Lo: 0x1fae9, Hi: 0x1faef, Stride: 1},
+		{Lo: 0x1faf9, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fb93, Hi: 0x1fbcb, Stride: 56},
+		{Lo: 0x1fbcc, Hi: 0x1fbef, Stride: 1},
+		{Lo: 0x1fbfa, Hi: 0x1fffd, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+		{Lo: 0x40000, Hi: 0x4fffd, Stride: 1},
+		{Lo: 0x50000, Hi: 0x5fffd, Stride: 1},
+		{Lo: 0x60000, Hi: 0x6fffd, Stride: 1},
+		{Lo: 0x70000, Hi: 0x7fffd, Stride: 1},
+		{Lo: 0x80000, Hi: 0x8fffd, Stride: 1},
+		{Lo: 0x90000, Hi: 0x9fffd, Stride: 1},
+		{Lo: 0xa0000, Hi: 0xafffd, Stride: 1},
+		{Lo: 0xb0000, Hi: 0xbfffd, Stride: 1},
+		{Lo: 0xc0000, Hi: 0xcfffd, Stride: 1},
+		{Lo: 0xd0000, Hi: 0xdfffd, Stride: 1},
+		{Lo: 0xe1000, Hi: 0xefffd, Stride: 1},
+		{Lo: 0xf0000, Hi: 0xffffd, Stride: 1},
+		{Lo: 0x100000, Hi: 0x10fffd, Stride: 1},
+	},
+	LatinOffset: 6,
+}
+
+// BidiClass: LRE
+var BidiLRE = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x202a, Hi: 0x202a, Stride: 1},
+	},
+}
+
+// BidiClass: LRI
+var BidiLRI = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2066, Hi: 0x2066, Stride: 1},
+	},
+}
+
+// BidiClass: LRO
+var BidiLRO = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x202d, Hi: 0x202d, Stride: 1},
+	},
+}
+
+// BidiClass: NSM
+var BidiNSM = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0300, Hi: 0x036f, Stride: 1},
+		{Lo: 0x0483, Hi: 0x0489, Stride: 1},
+		{Lo: 0x0591, Hi: 0x05bd, Stride: 1},
+		{Lo: 0x05bf, Hi: 0x05c1, Stride: 2},
+		{Lo: 0x05c2, Hi: 0x05c4, Stride: 2},
+		{Lo: 0x05c5, Hi: 0x05c7, Stride: 2},
+		{Lo: 0x0610, Hi: 0x061a, Stride: 1},
+		{Lo: 0x064b, Hi: 0x065f, Stride: 1},
+		{Lo: 0x0670, Hi: 0x06d6, Stride: 102},
+		{Lo: 0x06d7, Hi: 0x06dc, Stride: 1},
+		{Lo: 0x06df, Hi: 0x06e4, Stride: 1},
+		{Lo: 0x06e7, Hi: 0x06e8, Stride: 1},
+		{Lo: 0x06ea, Hi: 0x06ed, Stride: 1},
+		{Lo: 0x0711, Hi: 0x0730, Stride: 31},
+		{Lo: 0x0731, Hi: 0x074a, Stride: 1},
+		{Lo: 0x07a6, Hi: 0x07b0, Stride: 1},
+		{Lo: 0x07eb, Hi: 0x07f3, Stride: 1},
+		{Lo: 0x07fd, Hi: 0x0816, Stride: 25},
+		{Lo: 0x0817, Hi: 0x0819, Stride: 1},
+		{Lo: 0x081b, Hi: 0x0823, Stride: 1},
+		{Lo: 0x0825, Hi: 0x0827, Stride: 1},
+		{Lo: 0x0829, Hi: 0x082d, Stride: 1},
+		{Lo: 0x0859, Hi: 0x085b, Stride: 1},
+		{Lo: 0x0898, Hi: 0x089f, Stride: 1},
+		{Lo: 0x08ca, Hi: 0x08e1, Stride: 1},
+		{Lo: 0x08e3, Hi: 0x0902, Stride: 1},
+		{Lo: 0x093a, Hi: 0x093c, Stride: 2},
+		{Lo: 0x0941, Hi: 0x0948, Stride: 1},
+		{Lo: 0x094d, Hi: 0x0951, Stride: 4},
+		{Lo: 0x0952, Hi: 0x0957, Stride: 1},
+		{Lo: 0x0962, Hi: 0x0963, Stride: 1},
+		{Lo: 0x0981, Hi: 0x09bc, Stride: 59},
+		{Lo: 0x09c1, Hi: 0x09c4, Stride: 1},
+		{Lo: 0x09cd, Hi: 0x09e2, Stride: 21},
+		{Lo: 0x09e3, Hi: 0x09fe, Stride: 27},
+		{Lo: 0x0a01, Hi: 0x0a02, Stride: 1},
+		{Lo: 0x0a3c, Hi: 0x0a41, Stride: 5},
+		{Lo: 0x0a42, Hi: 0x0a47, Stride: 5},
+		{Lo: 0x0a48, Hi: 0x0a4b, Stride: 3},
+		{Lo: 0x0a4c, Hi: 0x0a4d, Stride: 1},
+		{Lo: 0x0a51, Hi: 0x0a70, Stride: 31},
+		{Lo: 0x0a71, Hi: 0x0a75, Stride: 4},
+		{Lo: 0x0a81, Hi: 0x0a82, Stride: 1},
+		{Lo: 0x0abc, Hi: 0x0ac1, Stride: 5},
+		{Lo: 0x0ac2, Hi: 0x0ac5, Stride: 1},
+		{Lo: 0x0ac7, Hi: 0x0ac8, Stride: 1},
+		{Lo: 0x0acd, Hi: 0x0ae2, Stride: 21},
+		{Lo: 0x0ae3, Hi: 0x0afa, Stride: 23},
+		{Lo: 0x0afb, Hi: 0x0aff, Stride: 1},
+		{Lo: 0x0b01, Hi: 0x0b3c, Stride: 59},
+		{Lo: 0x0b3f, Hi: 0x0b41, Stride: 2},
+		{Lo: 0x0b42, Hi: 0x0b44, Stride: 1},
+		{Lo: 0x0b4d, Hi: 0x0b55, Stride: 8},
+		{Lo: 0x0b56, Hi: 0x0b62, Stride: 12},
+		{Lo: 0x0b63, Hi: 0x0b82, Stride: 31},
+		{Lo: 0x0bc0, Hi: 0x0bcd, Stride: 13},
+		{Lo: 0x0c00, Hi: 0x0c04, Stride: 4},
+		{Lo: 0x0c3c, Hi: 0x0c3e, Stride: 2},
+		{Lo: 0x0c3f, Hi: 0x0c40, Stride: 1},
+		{Lo: 0x0c46, Hi: 0x0c48, Stride: 1},
+		{Lo: 0x0c4a, Hi: 0x0c4d, Stride: 1},
+		{Lo: 0x0c55, Hi: 0x0c56, Stride: 1},
+		{Lo: 0x0c62, Hi: 0x0c63, Stride: 1},
+		{Lo: 0x0c81, Hi: 0x0cbc, Stride: 59},
+		{Lo: 0x0ccc, Hi: 0x0ccd, Stride: 1},
+		{Lo: 0x0ce2, Hi: 0x0ce3, Stride: 1},
+		{Lo: 0x0d00, Hi: 0x0d01, Stride: 1},
+		{Lo: 0x0d3b, Hi: 0x0d3c, Stride: 1},
+		{Lo: 0x0d41, Hi: 0x0d44, Stride: 1},
+		{Lo: 0x0d4d, Hi: 0x0d62, Stride: 21},
+		{Lo: 0x0d63, Hi: 0x0d81, Stride: 30},
+		{Lo: 0x0dca, Hi: 0x0dd2, Stride: 8},
+		{Lo: 0x0dd3, Hi: 0x0dd4, Stride: 1},
+		{Lo: 0x0dd6, Hi: 0x0e31, Stride: 91},
+		{Lo: 0x0e34, Hi: 0x0e3a, Stride: 1},
+		{Lo: 0x0e47, Hi: 0x0e4e, Stride: 1},
+		{Lo: 0x0eb1, Hi: 0x0eb4, Stride: 3},
+		{Lo: 0x0eb5, Hi: 0x0ebc, Stride: 1},
+		{Lo: 0x0ec8, Hi: 0x0ece, Stride: 1},
+		{Lo: 0x0f18, Hi: 0x0f19, Stride: 1},
+		{Lo: 0x0f35, Hi: 0x0f39, Stride: 2},
+		{Lo: 0x0f71, Hi: 0x0f7e, Stride: 1},
+		{Lo: 0x0f80, Hi: 0x0f84, Stride: 1},
+		{Lo: 0x0f86, Hi: 0x0f87, Stride: 1},
+		{Lo: 0x0f8d, Hi: 0x0f97, Stride: 1},
+		{Lo: 0x0f99, Hi: 0x0fbc, Stride: 1},
+		{Lo: 0x0fc6, Hi: 0x102d, Stride: 103},
+		{Lo: 0x102e, Hi: 0x1030, Stride: 1},
+		{Lo: 0x1032, Hi: 0x1037, Stride: 1},
+		{Lo: 0x1039, Hi: 0x103a, Stride: 1},
+		{Lo: 0x103d, Hi: 0x103e, Stride: 1},
+		{Lo: 0x1058, Hi: 0x1059, Stride: 1},
+		{Lo: 0x105e, Hi: 0x1060, Stride: 1},
+		{Lo: 0x1071, Hi: 0x1074, Stride: 1},
+		{Lo: 0x1082, Hi: 0x1085, Stride: 3},
+		{Lo: 0x1086, Hi: 0x108d, Stride: 7},
+		{Lo: 0x109d, Hi: 0x135d, Stride: 704},
+		{Lo: 0x135e, Hi: 0x135f, Stride: 1},
+		{Lo: 0x1712, Hi: 0x1714, Stride: 1},
+		{Lo: 0x1732, Hi: 0x1733, Stride: 1},
+		{Lo: 0x1752, Hi: 0x1753, Stride: 1},
+		{Lo: 0x1772, Hi: 0x1773, Stride: 1},
+		{Lo: 0x17b4, Hi: 0x17b5, Stride: 1},
+		{Lo: 0x17b7, Hi: 0x17bd, Stride: 1},
+		{Lo: 0x17c6, Hi: 0x17c9, Stride: 3},
+		{Lo: 0x17ca, Hi: 0x17d3, Stride: 1},
+		{Lo: 0x17dd, Hi: 0x180b, Stride: 46},
+		{Lo: 0x180c, Hi: 0x180d, Stride: 1},
+		{Lo: 0x180f, Hi: 0x1885, Stride: 118},
+		{Lo: 0x1886, Hi: 0x18a9, Stride: 35},
+		{Lo: 0x1920, Hi: 0x1922, Stride: 1},
+		{Lo: 0x1927, Hi: 0x1928, Stride: 1},
+		{Lo: 0x1932, Hi: 0x1939, Stride: 7},
+		{Lo: 0x193a, Hi: 0x193b, Stride: 1},
+		{Lo: 0x1a17, Hi: 0x1a18, Stride: 1},
+		{Lo: 0x1a1b, Hi: 0x1a56, Stride: 59},
+		{Lo: 0x1a58, Hi: 0x1a5e, Stride: 1},
+		{Lo: 0x1a60, Hi: 0x1a62, Stride: 2},
+		{Lo: 0x1a65, Hi: 0x1a6c, Stride: 1},
+		{Lo: 0x1a73, Hi: 0x1a7c, Stride: 1},
+		{Lo: 0x1a7f, Hi: 0x1ab0, Stride: 49},
+		{Lo: 0x1ab1, Hi: 0x1ace, Stride: 1},
+		{Lo: 0x1b00, Hi: 0x1b03, Stride: 1},
+		{Lo: 0x1b34, Hi: 0x1b36, Stride: 2},
+		{Lo: 0x1b37, Hi: 0x1b3a, Stride: 1},
+		{Lo: 0x1b3c, Hi: 0x1b42, Stride: 6},
+		{Lo: 0x1b6b, Hi: 0x1b73, Stride: 1},
+		{Lo: 0x1b80, Hi: 0x1b81, Stride: 1},
+		{Lo: 0x1ba2, Hi: 0x1ba5, Stride: 1},
+		{Lo: 0x1ba8, Hi: 0x1ba9, Stride: 1},
+		{Lo: 0x1bab, Hi: 0x1bad, Stride: 1},
+		{Lo: 0x1be6, Hi: 0x1be8, Stride: 2},
+		{Lo: 0x1be9, Hi: 0x1bed, Stride: 4},
+		{Lo: 0x1bef, Hi: 0x1bf1, Stride: 1},
+		{Lo: 0x1c2c, Hi: 0x1c33, Stride: 1},
+		{Lo: 0x1c36, Hi: 0x1c37, Stride: 1},
+		{Lo: 0x1cd0, Hi: 0x1cd2, Stride: 1},
+		{Lo: 0x1cd4, Hi: 0x1ce0, Stride: 1},
+		{Lo: 0x1ce2, Hi: 0x1ce8, Stride: 1},
+		{Lo: 0x1ced, Hi: 0x1cf4, Stride: 7},
+		{Lo: 0x1cf8, Hi: 0x1cf9, Stride: 1},
+		{Lo: 0x1dc0, Hi: 0x1dff, Stride: 1},
+		{Lo: 0x20d0, Hi: 0x20f0, Stride: 1},
+		{Lo: 0x2cef, Hi: 0x2cf1, Stride: 1},
+		{Lo: 0x2d7f, Hi: 0x2de0, Stride: 97},
+		{Lo: 0x2de1, Hi: 0x2dff, Stride: 1},
+		{Lo: 0x302a, Hi: 0x302d, Stride: 1},
+		{Lo: 0x3099, Hi: 0x309a, Stride: 1},
+		{Lo: 0xa66f, Hi: 0xa672, Stride: 1},
+		{Lo: 0xa674, Hi: 0xa67d, Stride: 1},
+		{Lo: 0xa69e, Hi: 0xa69f, Stride: 1},
+		{Lo: 0xa6f0, Hi: 0xa6f1, Stride: 1},
+		{Lo: 0xa802, Hi: 0xa806, Stride: 4},
+		{Lo: 0xa80b, Hi: 0xa825, Stride: 26},
+		{Lo: 0xa826, Hi: 0xa82c, Stride: 6},
+		{Lo: 0xa8c4, Hi: 0xa8c5, Stride: 1},
+		{Lo: 0xa8e0, Hi: 0xa8f1, Stride: 1},
+		{Lo: 0xa8ff, Hi: 0xa926, Stride: 39},
+		{Lo: 0xa927, Hi: 0xa92d, Stride: 1},
+		{Lo: 0xa947, Hi: 0xa951, Stride: 1},
+		{Lo: 0xa980, Hi: 0xa982, Stride: 1},
+		{Lo: 0xa9b3, Hi: 0xa9b6, Stride: 3},
+		{Lo: 0xa9b7, Hi: 0xa9b9, Stride: 1},
+		{Lo: 0xa9bc, Hi: 0xa9bd, Stride: 1},
+		{Lo: 0xa9e5, Hi: 0xaa29, Stride: 68},
+		{Lo: 0xaa2a, Hi: 0xaa2e, Stride: 1},
+		{Lo: 0xaa31, Hi: 0xaa32, Stride: 1},
+		{Lo: 0xaa35, Hi: 0xaa36, Stride: 1},
+		{Lo: 0xaa43, Hi: 0xaa4c, Stride: 9},
+		{Lo: 0xaa7c, Hi: 0xaab0, Stride: 52},
+		{Lo: 0xaab2, Hi: 0xaab4, Stride: 1},
+		{Lo: 0xaab7, Hi: 0xaab8, Stride: 1},
+		{Lo: 0xaabe, Hi: 0xaabf, Stride: 1},
+		{Lo: 0xaac1, Hi: 0xaaec, Stride: 43},
+		{Lo: 0xaaed, Hi: 0xaaf6, Stride: 9},
+		{Lo: 0xabe5, Hi: 0xabe8, Stride: 3},
+		{Lo: 0xabed, Hi: 0xfb1e, Stride: 20273},
+		{Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
+		{Lo: 0xfe20, Hi: 0xfe2f, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x101fd, Hi: 0x102e0, Stride: 227},
+		{Lo: 0x10376, Hi: 0x1037a, Stride: 1},
+		{Lo: 0x10a01, Hi: 0x10a03, Stride: 1},
+		{Lo: 0x10a05, Hi: 0x10a06, Stride: 1},
+		{Lo: 0x10a0c, Hi: 0x10a0f, Stride: 1},
+		{Lo: 0x10a38, Hi: 0x10a3a, Stride: 1},
+		{Lo: 0x10a3f, Hi: 0x10ae5, Stride: 166},
+		{Lo: 0x10ae6, Hi: 0x10d24, Stride: 574},
+		{Lo: 0x10d25, Hi: 0x10d27, Stride: 1},
+		{Lo: 0x10eab, Hi: 0x10eac, Stride: 1},
+		{Lo: 0x10efd, Hi: 0x10eff, Stride: 1},
+		{Lo: 0x10f46, Hi: 0x10f50, Stride: 1},
+		{Lo: 0x10f82, Hi: 0x10f85, Stride: 1},
+		{Lo: 0x11001, Hi: 0x11038, Stride: 55},
+		{Lo: 0x11039, Hi: 0x11046, Stride: 1},
+		{Lo: 0x11070, Hi: 0x11073, Stride: 3},
+		{Lo: 0x11074, Hi: 0x1107f, Stride: 11},
+		{Lo: 0x11080, Hi: 0x11081, Stride: 1},
+		{Lo: 0x110b3, Hi: 0x110b6, Stride: 1},
+		{Lo: 0x110b9, Hi: 0x110ba, Stride: 1},
+		{Lo: 0x110c2, Hi: 0x11100, Stride: 62},
+		{Lo: 0x11101, Hi: 0x11102, Stride: 1},
+		{Lo: 0x11127, Hi: 0x1112b, Stride: 1},
+		{Lo: 0x1112d, Hi: 0x11134, Stride: 1},
+		{Lo: 0x11173, Hi: 0x11180, Stride: 13},
+		{Lo: 0x11181, Hi: 0x111b6, Stride: 53},
+		{Lo: 0x111b7, Hi: 0x111be, Stride: 1},
+		{Lo: 0x111c9, Hi: 0x111cc, Stride: 1},
+		{Lo: 0x111cf, Hi: 0x1122f, Stride: 96},
+		{Lo: 0x11230, Hi: 0x11231, Stride: 1},
+		{Lo: 0x11234, Hi: 0x11236, Stride: 2},
+		{Lo: 0x11237, Hi: 0x1123e, Stride: 7},
+		{Lo: 0x11241, Hi: 0x112df, Stride: 158},
+		{Lo: 0x112e3, Hi: 0x112ea, Stride: 1},
+		{Lo: 0x11300, Hi: 0x11301, Stride: 1},
+		{Lo: 0x1133b, Hi: 0x1133c, Stride: 1},
+		{Lo: 0x11340, Hi: 0x11366, Stride: 38},
+		{Lo: 0x11367, Hi: 0x1136c, Stride: 1},
+		{Lo: 0x11370, Hi: 0x11374, Stride: 1},
+		{Lo: 0x11438, Hi: 0x1143f, Stride: 1},
+		{Lo: 0x11442, Hi: 0x11444, Stride: 1},
+		{Lo: 0x11446, Hi: 0x1145e, Stride: 24},
+		{Lo: 0x114b3, Hi: 0x114b8, Stride: 1},
+		{Lo: 0x114ba, Hi: 0x114bf, Stride: 5},
+		{Lo: 0x114c0, Hi: 0x114c2, Stride: 2},
+		{Lo: 0x114c3, Hi: 0x115b2, Stride: 239},
+		{Lo: 0x115b3, Hi: 0x115b5, Stride: 1},
+		{Lo: 0x115bc, Hi: 0x115bd, Stride: 1},
+		{Lo: 0x115bf, Hi: 0x115c0, Stride: 1},
+		{Lo: 0x115dc, Hi: 0x115dd, Stride: 1},
+		{Lo: 0x11633, Hi: 0x1163a, Stride: 1},
+		{Lo: 0x1163d, Hi: 0x1163f, Stride: 2},
+		{Lo: 0x11640, Hi: 0x116ab, Stride: 107},
+		{Lo: 0x116ad, Hi: 0x116b0, Stride: 3},
+		{Lo: 0x116b1, Hi: 0x116b5, Stride: 1},
+		{Lo: 0x116b7, Hi: 0x1171d, Stride: 102},
+		{Lo: 0x1171e, Hi: 0x1171f, Stride: 1},
+		{Lo: 0x11722, Hi: 0x11725, Stride: 1},
+		{Lo: 0x11727, Hi: 0x1172b, Stride: 1},
+		{Lo: 0x1182f, Hi: 0x11837, Stride: 1},
+		{Lo: 0x11839, Hi: 0x1183a, Stride: 1},
+		{Lo: 0x1193b, Hi: 0x1193c, Stride: 1},
+		{Lo: 0x1193e, Hi: 0x11943, Stride: 5},
+		{Lo: 0x119d4, Hi: 0x119d7, Stride: 1},
+		{Lo: 0x119da, Hi: 0x119db, Stride: 1},
+		{Lo: 0x119e0, Hi: 0x11a01, Stride: 33},
+		{Lo: 0x11a02, Hi: 0x11a06, Stride: 1},
+		{Lo: 0x11a09, Hi: 0x11a0a, Stride: 1},
+		{Lo: 0x11a33, Hi: 0x11a38, Stride: 1},
+		{Lo: 0x11a3b, Hi: 0x11a3e, Stride: 1},
+		{Lo: 0x11a47, Hi: 0x11a51, Stride: 10},
+		{Lo: 0x11a52, Hi: 0x11a56, Stride: 1},
+		{Lo: 0x11a59, Hi: 0x11a5b, Stride: 1},
+		{Lo: 0x11a8a, Hi: 0x11a96, Stride: 1},
+		{Lo: 0x11a98, Hi: 0x11a99, Stride: 1},
+		{Lo: 0x11c30, Hi: 0x11c36, Stride: 1},
+		{Lo: 0x11c38, Hi: 0x11c3d, Stride: 1},
+		{Lo: 0x11c92, Hi: 0x11ca7, Stride: 1},
+		{Lo: 0x11caa, Hi: 0x11cb0, Stride: 1},
+		{Lo: 0x11cb2, Hi: 0x11cb3, Stride: 1},
+		{Lo: 0x11cb5, Hi: 0x11cb6, Stride: 1},
+		{Lo: 0x11d31, Hi: 0x11d36, Stride: 1},
+		{Lo: 0x11d3a, Hi: 0x11d3c, Stride: 2},
+		{Lo: 0x11d3d, Hi: 0x11d3f, Stride: 2},
+		{Lo: 0x11d40, Hi: 0x11d45, Stride: 1},
+		{Lo: 0x11d47, Hi: 0x11d90, Stride: 73},
+		{Lo: 0x11d91, Hi: 0x11d95, Stride: 4},
+		{Lo: 0x11d97, Hi: 0x11ef3, Stride: 348},
+		{Lo: 0x11ef4, Hi: 0x11f00, Stride: 12},
+		{Lo: 0x11f01, Hi: 0x11f36, Stride: 53},
+		{Lo: 0x11f37, Hi: 0x11f3a, Stride: 1},
+		{Lo: 0x11f40, Hi: 0x11f42, Stride: 2},
+		{Lo: 0x13440, Hi: 0x13447, Stride: 7},
+		{Lo: 0x13448, Hi: 0x13455, Stride: 1},
+		{Lo: 0x16af0, Hi: 0x16af4, Stride: 1},
+		{Lo: 0x16b30, Hi: 0x16b36, Stride: 1},
+		{Lo: 0x16f4f, Hi: 0x16f8f, Stride: 64},
+		{Lo: 0x16f90, Hi: 0x16f92, Stride: 1},
+		{Lo: 0x16fe4, Hi: 0x1bc9d, Stride: 19641},
+		{Lo: 0x1bc9e, Hi: 0x1cf00, Stride: 4706},
+		{Lo: 0x1cf01, Hi: 0x1cf2d, Stride: 1},
+		{Lo: 0x1cf30, Hi: 0x1cf46, Stride: 1},
+		{Lo: 0x1d167, Hi: 0x1d169, Stride: 1},
+		{Lo: 0x1d17b, Hi: 0x1d182, Stride: 1},
+		{Lo: 0x1d185, Hi: 0x1d18b, Stride: 1},
+		{Lo: 0x1d1aa, Hi: 0x1d1ad, Stride: 1},
+		{Lo: 0x1d242, Hi: 0x1d244, Stride: 1},
+		{Lo: 0x1da00, Hi: 0x1da36, Stride: 1},
+		{Lo: 0x1da3b, Hi: 0x1da6c, Stride: 1},
+		{Lo: 0x1da75, Hi: 0x1da84, Stride: 15},
+		{Lo: 0x1da9b, Hi: 0x1da9f, Stride: 1},
+		{Lo: 0x1daa1, Hi: 0x1daaf, Stride: 1},
+		{Lo: 0x1e000, Hi: 0x1e006, Stride: 1},
+		{Lo: 0x1e008, Hi: 0x1e018, Stride: 1},
+		{Lo: 0x1e01b, Hi: 0x1e021, Stride: 1},
+		{Lo: 0x1e023, Hi: 0x1e024, Stride: 1},
+		{Lo: 0x1e026, Hi: 0x1e02a, Stride: 1},
+		{Lo: 0x1e08f, Hi: 0x1e130, Stride: 161},
+		{Lo: 0x1e131, Hi: 0x1e136, Stride: 1},
+		{Lo: 0x1e2ae, Hi: 0x1e2ec, Stride: 62},
+		{Lo: 0x1e2ed, Hi: 0x1e2ef, Stride: 1},
+		{Lo: 0x1e4ec, Hi: 0x1e4ef, Stride: 1},
+		{Lo: 0x1e8d0, Hi: 0x1e8d6, Stride: 1},
+		{Lo: 0x1e944, Hi: 0x1e94a, Stride: 1},
+		{Lo: 0xe0100, Hi: 0xe01ef, Stride: 1},
+	},
+}
+
+// BidiClass: ON
+var BidiON = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0021, Hi: 0x0022, Stride: 1},
+		{Lo: 0x0026, Hi: 0x002a, Stride: 1},
+		{Lo: 0x003b, Hi: 0x0040, Stride: 1},
+		{Lo: 0x005b, Hi: 0x0060, Stride: 1},
+		{Lo: 0x007b, Hi: 0x007e, Stride: 1},
+		{Lo: 0x00a1, Hi: 0x00a6, Stride: 5},
+		{Lo: 0x00a7, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ab, Hi: 0x00ac, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00af, Stride: 1},
+		{Lo: 0x00b4, Hi: 0x00b6, Stride: 2},
+		{Lo: 0x00b7, Hi: 0x00b8, Stride: 1},
+		{Lo: 0x00bb, Hi: 0x00bf, Stride: 1},
+		{Lo: 0x00d7, Hi: 0x00f7, Stride: 32},
+		{Lo: 0x02b9, Hi: 0x02ba, Stride: 1},
+		{Lo: 0x02c2, Hi: 0x02cf, Stride: 1},
+		{Lo: 0x02d2, Hi: 0x02df, Stride: 1},
+		{Lo: 0x02e5, Hi: 0x02ed, Stride: 1},
+		{Lo: 0x02ef, Hi: 0x02ff, Stride: 1},
+		{Lo: 0x0374, Hi: 0x0375, Stride: 1},
+		{Lo: 0x037e, Hi: 0x0384, Stride: 6},
+		{Lo: 0x0385, Hi: 0x0387, Stride: 2},
+		{Lo: 0x03f6, Hi: 0x058a, Stride: 404},
+		{Lo: 0x058d, Hi: 0x058e, Stride: 1},
+		{Lo: 0x0606, Hi: 0x0607, Stride: 1},
+		{Lo: 0x060e, Hi: 0x060f, Stride: 1},
+		{Lo: 0x06de, Hi: 0x06e9, Stride: 11},
+		{Lo: 0x07f6, Hi: 0x07f9, Stride: 1},
+		{Lo: 0x0bf3, Hi: 0x0bf8, Stride: 1},
+		{Lo: 0x0bfa, Hi: 0x0c78, Stride: 126},
+		{Lo: 0x0c79, Hi: 0x0c7e, Stride: 1},
+		{Lo: 0x0f3a, Hi: 0x0f3d, Stride: 1},
+		{Lo: 0x1390, Hi: 0x1399, Stride: 1},
+		{Lo: 0x1400, Hi: 0x169b, Stride: 667},
+		{Lo: 0x169c, Hi: 0x17f0, Stride: 340},
+		{Lo: 0x17f1, Hi: 0x17f9, Stride: 1},
+		{Lo: 0x1800, Hi: 0x180a, Stride: 1},
+		{Lo: 0x1940, Hi: 0x1944, Stride: 4},
+		{Lo: 0x1945, Hi: 0x19de, Stride: 153},
+		{Lo: 0x19df, Hi: 0x19ff, Stride: 1},
+		{Lo: 0x1fbd, Hi: 0x1fbf, Stride: 2},
+		{Lo: 0x1fc0, Hi: 0x1fc1, Stride: 1},
+		{Lo: 0x1fcd, Hi: 0x1fcf, Stride: 1},
+		{Lo: 0x1fdd, Hi: 0x1fdf, Stride: 1},
+		{Lo: 0x1fed, Hi: 0x1fef, Stride: 1},
+		{Lo: 0x1ffd, Hi: 0x1ffe, Stride: 1},
+		{Lo: 0x2010, Hi: 0x2027, Stride: 1},
+		{Lo: 0x2035, Hi: 0x2043, Stride: 1},
+		{Lo: 0x2045, Hi: 0x205e, Stride: 1},
+		{Lo: 0x207c, Hi: 0x207e, Stride: 1},
+		{Lo: 0x208c, Hi: 0x208e, Stride: 1},
+		{Lo: 0x2100, Hi: 0x2101, Stride: 1},
+		{Lo: 0x2103, Hi: 0x2106, Stride: 1},
+		{Lo: 0x2108, Hi: 0x2109, Stride: 1},
+		{Lo: 0x2114, Hi: 0x2116, Stride: 2},
+		{Lo: 0x2117, Hi: 0x2118, Stride: 1},
+		{Lo: 0x211e, Hi: 0x2123, Stride: 1},
+		{Lo: 0x2125, Hi: 0x2129, Stride: 2},
+		{Lo: 0x213a, Hi: 0x213b, Stride: 1},
+		{Lo: 0x2140, Hi: 0x2144, Stride: 1},
+		{Lo: 0x214a, Hi: 0x214d, Stride: 1},
+		{Lo: 0x2150, Hi: 0x215f, Stride: 1},
+		{Lo: 0x2189, Hi: 0x218b, Stride: 1},
+		{Lo: 0x2190, Hi: 0x2211, Stride: 1},
+		{Lo: 0x2214, Hi: 0x2335, Stride: 1},
+		{Lo: 0x237b, Hi: 0x2394, Stride: 1},
+		{Lo: 0x2396, Hi: 0x2426, Stride: 1},
+		{Lo: 0x2440, Hi: 0x244a, Stride: 1},
+		{Lo: 0x2460, Hi: 0x2487, Stride: 1},
+		{Lo: 0x24ea, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26ad, Hi: 0x27ff, Stride: 1},
+		{Lo: 0x2900, Hi: 0x2b73, Stride: 1},
+		{Lo: 0x2b76, Hi: 0x2b95, Stride: 1},
+		{Lo: 0x2b97, Hi: 0x2bff, Stride: 1},
+		{Lo: 0x2ce5, Hi: 0x2cea, Stride: 1},
+		{Lo: 0x2cf9, Hi: 0x2cff, Stride: 1},
+		{Lo: 0x2e00, Hi: 0x2e5d, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x2e99, Stride: 1},
+		{Lo: 0x2e9b, Hi: 0x2ef3, Stride: 1},
+		{Lo: 0x2f00, Hi: 0x2fd5, Stride: 1},
+		{Lo: 0x2ff0, Hi: 0x2ffb, Stride: 1},
+		{Lo: 0x3001, Hi: 0x3004, Stride: 1},
+		{Lo: 0x3008, Hi: 0x3020, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3036, Stride: 6},
+		{Lo: 0x3037, Hi: 0x303d, Stride: 6},
+		{Lo: 0x303e, Hi: 0x303f, Stride: 1},
+		{Lo: 0x309b, Hi: 0x309c, Stride: 1},
+		{Lo: 0x30a0, Hi: 0x30fb, Stride: 91},
+		{Lo: 0x31c0, Hi: 0x31e3, Stride: 1},
+		{Lo: 0x321d, Hi: 0x321e, Stride: 1},
+		{Lo: 0x3250, Hi: 0x325f, Stride: 1},
+		{Lo: 0x327c, Hi: 0x327e, Stride: 1},
+		{Lo: 0x32b1, Hi: 0x32bf, Stride: 1},
+		{Lo: 0x32cc, Hi: 0x32cf, Stride: 1},
+		{Lo: 0x3377, Hi: 0x337a, Stride: 1},
+		{Lo: 0x33de, Hi: 0x33df, Stride: 1},
+		{Lo: 0x33ff, Hi: 0x4dc0, Stride: 6593},
+		{Lo: 0x4dc1, Hi: 0x4dff, Stride: 1},
+		{Lo: 0xa490, Hi: 0xa4c6, Stride: 1},
+		{Lo: 0xa60d, Hi: 0xa60f, Stride: 1},
+		{Lo: 0xa673, Hi: 0xa67e, Stride: 11},
+		{Lo: 0xa67f, Hi: 0xa700, Stride: 129},
+		{Lo: 0xa701, Hi: 0xa721, Stride: 1},
+		{Lo: 0xa788, Hi: 0xa828, Stride: 160},
+		{Lo: 0xa829, Hi: 0xa82b, Stride: 1},
+		{Lo: 0xa874, Hi: 0xa877, Stride: 1},
+		{Lo: 0xab6a, Hi: 0xab6b, Stride: 1},
+		{Lo: 0xfd3e, Hi: 0xfd4f, Stride: 1},
+		{Lo: 0xfdcf, Hi: 0xfdfd, Stride: 46},
+		{Lo: 0xfdfe, Hi: 0xfdff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe4f, Stride: 1},
+		{Lo: 0xfe51, Hi: 0xfe54, Stride: 3},
+		{Lo: 0xfe56, Hi: 0xfe5e, Stride: 1},
+		{Lo: 0xfe60, Hi: 0xfe61, Stride: 1},
+		{Lo: 0xfe64, Hi: 0xfe66, Stride: 1},
+		{Lo: 0xfe68, Hi: 0xfe6b, Stride: 3},
+		{Lo: 0xff01, Hi: 0xff02, Stride: 1},
+		{Lo: 0xff06, Hi: 0xff0a, Stride: 1},
+		{Lo: 0xff1b, Hi: 0xff20, Stride: 1},
+		{Lo: 0xff3b, Hi: 0xff40, Stride: 1},
+		{Lo: 0xff5b, Hi: 0xff65, Stride: 1},
+		{Lo: 0xffe2, Hi: 0xffe4, Stride: 1},
+		{Lo: 0xffe8, Hi: 0xffee, Stride: 1},
+		{Lo: 0xfff9, Hi: 0xfffd, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10101, Hi: 0x10140, Stride: 63},
+		{Lo: 0x10141, Hi: 0x1018c, Stride: 1},
+		{Lo: 0x10190, Hi: 0x1019c, Stride: 1},
+		{Lo: 0x101a0, Hi: 0x1091f, Stride: 1919},
+		{Lo: 0x10b39, Hi: 0x10b3f, Stride: 1},
+		{Lo: 0x11052, Hi: 0x11065, Stride: 1},
+		{Lo: 0x11660, Hi: 0x1166c, Stride: 1},
+		{Lo: 0x11fd5, Hi: 0x11fdc, Stride: 1},
+		{Lo: 0x11fe1, Hi: 0x11ff1, Stride: 1},
+		{Lo: 0x16fe2, Hi: 0x1d1e9, Stride: 25095},
+		{Lo: 0x1d1ea, Hi: 0x1d200, Stride: 22},
+		{Lo: 0x1d201, Hi: 0x1d241, Stride: 1},
+		{Lo: 0x1d245, Hi: 0x1d300, Stride: 187},
+		{Lo: 0x1d301, Hi: 0x1d356, Stride: 1},
+		{Lo: 0x1d6db, Hi: 0x1d7c3, Stride: 58},
+		{Lo: 0x1eef0, Hi: 0x1eef1, Stride: 1},
+		{Lo: 0x1f000, Hi: 0x1f02b, Stride: 1},
+		{Lo: 0x1f030, Hi: 0x1f093, Stride: 1},
+		{Lo: 0x1f0a0, Hi: 0x1f0ae, Stride: 1},
+		{Lo: 0x1f0b1, Hi: 0x1f0bf, Stride: 1},
+		{Lo: 0x1f0c1, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f0d1, Hi: 0x1f0f5, Stride: 1},
+		{Lo: 0x1f10b, Hi: 0x1f10f, Stride: 1},
+		{Lo: 0x1f12f, Hi: 0x1f16a, Stride: 59},
+		{Lo: 0x1f16b, Hi: 0x1f16f, Stride: 1},
+		{Lo: 0x1f1ad, Hi: 0x1f260, Stride: 179},
+		{Lo: 0x1f261, Hi: 0x1f265, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f6d7, Stride: 1},
+		{Lo: 0x1f6dc, Hi: 0x1f6ec, Stride: 1},
+		{Lo: 0x1f6f0, Hi: 0x1f6fc, Stride: 1},
+		{Lo: 0x1f700, Hi: 0x1f776, Stride: 1},
+		{Lo: 0x1f77b, Hi: 0x1f7d9, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f7f0, Hi: 0x1f800, Stride: 16},
+		{Lo: 0x1f801, Hi: 0x1f80b, Stride: 1},
+		{Lo: 0x1f810, Hi: 0x1f847, Stride: 1},
+		{Lo: 0x1f850, Hi: 0x1f859, Stride: 1},
+		{Lo: 0x1f860, Hi: 0x1f887, Stride: 1},
+		{Lo: 0x1f890, Hi: 0x1f8ad, Stride: 1},
+		{Lo: 0x1f8b0, Hi: 0x1f8b1, Stride: 1},
+		{Lo: 0x1f900, Hi: 0x1fa53, Stride: 1},
+		{Lo: 0x1fa60, Hi: 0x1fa6d, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1fa7c, Stride: 1},
+		{Lo: 0x1fa80, Hi: 0x1fa88, Stride: 1},
+		{Lo: 0x1fa90, Hi: 0x1fabd, Stride: 1},
+		{Lo: 0x1fabf, Hi: 0x1fac5, Stride: 1},
+		{Lo: 0x1face, Hi: 0x1fadb, Stride: 1},
+		{Lo: 0x1fae0, Hi: 0x1fae8, Stride: 1},
+		{Lo: 0x1faf0, Hi: 0x1faf8, Stride: 1},
+		{Lo: 0x1fb00, Hi: 0x1fb92, Stride: 1},
+		{Lo: 0x1fb94, Hi: 0x1fbca, Stride: 1},
+	},
+	LatinOffset: 13,
+}
+
+// BidiClass: PDF
+var BidiPDF = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x202c, Hi: 0x202c, Stride: 1},
+	},
+}
+
+// BidiClass: PDI
+var BidiPDI = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2069, Hi: 0x2069, Stride: 1},
+	},
+}
+
+// BidiClass: R
+var BidiR = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0590, Hi: 0x05be, Stride: 46},
+		{Lo: 0x05c0, Hi: 0x05c6, Stride: 3},
+		{Lo: 0x05c8, Hi: 0x05ff, Stride: 1},
+		{Lo: 0x07c0, Hi: 0x07ea, Stride: 1},
+		{Lo: 0x07f4, Hi: 0x07f5, Stride: 1},
+		{Lo: 0x07fa, Hi: 0x07fc, Stride: 1},
+		{Lo: 0x07fe, Hi: 0x0815, Stride: 1},
+		{Lo: 0x081a, Hi: 0x0824, Stride: 10},
+		{Lo: 0x0828, Hi: 0x082e, Stride: 6},
+		{Lo: 0x082f, Hi: 0x0858, Stride: 1},
+		{Lo: 0x085c, Hi: 0x085f, Stride: 1},
+		{Lo: 0x086b, Hi: 0x086f, Stride: 1},
+		{Lo: 0x088f, Hi: 0x0892, Stride: 3},
+		{Lo: 0x0893, Hi: 0x0897, Stride: 1},
+		{Lo: 0x200f, Hi: 0xfb1d, Stride: 56078},
+		{Lo: 0xfb1f, Hi: 0xfb28, Stride: 1},
+		{Lo: 0xfb2a, Hi: 0xfb4f, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10800, Hi: 0x1091e, Stride: 1},
+		{Lo: 0x10920, Hi: 0x10a00, Stride: 1},
+		{Lo: 0x10a04, Hi: 0x10a07, Stride: 3},
+		{Lo: 0x10a08, Hi: 0x10a0b, Stride: 1},
+		{Lo: 0x10a10, Hi: 0x10a37, Stride: 1},
+		{Lo: 0x10a3b, Hi: 0x10a3e, Stride: 1},
+		{Lo: 0x10a40, Hi: 0x10ae4, Stride: 1},
+		{Lo: 0x10ae7, Hi: 0x10b38, Stride: 1},
+		{Lo: 0x10b40, Hi: 0x10cff, Stride: 1},
+		{Lo: 0x10d28, Hi: 0x10d2f, Stride: 1},
+		{Lo: 0x10d3a, Hi: 0x10e5f, Stride: 1},
+		{Lo: 0x10e7f, Hi: 0x10eaa, Stride: 1},
+		{Lo: 0x10ead, Hi: 0x10efc, Stride: 1},
+		{Lo: 0x10f00, Hi: 0x10f2f, Stride: 1},
+		{Lo: 0x10f5a, Hi: 0x10f81, Stride: 1},
+		{Lo: 0x10f86, Hi: 0x10fff, Stride: 1},
+		{Lo: 0x1e800, Hi: 0x1e8cf, Stride: 1},
+		{Lo: 0x1e8d7, Hi: 0x1e943, Stride: 1},
+		{Lo: 0x1e94b, Hi: 0x1ec70, Stride: 1},
+		{Lo: 0x1ecb5, Hi: 0x1ed00, Stride: 1},
+		{Lo: 0x1ed3e, Hi: 0x1edff, Stride: 1},
+		{Lo: 0x1ef00, Hi: 0x1efff, Stride: 1},
+	},
+}
+
+// BidiClass: RLE
+var BidiRLE = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x202b, Hi: 0x202b, Stride: 1},
+	},
+}
+
+// BidiClass: RLI
+var BidiRLI = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2067, Hi: 0x2067, Stride: 1},
+	},
+}
+
+// BidiClass: RLO
+var BidiRLO = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x202e, Hi: 0x202e, Stride: 1},
+	},
+}
+
+// BidiClass: S
+var BidiS = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0009, Hi: 0x000b, Stride: 2},
+		{Lo: 0x001f, Hi: 0x001f, Stride: 1},
+	},
+	LatinOffset: 2,
+}
+
+// BidiClass: WS
+var BidiWS = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x000c, Hi: 0x0020, Stride: 20},
+		{Lo: 0x1680, Hi: 0x2000, Stride: 2432},
+		{Lo: 0x2001, Hi: 0x200a, Stride: 1},
+		{Lo: 0x2028, Hi: 0x205f, Stride: 55},
+		{Lo: 0x3000, Hi: 0x3000, Stride: 1},
+	},
+	LatinOffset: 1,
+}
+
+var bidiClasses = [...]*unicode.RangeTable{
+	BidiAL,  // AL
+	BidiAN,  // AN
+	BidiB,   // B
+	BidiBN,  // BN
+	BidiCS,  // CS
+	BidiEN,  // EN
+	BidiES,  // ES
+	BidiET,  // ET
+	BidiFSI, // FSI
+	BidiLRE, // LRE
+	BidiLRI, // LRI
+	BidiLRO, // LRO
+	BidiNSM, // NSM
+	BidiON,  // ON
+	BidiPDF, // PDF
+	BidiPDI, // PDI
+	BidiR,   // R
+	BidiRLE, // RLE
+	BidiRLI, // RLI
+	BidiRLO, // RLO
+	BidiS,   // S
+	BidiWS,  // WS
+}
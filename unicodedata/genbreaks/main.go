@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+// Command genbreaks generates the segmentation property tables of the
+// unicodedata package (line, grapheme, word and sentence breaks)
+// from a release of the Unicode Character Database.
+//
+// It is run from the unicodedata directory with
+//
+//	go run ./genbreaks [-ucd version] [-dir path]
+//
+// By default, the files of the release [unicodedata.UnicodeVersion] are downloaded
+// from unicode.org : to update the tables, change UnicodeVersion and run go generate.
+// The -dir flag reads the files from a local copy of the UCD instead.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-text/typesetting/unicodedata"
+)
+
+var (
+	ucdVersion = flag.String("ucd", unicodedata.UnicodeVersion, "version of the Unicode Character Database")
+	ucdDir     = flag.String("dir", "", "directory containing the UCD files, instead of downloading them")
+	outDir     = flag.String("out", ".", "directory of the generated files")
+)
+
+// lineBreakClasses are the line breaking classes, in the order
+// of the lineBreaks array
+var lineBreakClasses = [...][2]string{
+	{"BK", "Mandatory Break"},
+	{"CR", "Carriage Return"},
+	{"LF", "Line Feed"},
+	{"NL", "Next Line"},
+	{"SP", "Space"},
+	{"NU", "Numeric"},
+	{"AL", "Alphabetic"},
+	{"IS", "Infix Numeric Separator"},
+	{"PR", "Prefix Numeric"},
+	{"PO", "Postfix Numeric"},
+	{"OP", "Open Punctuation"},
+	{"CL", "Close Punctuation"},
+	{"CP", "Close Parenthesis"},
+	{"QU", "Quotation"},
+	{"HY", "Hyphen"},
+	{"SG", "Surrogate"},
+	{"GL", `Non-breaking ("Glue")`},
+	{"NS", "Nonstarter"},
+	{"EX", "Exclamation/Interrogation"},
+	{"SY", "Symbols Allowing Break After"},
+	{"HL", "Hebrew Letter"},
+	{"ID", "Ideographic"},
+	{"IN", "Inseparable"},
+	{"BA", "Break After"},
+	{"BB", "Break Before"},
+	{"B2", "Break Opportunity Before and After"},
+	{"ZW", "Zero Width Space"},
+	{"CM", "Combining Mark"},
+	{"EB", "Emoji Base"},
+	{"EM", "Emoji Modifier"},
+	{"WJ", "Word Joiner"},
+	{"ZWJ", "Zero width joiner"},
+	{"H2", "Hangul LV Syllable"},
+	{"H3", "Hangul LVT Syllable"},
+	{"JL", "Hangul L Jamo"},
+	{"JV", "Hangul V Jamo"},
+	{"JT", "Hangul T Jamo"},
+	{"RI", "Regional Indicator"},
+	{"CB", "Contingent Break Opportunity"},
+	{"AI", "Ambiguous (Alphabetic or Ideographic)"},
+	{"CJ", "Conditional Japanese Starter"},
+	{"SA", "Complex Context Dependent (South East Asian)"},
+	{"XX", "Unknown"},
+}
+
+func main() {
+	flag.Parse()
+
+	props, err := fetchProperties("LineBreak.txt")
+	check(err)
+	content, err := lineBreakTables(props)
+	check(err)
+	check(writeFile("linebreak.go", content))
+
+	for _, kind := range [...]string{"Grapheme", "Word", "Sentence"} {
+		props, err := fetchProperties("auxiliary/" + kind + "BreakProperty.txt")
+		check(err)
+		check(writeFile(strings.ToLower(kind[:1])+kind[1:]+"Break.go", segmentationTables(kind, props)))
+	}
+}
+
+func check(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// fetchProperties returns the runes of each property value
+// defined in the UCD file [name].
+func fetchProperties(name string) (map[string][]rune, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if *ucdDir != "" {
+		data, err = ioutil.ReadFile(filepath.Join(*ucdDir, filepath.FromSlash(name)))
+	} else {
+		data, err = download(fmt.Sprintf("https://www.unicode.org/Public/%s/ucd/%s", *ucdVersion, name))
+	}
+	if err != nil {
+		return nil, err
+	}
+	props, err := parseProperties(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	return props, nil
+}
+
+func download(url string) ([]byte, error) {
+	log.Println("downloading", url)
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// parseProperties parses the lines of a UCD file with the format
+// <code point or range> ; <property value> # <comment>
+func parseProperties(data []byte) (map[string][]rune, error) {
+	out := map[string][]rune{}
+	for i, line := range strings.Split(string(data), "\n") {
+		if index := strings.IndexByte(line, '#'); index != -1 {
+			line = line[:index]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: invalid line %q", i+1, line)
+		}
+		codes := strings.TrimSpace(fields[0])
+		start, end := codes, codes
+		if index := strings.Index(codes, ".."); index != -1 {
+			start, end = codes[:index], codes[index+2:]
+		}
+		lo, err := strconv.ParseUint(start, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+		hi, err := strconv.ParseUint(end, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+		prop := strings.TrimSpace(fields[1])
+		for r := rune(lo); r <= rune(hi); r++ {
+			out[prop] = append(out[prop], r)
+		}
+	}
+	return out, nil
+}
+
+func lineBreakTables(props map[string][]rune) (*bytes.Buffer, error) {
+	known := map[string]bool{}
+	for _, class := range lineBreakClasses {
+		known[class[0]] = true
+	}
+	for prop := range props {
+		if !known[prop] {
+			return nil, fmt.Errorf("LineBreak.txt: unsupported class %s", prop)
+		}
+	}
+
+	w := newFile()
+	for _, class := range lineBreakClasses {
+		writeTable(w, class[1], "Break"+class[0], rangeTable(props[class[0]]))
+	}
+	fmt.Fprintln(w, "var lineBreaks = [...]*unicode.RangeTable{")
+	for _, class := range lineBreakClasses {
+		fmt.Fprintf(w, "Break%s, // %s\n", class[0], class[0])
+	}
+	fmt.Fprintln(w, "}")
+	return w, nil
+}
+
+// segmentationTables returns the tables of the property
+// <kind>BreakProperty, defined in UAX #29.
+func segmentationTables(kind string, props map[string][]rune) *bytes.Buffer {
+	values := make([]string, 0, len(props))
+	for value := range props {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	w := newFile()
+	if kind == "Sentence" {
+		fmt.Fprintln(w, "// STerm is the same as SentenceBreakSTerm.")
+		fmt.Fprintln(w, "var STerm = SentenceBreakSTerm")
+		fmt.Fprintln(w)
+	}
+	var all []rune
+	for _, value := range values {
+		writeTable(w, kind+"BreakProperty: "+value, kind+"Break"+value, rangeTable(props[value]))
+		all = append(all, props[value]...)
+	}
+	lower := strings.ToLower(kind[:1]) + kind[1:]
+	writeTable(w, "contains all the runes having a non nil "+strings.ToLower(kind)+" break property", lower+"BreakAll", rangeTable(all))
+	fmt.Fprintf(w, "var %sBreaks = [...]*unicode.RangeTable{\n", lower)
+	for _, value := range values {
+		fmt.Fprintf(w, "%sBreak%s, // %s\n", kind, value, value)
+	}
+	fmt.Fprintln(w, "}")
+	return w
+}
+
+func newFile() *bytes.Buffer {
+	var w bytes.Buffer
+	fmt.Fprint(&w, `// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package unicodedata
+
+import "unicode"
+
+// Code generated by unicodedata/genbreaks/main.go DO NOT EDIT.
+
+`)
+	return &w
+}
+
+func writeFile(name string, content *bytes.Buffer) error {
+	src, err := format.Source(content.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %s", name, err)
+	}
+	return ioutil.WriteFile(filepath.Join(*outDir, name), src, 0o644)
+}
+
+func writeTable(w *bytes.Buffer, comment, name string, table *unicode.RangeTable) {
+	fmt.Fprintf(w, "// %s\n", comment)
+	fmt.Fprintf(w, "var %s = &unicode.RangeTable{\n", name)
+	if len(table.R16) != 0 {
+		fmt.Fprintln(w, "R16: []unicode.Range16{")
+		for _, r := range table.R16 {
+			fmt.Fprintf(w, "{Lo: 0x%04x, Hi: 0x%04x, Stride: %d},\n", r.Lo, r.Hi, r.Stride)
+		}
+		fmt.Fprintln(w, "},")
+	}
+	if len(table.R32) != 0 {
+		fmt.Fprintln(w, "R32: []unicode.Range32{")
+		for _, r := range table.R32 {
+			fmt.Fprintf(w, "{Lo: 0x%x, Hi: 0x%x, Stride: %d},\n", r.Lo, r.Hi, r.Stride)
+		}
+		fmt.Fprintln(w, "},")
+	}
+	if table.LatinOffset != 0 {
+		fmt.Fprintf(w, "LatinOffset: %d,\n", table.LatinOffset)
+	}
+	fmt.Fprint(w, "}\n\n")
+}
+
+// rangeTable returns a table containing [runes], whose ranges
+// use the largest possible strides.
+func rangeTable(runes []rune) *unicode.RangeTable {
+	runes = append([]rune(nil), runes...)
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	var (
+		out            unicode.RangeTable
+		lo, hi, stride rune = -1, -1, 1
+	)
+	flush := func() {
+		if lo == -1 {
+			return
+		}
+		if hi <= 0xFFFF {
+			out.R16 = append(out.R16, unicode.Range16{Lo: uint16(lo), Hi: uint16(hi), Stride: uint16(stride)})
+			if hi <= unicode.MaxLatin1 {
+				out.LatinOffset++
+			}
+		} else {
+			out.R32 = append(out.R32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: uint32(stride)})
+		}
+	}
+	for _, r := range runes {
+		switch {
+		case r == hi: // duplicate
+		case lo != -1 && (hi <= 0xFFFF) == (r <= 0xFFFF) && (lo == hi || r-hi == stride):
+			hi, stride = r, r-hi
+		default:
+			flush()
+			lo, hi, stride = r, r, 1
+		}
+	}
+	flush()
+	return &out
+}
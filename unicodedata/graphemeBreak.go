@@ -4,7 +4,7 @@ package unicodedata
 
 import "unicode"
 
-// Code generated by typesettings-utils/generators/unicodedata/cmd/main.go DO NOT EDIT.
+// Code generated by unicodedata/genbreaks/main.go DO NOT EDIT.
 
 // GraphemeBreakProperty: CR
 var GraphemeBreakCR = &unicode.RangeTable{
@@ -30,7 +30,7 @@ var GraphemeBreakControl = &unicode.RangeTable{
 		{Lo: 0xfff1, Hi: 0xfffb, Stride: 1},
 	},
 	R32: []unicode.Range32{
-		{Lo: 0x13430, Hi: 0x13438, Stride: 1},
+		{Lo: 0x13430, Hi: 0x1343f, Stride: 1},
 		{Lo: 0x1bca0, Hi: 0x1bca3, Stride: 1},
 		{Lo: 0x1d173, Hi: 0x1d17a, Stride: 1},
 		{Lo: 0xe0000, Hi: 0xe001f, Stride: 1},
@@ -66,7 +66,8 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x0825, Hi: 0x0827, Stride: 1},
 		{Lo: 0x0829, Hi: 0x082d, Stride: 1},
 		{Lo: 0x0859, Hi: 0x085b, Stride: 1},
-		{Lo: 0x08d3, Hi: 0x08e1, Stride: 1},
+		{Lo: 0x0898, Hi: 0x089f, Stride: 1},
+		{Lo: 0x08ca, Hi: 0x08e1, Stride: 1},
 		{Lo: 0x08e3, Hi: 0x0902, Stride: 1},
 		{Lo: 0x093a, Hi: 0x093c, Stride: 2},
 		{Lo: 0x0941, Hi: 0x0948, Stride: 1},
@@ -101,8 +102,8 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x0b82, Hi: 0x0bbe, Stride: 60},
 		{Lo: 0x0bc0, Hi: 0x0bcd, Stride: 13},
 		{Lo: 0x0bd7, Hi: 0x0c00, Stride: 41},
-		{Lo: 0x0c04, Hi: 0x0c3e, Stride: 58},
-		{Lo: 0x0c3f, Hi: 0x0c40, Stride: 1},
+		{Lo: 0x0c04, Hi: 0x0c3c, Stride: 56},
+		{Lo: 0x0c3e, Hi: 0x0c40, Stride: 1},
 		{Lo: 0x0c46, Hi: 0x0c48, Stride: 1},
 		{Lo: 0x0c4a, Hi: 0x0c4d, Stride: 1},
 		{Lo: 0x0c55, Hi: 0x0c56, Stride: 1},
@@ -127,7 +128,7 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x0e47, Hi: 0x0e4e, Stride: 1},
 		{Lo: 0x0eb1, Hi: 0x0eb4, Stride: 3},
 		{Lo: 0x0eb5, Hi: 0x0ebc, Stride: 1},
-		{Lo: 0x0ec8, Hi: 0x0ecd, Stride: 1},
+		{Lo: 0x0ec8, Hi: 0x0ece, Stride: 1},
 		{Lo: 0x0f18, Hi: 0x0f19, Stride: 1},
 		{Lo: 0x0f35, Hi: 0x0f39, Stride: 2},
 		{Lo: 0x0f71, Hi: 0x0f7e, Stride: 1},
@@ -148,7 +149,7 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x109d, Hi: 0x135d, Stride: 704},
 		{Lo: 0x135e, Hi: 0x135f, Stride: 1},
 		{Lo: 0x1712, Hi: 0x1714, Stride: 1},
-		{Lo: 0x1732, Hi: 0x1734, Stride: 1},
+		{Lo: 0x1732, Hi: 0x1733, Stride: 1},
 		{Lo: 0x1752, Hi: 0x1753, Stride: 1},
 		{Lo: 0x1772, Hi: 0x1773, Stride: 1},
 		{Lo: 0x17b4, Hi: 0x17b5, Stride: 1},
@@ -157,9 +158,9 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x17ca, Hi: 0x17d3, Stride: 1},
 		{Lo: 0x17dd, Hi: 0x180b, Stride: 46},
 		{Lo: 0x180c, Hi: 0x180d, Stride: 1},
-		{Lo: 0x1885, Hi: 0x1886, Stride: 1},
-		{Lo: 0x18a9, Hi: 0x1920, Stride: 119},
-		{Lo: 0x1921, Hi: 0x1922, Stride: 1},
+		{Lo: 0x180f, Hi: 0x1885, Stride: 118},
+		{Lo: 0x1886, Hi: 0x18a9, Stride: 35},
+		{Lo: 0x1920, Hi: 0x1922, Stride: 1},
 		{Lo: 0x1927, Hi: 0x1928, Stride: 1},
 		{Lo: 0x1932, Hi: 0x1939, Stride: 7},
 		{Lo: 0x193a, Hi: 0x193b, Stride: 1},
@@ -170,7 +171,7 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x1a65, Hi: 0x1a6c, Stride: 1},
 		{Lo: 0x1a73, Hi: 0x1a7c, Stride: 1},
 		{Lo: 0x1a7f, Hi: 0x1ab0, Stride: 49},
-		{Lo: 0x1ab1, Hi: 0x1ac0, Stride: 1},
+		{Lo: 0x1ab1, Hi: 0x1ace, Stride: 1},
 		{Lo: 0x1b00, Hi: 0x1b03, Stride: 1},
 		{Lo: 0x1b34, Hi: 0x1b3a, Stride: 1},
 		{Lo: 0x1b3c, Hi: 0x1b42, Stride: 6},
@@ -189,8 +190,7 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x1ce2, Hi: 0x1ce8, Stride: 1},
 		{Lo: 0x1ced, Hi: 0x1cf4, Stride: 7},
 		{Lo: 0x1cf8, Hi: 0x1cf9, Stride: 1},
-		{Lo: 0x1dc0, Hi: 0x1df9, Stride: 1},
-		{Lo: 0x1dfb, Hi: 0x1dff, Stride: 1},
+		{Lo: 0x1dc0, Hi: 0x1dff, Stride: 1},
 		{Lo: 0x200c, Hi: 0x20d0, Stride: 196},
 		{Lo: 0x20d1, Hi: 0x20f0, Stride: 1},
 		{Lo: 0x2cef, Hi: 0x2cf1, Stride: 1},
@@ -242,13 +242,18 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x10ae6, Hi: 0x10d24, Stride: 574},
 		{Lo: 0x10d25, Hi: 0x10d27, Stride: 1},
 		{Lo: 0x10eab, Hi: 0x10eac, Stride: 1},
+		{Lo: 0x10efd, Hi: 0x10eff, Stride: 1},
 		{Lo: 0x10f46, Hi: 0x10f50, Stride: 1},
+		{Lo: 0x10f82, Hi: 0x10f85, Stride: 1},
 		{Lo: 0x11001, Hi: 0x11038, Stride: 55},
 		{Lo: 0x11039, Hi: 0x11046, Stride: 1},
-		{Lo: 0x1107f, Hi: 0x11081, Stride: 1},
+		{Lo: 0x11070, Hi: 0x11073, Stride: 3},
+		{Lo: 0x11074, Hi: 0x1107f, Stride: 11},
+		{Lo: 0x11080, Hi: 0x11081, Stride: 1},
 		{Lo: 0x110b3, Hi: 0x110b6, Stride: 1},
 		{Lo: 0x110b9, Hi: 0x110ba, Stride: 1},
-		{Lo: 0x11100, Hi: 0x11102, Stride: 1},
+		{Lo: 0x110c2, Hi: 0x11100, Stride: 62},
+		{Lo: 0x11101, Hi: 0x11102, Stride: 1},
 		{Lo: 0x11127, Hi: 0x1112b, Stride: 1},
 		{Lo: 0x1112d, Hi: 0x11134, Stride: 1},
 		{Lo: 0x11173, Hi: 0x11180, Stride: 13},
@@ -259,8 +264,8 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x11230, Hi: 0x11231, Stride: 1},
 		{Lo: 0x11234, Hi: 0x11236, Stride: 2},
 		{Lo: 0x11237, Hi: 0x1123e, Stride: 7},
-		{Lo: 0x112df, Hi: 0x112e3, Stride: 4},
-		{Lo: 0x112e4, Hi: 0x112ea, Stride: 1},
+		{Lo: 0x11241, Hi: 0x112df, Stride: 158},
+		{Lo: 0x112e3, Hi: 0x112ea, Stride: 1},
 		{Lo: 0x11300, Hi: 0x11301, Stride: 1},
 		{Lo: 0x1133b, Hi: 0x1133c, Stride: 1},
 		{Lo: 0x1133e, Hi: 0x11340, Stride: 2},
@@ -319,14 +324,22 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x11d47, Hi: 0x11d90, Stride: 73},
 		{Lo: 0x11d91, Hi: 0x11d95, Stride: 4},
 		{Lo: 0x11d97, Hi: 0x11ef3, Stride: 348},
-		{Lo: 0x11ef4, Hi: 0x16af0, Stride: 19452},
-		{Lo: 0x16af1, Hi: 0x16af4, Stride: 1},
+		{Lo: 0x11ef4, Hi: 0x11f00, Stride: 12},
+		{Lo: 0x11f01, Hi: 0x11f36, Stride: 53},
+		{Lo: 0x11f37, Hi: 0x11f3a, Stride: 1},
+		{Lo: 0x11f40, Hi: 0x11f42, Stride: 2},
+		{Lo: 0x13440, Hi: 0x13447, Stride: 7},
+		{Lo: 0x13448, Hi: 0x13455, Stride: 1},
+		{Lo: 0x16af0, Hi: 0x16af4, Stride: 1},
 		{Lo: 0x16b30, Hi: 0x16b36, Stride: 1},
 		{Lo: 0x16f4f, Hi: 0x16f8f, Stride: 64},
 		{Lo: 0x16f90, Hi: 0x16f92, Stride: 1},
 		{Lo: 0x16fe4, Hi: 0x1bc9d, Stride: 19641},
-		{Lo: 0x1bc9e, Hi: 0x1d165, Stride: 5319},
-		{Lo: 0x1d167, Hi: 0x1d169, Stride: 1},
+		{Lo: 0x1bc9e, Hi: 0x1cf00, Stride: 4706},
+		{Lo: 0x1cf01, Hi: 0x1cf2d, Stride: 1},
+		{Lo: 0x1cf30, Hi: 0x1cf46, Stride: 1},
+		{Lo: 0x1d165, Hi: 0x1d167, Stride: 2},
+		{Lo: 0x1d168, Hi: 0x1d169, Stride: 1},
 		{Lo: 0x1d16e, Hi: 0x1d172, Stride: 1},
 		{Lo: 0x1d17b, Hi: 0x1d182, Stride: 1},
 		{Lo: 0x1d185, Hi: 0x1d18b, Stride: 1},
@@ -342,8 +355,11 @@ var GraphemeBreakExtend = &unicode.RangeTable{
 		{Lo: 0x1e01b, Hi: 0x1e021, Stride: 1},
 		{Lo: 0x1e023, Hi: 0x1e024, Stride: 1},
 		{Lo: 0x1e026, Hi: 0x1e02a, Stride: 1},
-		{Lo: 0x1e130, Hi: 0x1e136, Stride: 1},
-		{Lo: 0x1e2ec, Hi: 0x1e2ef, Stride: 1},
+		{Lo: 0x1e08f, Hi: 0x1e130, Stride: 161},
+		{Lo: 0x1e131, Hi: 0x1e136, Stride: 1},
+		{Lo: 0x1e2ae, Hi: 0x1e2ec, Stride: 62},
+		{Lo: 0x1e2ed, Hi: 0x1e2ef, Stride: 1},
+		{Lo: 0x1e4ec, Hi: 0x1e4ef, Stride: 1},
 		{Lo: 0x1e8d0, Hi: 0x1e8d6, Stride: 1},
 		{Lo: 0x1e944, Hi: 0x1e94a, Stride: 1},
 		{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
@@ -785,6 +801,7 @@ var GraphemeBreakPrepend = &unicode.RangeTable{
 	R16: []unicode.Range16{
 		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
 		{Lo: 0x06dd, Hi: 0x070f, Stride: 50},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
 		{Lo: 0x08e2, Hi: 0x0d4e, Stride: 1132},
 	},
 	R32: []unicode.Range32{
@@ -793,7 +810,7 @@ var GraphemeBreakPrepend = &unicode.RangeTable{
 		{Lo: 0x1193f, Hi: 0x11941, Stride: 2},
 		{Lo: 0x11a3a, Hi: 0x11a84, Stride: 74},
 		{Lo: 0x11a85, Hi: 0x11a89, Stride: 1},
-		{Lo: 0x11d46, Hi: 0x11d46, Stride: 1},
+		{Lo: 0x11d46, Hi: 0x11f02, Stride: 444},
 	},
 }
 
@@ -835,10 +852,10 @@ var GraphemeBreakSpacingMark = &unicode.RangeTable{
 		{Lo: 0x0cc1, Hi: 0x0cc3, Stride: 2},
 		{Lo: 0x0cc4, Hi: 0x0cc7, Stride: 3},
 		{Lo: 0x0cc8, Hi: 0x0cca, Stride: 2},
-		{Lo: 0x0ccb, Hi: 0x0d02, Stride: 55},
-		{Lo: 0x0d03, Hi: 0x0d3f, Stride: 60},
-		{Lo: 0x0d40, Hi: 0x0d46, Stride: 6},
-		{Lo: 0x0d47, Hi: 0x0d48, Stride: 1},
+		{Lo: 0x0ccb, Hi: 0x0cf3, Stride: 40},
+		{Lo: 0x0d02, Hi: 0x0d03, Stride: 1},
+		{Lo: 0x0d3f, Hi: 0x0d40, Stride: 1},
+		{Lo: 0x0d46, Hi: 0x0d48, Stride: 1},
 		{Lo: 0x0d4a, Hi: 0x0d4c, Stride: 1},
 		{Lo: 0x0d82, Hi: 0x0d83, Stride: 1},
 		{Lo: 0x0dd0, Hi: 0x0dd1, Stride: 1},
@@ -849,7 +866,8 @@ var GraphemeBreakSpacingMark = &unicode.RangeTable{
 		{Lo: 0x0f7f, Hi: 0x1031, Stride: 178},
 		{Lo: 0x103b, Hi: 0x103c, Stride: 1},
 		{Lo: 0x1056, Hi: 0x1057, Stride: 1},
-		{Lo: 0x1084, Hi: 0x17b6, Stride: 1842},
+		{Lo: 0x1084, Hi: 0x1715, Stride: 1681},
+		{Lo: 0x1734, Hi: 0x17b6, Stride: 130},
 		{Lo: 0x17be, Hi: 0x17c5, Stride: 1},
 		{Lo: 0x17c7, Hi: 0x17c8, Stride: 1},
 		{Lo: 0x1923, Hi: 0x1926, Stride: 1},
@@ -922,8 +940,7 @@ var GraphemeBreakSpacingMark = &unicode.RangeTable{
 		{Lo: 0x1163b, Hi: 0x1163c, Stride: 1},
 		{Lo: 0x1163e, Hi: 0x116ac, Stride: 110},
 		{Lo: 0x116ae, Hi: 0x116af, Stride: 1},
-		{Lo: 0x116b6, Hi: 0x11720, Stride: 106},
-		{Lo: 0x11721, Hi: 0x11726, Stride: 5},
+		{Lo: 0x116b6, Hi: 0x11726, Stride: 112},
 		{Lo: 0x1182c, Hi: 0x1182e, Stride: 1},
 		{Lo: 0x11838, Hi: 0x11931, Stride: 249},
 		{Lo: 0x11932, Hi: 0x11935, Stride: 1},
@@ -940,7 +957,10 @@ var GraphemeBreakSpacingMark = &unicode.RangeTable{
 		{Lo: 0x11d8a, Hi: 0x11d8e, Stride: 1},
 		{Lo: 0x11d93, Hi: 0x11d94, Stride: 1},
 		{Lo: 0x11d96, Hi: 0x11ef5, Stride: 351},
-		{Lo: 0x11ef6, Hi: 0x16f51, Stride: 20571},
+		{Lo: 0x11ef6, Hi: 0x11f03, Stride: 13},
+		{Lo: 0x11f34, Hi: 0x11f35, Stride: 1},
+		{Lo: 0x11f3e, Hi: 0x11f3f, Stride: 1},
+		{Lo: 0x11f41, Hi: 0x16f51, Stride: 20496},
 		{Lo: 0x16f52, Hi: 0x16f87, Stride: 1},
 		{Lo: 0x16ff0, Hi: 0x16ff1, Stride: 1},
 		{Lo: 0x1d166, Hi: 0x1d16d, Stride: 7},
@@ -975,8 +995,8 @@ var graphemeBreakAll = &unicode.RangeTable{
 	R16: []unicode.Range16{
 		{Lo: 0x0000, Hi: 0x001f, Stride: 1},
 		{Lo: 0x007f, Hi: 0x009f, Stride: 1},
-		{Lo: 0x00ad, Hi: 0x00ad, Stride: 1},
-		{Lo: 0x0300, Hi: 0x036f, Stride: 1},
+		{Lo: 0x00ad, Hi: 0x0300, Stride: 595},
+		{Lo: 0x0301, Hi: 0x036f, Stride: 1},
 		{Lo: 0x0483, Hi: 0x0489, Stride: 1},
 		{Lo: 0x0591, Hi: 0x05bd, Stride: 1},
 		{Lo: 0x05bf, Hi: 0x05c1, Stride: 2},
@@ -984,16 +1004,15 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x05c5, Hi: 0x05c7, Stride: 2},
 		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
 		{Lo: 0x0610, Hi: 0x061a, Stride: 1},
-		{Lo: 0x061c, Hi: 0x061c, Stride: 1},
-		{Lo: 0x064b, Hi: 0x065f, Stride: 1},
+		{Lo: 0x061c, Hi: 0x064b, Stride: 47},
+		{Lo: 0x064c, Hi: 0x065f, Stride: 1},
 		{Lo: 0x0670, Hi: 0x06d6, Stride: 102},
 		{Lo: 0x06d7, Hi: 0x06dd, Stride: 1},
 		{Lo: 0x06df, Hi: 0x06e4, Stride: 1},
 		{Lo: 0x06e7, Hi: 0x06e8, Stride: 1},
 		{Lo: 0x06ea, Hi: 0x06ed, Stride: 1},
-		{Lo: 0x070f, Hi: 0x070f, Stride: 1},
-		{Lo: 0x0711, Hi: 0x0730, Stride: 31},
-		{Lo: 0x0731, Hi: 0x074a, Stride: 1},
+		{Lo: 0x070f, Hi: 0x0711, Stride: 2},
+		{Lo: 0x0730, Hi: 0x074a, Stride: 1},
 		{Lo: 0x07a6, Hi: 0x07b0, Stride: 1},
 		{Lo: 0x07eb, Hi: 0x07f3, Stride: 1},
 		{Lo: 0x07fd, Hi: 0x0816, Stride: 25},
@@ -1002,7 +1021,9 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x0825, Hi: 0x0827, Stride: 1},
 		{Lo: 0x0829, Hi: 0x082d, Stride: 1},
 		{Lo: 0x0859, Hi: 0x085b, Stride: 1},
-		{Lo: 0x08d3, Hi: 0x0903, Stride: 1},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+		{Lo: 0x0898, Hi: 0x089f, Stride: 1},
+		{Lo: 0x08ca, Hi: 0x0903, Stride: 1},
 		{Lo: 0x093a, Hi: 0x093c, Stride: 1},
 		{Lo: 0x093e, Hi: 0x094f, Stride: 1},
 		{Lo: 0x0951, Hi: 0x0957, Stride: 1},
@@ -1012,10 +1033,9 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x09bf, Hi: 0x09c4, Stride: 1},
 		{Lo: 0x09c7, Hi: 0x09c8, Stride: 1},
 		{Lo: 0x09cb, Hi: 0x09cd, Stride: 1},
-		{Lo: 0x09d7, Hi: 0x09d7, Stride: 10},
-		{Lo: 0x09e2, Hi: 0x09e3, Stride: 1},
-		{Lo: 0x09fe, Hi: 0x0a01, Stride: 3},
-		{Lo: 0x0a02, Hi: 0x0a03, Stride: 1},
+		{Lo: 0x09d7, Hi: 0x09e2, Stride: 11},
+		{Lo: 0x09e3, Hi: 0x09fe, Stride: 27},
+		{Lo: 0x0a01, Hi: 0x0a03, Stride: 1},
 		{Lo: 0x0a3c, Hi: 0x0a3e, Stride: 2},
 		{Lo: 0x0a3f, Hi: 0x0a42, Stride: 1},
 		{Lo: 0x0a47, Hi: 0x0a48, Stride: 1},
@@ -1027,12 +1047,11 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x0abf, Hi: 0x0ac5, Stride: 1},
 		{Lo: 0x0ac7, Hi: 0x0ac9, Stride: 1},
 		{Lo: 0x0acb, Hi: 0x0acd, Stride: 1},
-		{Lo: 0x0ae2, Hi: 0x0ae2, Stride: 21},
-		{Lo: 0x0ae3, Hi: 0x0afa, Stride: 23},
-		{Lo: 0x0afb, Hi: 0x0aff, Stride: 1},
+		{Lo: 0x0ae2, Hi: 0x0ae3, Stride: 1},
+		{Lo: 0x0afa, Hi: 0x0aff, Stride: 1},
 		{Lo: 0x0b01, Hi: 0x0b03, Stride: 1},
-		{Lo: 0x0b3c, Hi: 0x0b3c, Stride: 1},
-		{Lo: 0x0b3e, Hi: 0x0b44, Stride: 1},
+		{Lo: 0x0b3c, Hi: 0x0b3e, Stride: 2},
+		{Lo: 0x0b3f, Hi: 0x0b44, Stride: 1},
 		{Lo: 0x0b47, Hi: 0x0b48, Stride: 1},
 		{Lo: 0x0b4b, Hi: 0x0b4d, Stride: 1},
 		{Lo: 0x0b55, Hi: 0x0b57, Stride: 1},
@@ -1043,7 +1062,8 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x0bca, Hi: 0x0bcd, Stride: 1},
 		{Lo: 0x0bd7, Hi: 0x0c00, Stride: 41},
 		{Lo: 0x0c01, Hi: 0x0c04, Stride: 1},
-		{Lo: 0x0c3e, Hi: 0x0c44, Stride: 1},
+		{Lo: 0x0c3c, Hi: 0x0c3e, Stride: 2},
+		{Lo: 0x0c3f, Hi: 0x0c44, Stride: 1},
 		{Lo: 0x0c46, Hi: 0x0c48, Stride: 1},
 		{Lo: 0x0c4a, Hi: 0x0c4d, Stride: 1},
 		{Lo: 0x0c55, Hi: 0x0c56, Stride: 1},
@@ -1053,28 +1073,28 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x0cbf, Hi: 0x0cc4, Stride: 1},
 		{Lo: 0x0cc6, Hi: 0x0cc8, Stride: 1},
 		{Lo: 0x0cca, Hi: 0x0ccd, Stride: 1},
-		{Lo: 0x0cd5, Hi: 0x0cd5, Stride: 8},
-		{Lo: 0x0cd6, Hi: 0x0ce2, Stride: 12},
-		{Lo: 0x0ce3, Hi: 0x0d00, Stride: 29},
+		{Lo: 0x0cd5, Hi: 0x0cd6, Stride: 1},
+		{Lo: 0x0ce2, Hi: 0x0ce3, Stride: 1},
+		{Lo: 0x0cf3, Hi: 0x0d00, Stride: 13},
 		{Lo: 0x0d01, Hi: 0x0d03, Stride: 1},
 		{Lo: 0x0d3b, Hi: 0x0d3c, Stride: 1},
 		{Lo: 0x0d3e, Hi: 0x0d44, Stride: 1},
 		{Lo: 0x0d46, Hi: 0x0d48, Stride: 1},
 		{Lo: 0x0d4a, Hi: 0x0d4e, Stride: 1},
-		{Lo: 0x0d57, Hi: 0x0d57, Stride: 1},
-		{Lo: 0x0d62, Hi: 0x0d63, Stride: 1},
-		{Lo: 0x0d81, Hi: 0x0d83, Stride: 1},
+		{Lo: 0x0d57, Hi: 0x0d62, Stride: 11},
+		{Lo: 0x0d63, Hi: 0x0d81, Stride: 30},
+		{Lo: 0x0d82, Hi: 0x0d83, Stride: 1},
 		{Lo: 0x0dca, Hi: 0x0dcf, Stride: 5},
 		{Lo: 0x0dd0, Hi: 0x0dd4, Stride: 1},
-		{Lo: 0x0dd6, Hi: 0x0dd6, Stride: 1},
-		{Lo: 0x0dd8, Hi: 0x0ddf, Stride: 1},
+		{Lo: 0x0dd6, Hi: 0x0dd8, Stride: 2},
+		{Lo: 0x0dd9, Hi: 0x0ddf, Stride: 1},
 		{Lo: 0x0df2, Hi: 0x0df3, Stride: 1},
 		{Lo: 0x0e31, Hi: 0x0e33, Stride: 2},
 		{Lo: 0x0e34, Hi: 0x0e3a, Stride: 1},
 		{Lo: 0x0e47, Hi: 0x0e4e, Stride: 1},
 		{Lo: 0x0eb1, Hi: 0x0eb3, Stride: 2},
 		{Lo: 0x0eb4, Hi: 0x0ebc, Stride: 1},
-		{Lo: 0x0ec8, Hi: 0x0ecd, Stride: 1},
+		{Lo: 0x0ec8, Hi: 0x0ece, Stride: 1},
 		{Lo: 0x0f18, Hi: 0x0f19, Stride: 1},
 		{Lo: 0x0f35, Hi: 0x0f39, Stride: 2},
 		{Lo: 0x0f3e, Hi: 0x0f3f, Stride: 1},
@@ -1093,13 +1113,13 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x108d, Hi: 0x109d, Stride: 16},
 		{Lo: 0x1100, Hi: 0x11ff, Stride: 1},
 		{Lo: 0x135d, Hi: 0x135f, Stride: 1},
-		{Lo: 0x1712, Hi: 0x1714, Stride: 1},
+		{Lo: 0x1712, Hi: 0x1715, Stride: 1},
 		{Lo: 0x1732, Hi: 0x1734, Stride: 1},
 		{Lo: 0x1752, Hi: 0x1753, Stride: 1},
 		{Lo: 0x1772, Hi: 0x1773, Stride: 1},
 		{Lo: 0x17b4, Hi: 0x17d3, Stride: 1},
 		{Lo: 0x17dd, Hi: 0x180b, Stride: 46},
-		{Lo: 0x180c, Hi: 0x180e, Stride: 1},
+		{Lo: 0x180c, Hi: 0x180f, Stride: 1},
 		{Lo: 0x1885, Hi: 0x1886, Stride: 1},
 		{Lo: 0x18a9, Hi: 0x1920, Stride: 119},
 		{Lo: 0x1921, Hi: 0x192b, Stride: 1},
@@ -1109,7 +1129,7 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x1a60, Hi: 0x1a62, Stride: 2},
 		{Lo: 0x1a65, Hi: 0x1a7c, Stride: 1},
 		{Lo: 0x1a7f, Hi: 0x1ab0, Stride: 49},
-		{Lo: 0x1ab1, Hi: 0x1ac0, Stride: 1},
+		{Lo: 0x1ab1, Hi: 0x1ace, Stride: 1},
 		{Lo: 0x1b00, Hi: 0x1b04, Stride: 1},
 		{Lo: 0x1b34, Hi: 0x1b44, Stride: 1},
 		{Lo: 0x1b6b, Hi: 0x1b73, Stride: 1},
@@ -1121,8 +1141,7 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x1cd4, Hi: 0x1ce8, Stride: 1},
 		{Lo: 0x1ced, Hi: 0x1cf4, Stride: 7},
 		{Lo: 0x1cf7, Hi: 0x1cf9, Stride: 1},
-		{Lo: 0x1dc0, Hi: 0x1df9, Stride: 1},
-		{Lo: 0x1dfb, Hi: 0x1dff, Stride: 1},
+		{Lo: 0x1dc0, Hi: 0x1dff, Stride: 1},
 		{Lo: 0x200b, Hi: 0x200f, Stride: 1},
 		{Lo: 0x2028, Hi: 0x202e, Stride: 1},
 		{Lo: 0x2060, Hi: 0x206f, Stride: 1},
@@ -1137,8 +1156,8 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0xa69e, Hi: 0xa69f, Stride: 1},
 		{Lo: 0xa6f0, Hi: 0xa6f1, Stride: 1},
 		{Lo: 0xa802, Hi: 0xa806, Stride: 4},
-		{Lo: 0xa80b, Hi: 0xa80b, Stride: 1},
-		{Lo: 0xa823, Hi: 0xa827, Stride: 1},
+		{Lo: 0xa80b, Hi: 0xa823, Stride: 24},
+		{Lo: 0xa824, Hi: 0xa827, Stride: 1},
 		{Lo: 0xa82c, Hi: 0xa880, Stride: 84},
 		{Lo: 0xa881, Hi: 0xa8b4, Stride: 51},
 		{Lo: 0xa8b5, Hi: 0xa8c5, Stride: 1},
@@ -1152,9 +1171,9 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0xa9e5, Hi: 0xaa29, Stride: 68},
 		{Lo: 0xaa2a, Hi: 0xaa36, Stride: 1},
 		{Lo: 0xaa43, Hi: 0xaa4c, Stride: 9},
-		{Lo: 0xaa4d, Hi: 0xaa4d, Stride: 1},
-		{Lo: 0xaa7c, Hi: 0xaab0, Stride: 52},
-		{Lo: 0xaab2, Hi: 0xaab4, Stride: 1},
+		{Lo: 0xaa4d, Hi: 0xaa7c, Stride: 47},
+		{Lo: 0xaab0, Hi: 0xaab2, Stride: 2},
+		{Lo: 0xaab3, Hi: 0xaab4, Stride: 1},
 		{Lo: 0xaab7, Hi: 0xaab8, Stride: 1},
 		{Lo: 0xaabe, Hi: 0xaabf, Stride: 1},
 		{Lo: 0xaac1, Hi: 0xaaeb, Stride: 42},
@@ -1165,12 +1184,12 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
 		{Lo: 0xd7b0, Hi: 0xd7c6, Stride: 1},
 		{Lo: 0xd7cb, Hi: 0xd7fb, Stride: 1},
-		{Lo: 0xfb1e, Hi: 0xfb1e, Stride: 1},
-		{Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
+		{Lo: 0xfb1e, Hi: 0xfe00, Stride: 738},
+		{Lo: 0xfe01, Hi: 0xfe0f, Stride: 1},
 		{Lo: 0xfe20, Hi: 0xfe2f, Stride: 1},
-		{Lo: 0xfeff, Hi: 0xfeff, Stride: 1},
-		{Lo: 0xff9e, Hi: 0xff9f, Stride: 1},
-		{Lo: 0xfff0, Hi: 0xfffb, Stride: 1},
+		{Lo: 0xfeff, Hi: 0xff9e, Stride: 159},
+		{Lo: 0xff9f, Hi: 0xfff0, Stride: 81},
+		{Lo: 0xfff1, Hi: 0xfffb, Stride: 1},
 	},
 	R32: []unicode.Range32{
 		{Lo: 0x101fd, Hi: 0x102e0, Stride: 227},
@@ -1183,13 +1202,18 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x10ae6, Hi: 0x10d24, Stride: 574},
 		{Lo: 0x10d25, Hi: 0x10d27, Stride: 1},
 		{Lo: 0x10eab, Hi: 0x10eac, Stride: 1},
+		{Lo: 0x10efd, Hi: 0x10eff, Stride: 1},
 		{Lo: 0x10f46, Hi: 0x10f50, Stride: 1},
+		{Lo: 0x10f82, Hi: 0x10f85, Stride: 1},
 		{Lo: 0x11000, Hi: 0x11002, Stride: 1},
 		{Lo: 0x11038, Hi: 0x11046, Stride: 1},
-		{Lo: 0x1107f, Hi: 0x11082, Stride: 1},
+		{Lo: 0x11070, Hi: 0x11073, Stride: 3},
+		{Lo: 0x11074, Hi: 0x1107f, Stride: 11},
+		{Lo: 0x11080, Hi: 0x11082, Stride: 1},
 		{Lo: 0x110b0, Hi: 0x110ba, Stride: 1},
-		{Lo: 0x110bd, Hi: 0x110cd, Stride: 16},
-		{Lo: 0x11100, Hi: 0x11102, Stride: 1},
+		{Lo: 0x110bd, Hi: 0x110c2, Stride: 5},
+		{Lo: 0x110cd, Hi: 0x11100, Stride: 51},
+		{Lo: 0x11101, Hi: 0x11102, Stride: 1},
 		{Lo: 0x11127, Hi: 0x11134, Stride: 1},
 		{Lo: 0x11145, Hi: 0x11146, Stride: 1},
 		{Lo: 0x11173, Hi: 0x11180, Stride: 13},
@@ -1199,16 +1223,16 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x111c9, Hi: 0x111cc, Stride: 1},
 		{Lo: 0x111ce, Hi: 0x111cf, Stride: 1},
 		{Lo: 0x1122c, Hi: 0x11237, Stride: 1},
-		{Lo: 0x1123e, Hi: 0x112df, Stride: 161},
-		{Lo: 0x112e0, Hi: 0x112ea, Stride: 1},
+		{Lo: 0x1123e, Hi: 0x11241, Stride: 3},
+		{Lo: 0x112df, Hi: 0x112ea, Stride: 1},
 		{Lo: 0x11300, Hi: 0x11303, Stride: 1},
 		{Lo: 0x1133b, Hi: 0x1133c, Stride: 1},
 		{Lo: 0x1133e, Hi: 0x11344, Stride: 1},
 		{Lo: 0x11347, Hi: 0x11348, Stride: 1},
 		{Lo: 0x1134b, Hi: 0x1134d, Stride: 1},
-		{Lo: 0x11357, Hi: 0x11357, Stride: 1},
-		{Lo: 0x11362, Hi: 0x11363, Stride: 1},
-		{Lo: 0x11366, Hi: 0x1136c, Stride: 1},
+		{Lo: 0x11357, Hi: 0x11362, Stride: 11},
+		{Lo: 0x11363, Hi: 0x11366, Stride: 3},
+		{Lo: 0x11367, Hi: 0x1136c, Stride: 1},
 		{Lo: 0x11370, Hi: 0x11374, Stride: 1},
 		{Lo: 0x11435, Hi: 0x11446, Stride: 1},
 		{Lo: 0x1145e, Hi: 0x114b0, Stride: 82},
@@ -1218,7 +1242,8 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x115dc, Hi: 0x115dd, Stride: 1},
 		{Lo: 0x11630, Hi: 0x11640, Stride: 1},
 		{Lo: 0x116ab, Hi: 0x116b7, Stride: 1},
-		{Lo: 0x1171d, Hi: 0x1172b, Stride: 1},
+		{Lo: 0x1171d, Hi: 0x1171f, Stride: 1},
+		{Lo: 0x11722, Hi: 0x1172b, Stride: 1},
 		{Lo: 0x1182c, Hi: 0x1183a, Stride: 1},
 		{Lo: 0x11930, Hi: 0x11935, Stride: 1},
 		{Lo: 0x11937, Hi: 0x11938, Stride: 1},
@@ -1243,16 +1268,22 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x11d90, Hi: 0x11d91, Stride: 1},
 		{Lo: 0x11d93, Hi: 0x11d97, Stride: 1},
 		{Lo: 0x11ef3, Hi: 0x11ef6, Stride: 1},
-		{Lo: 0x13430, Hi: 0x13438, Stride: 1},
+		{Lo: 0x11f00, Hi: 0x11f03, Stride: 1},
+		{Lo: 0x11f34, Hi: 0x11f3a, Stride: 1},
+		{Lo: 0x11f3e, Hi: 0x11f42, Stride: 1},
+		{Lo: 0x13430, Hi: 0x13440, Stride: 1},
+		{Lo: 0x13447, Hi: 0x13455, Stride: 1},
 		{Lo: 0x16af0, Hi: 0x16af4, Stride: 1},
 		{Lo: 0x16b30, Hi: 0x16b36, Stride: 1},
 		{Lo: 0x16f4f, Hi: 0x16f51, Stride: 2},
 		{Lo: 0x16f52, Hi: 0x16f87, Stride: 1},
 		{Lo: 0x16f8f, Hi: 0x16f92, Stride: 1},
-		{Lo: 0x16fe4, Hi: 0x16fe4, Stride: 1},
-		{Lo: 0x16ff0, Hi: 0x16ff1, Stride: 1},
-		{Lo: 0x1bc9d, Hi: 0x1bc9e, Stride: 1},
-		{Lo: 0x1bca0, Hi: 0x1bca3, Stride: 1},
+		{Lo: 0x16fe4, Hi: 0x16ff0, Stride: 12},
+		{Lo: 0x16ff1, Hi: 0x1bc9d, Stride: 19628},
+		{Lo: 0x1bc9e, Hi: 0x1bca0, Stride: 2},
+		{Lo: 0x1bca1, Hi: 0x1bca3, Stride: 1},
+		{Lo: 0x1cf00, Hi: 0x1cf2d, Stride: 1},
+		{Lo: 0x1cf30, Hi: 0x1cf46, Stride: 1},
 		{Lo: 0x1d165, Hi: 0x1d169, Stride: 1},
 		{Lo: 0x1d16d, Hi: 0x1d182, Stride: 1},
 		{Lo: 0x1d185, Hi: 0x1d18b, Stride: 1},
@@ -1268,15 +1299,18 @@ var graphemeBreakAll = &unicode.RangeTable{
 		{Lo: 0x1e01b, Hi: 0x1e021, Stride: 1},
 		{Lo: 0x1e023, Hi: 0x1e024, Stride: 1},
 		{Lo: 0x1e026, Hi: 0x1e02a, Stride: 1},
-		{Lo: 0x1e130, Hi: 0x1e136, Stride: 1},
-		{Lo: 0x1e2ec, Hi: 0x1e2ef, Stride: 1},
+		{Lo: 0x1e08f, Hi: 0x1e130, Stride: 161},
+		{Lo: 0x1e131, Hi: 0x1e136, Stride: 1},
+		{Lo: 0x1e2ae, Hi: 0x1e2ec, Stride: 62},
+		{Lo: 0x1e2ed, Hi: 0x1e2ef, Stride: 1},
+		{Lo: 0x1e4ec, Hi: 0x1e4ef, Stride: 1},
 		{Lo: 0x1e8d0, Hi: 0x1e8d6, Stride: 1},
 		{Lo: 0x1e944, Hi: 0x1e94a, Stride: 1},
 		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
 		{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
 		{Lo: 0xe0000, Hi: 0xe0fff, Stride: 1},
 	},
-	LatinOffset: 3,
+	LatinOffset: 2,
 }
 
 var graphemeBreaks = [...]*unicode.RangeTable{
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package unicodedata
+
+import "unicode"
+
+// LookupJoiningType returns the Joining_Type property of the rune,
+// one of U, R, D, C, L or T.
+// The Alaph and DalathRish joining groups are reported as R :
+// use [LookupSyriacJoiningGroup] to query them.
+func LookupJoiningType(r rune) ArabicJoining {
+	switch r {
+	case 0x200C: // ZERO WIDTH NON-JOINER
+		return U
+	case 0x200D: // ZERO WIDTH JOINER
+		return C
+	}
+	if j, ok := ArabicJoinings[r]; ok {
+		if j == Alaph || j == DalathRish {
+			return R
+		}
+		return j
+	}
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return T
+	}
+	return U
+}
+
+// LookupSyriacJoiningGroup returns the Alaph or DalathRish Joining_Group
+// of the rune, or 0 for the other runes.
+//
+// This is only a partial view of the Joining_Group property : the other groups
+// (like Beh, Hah or Lam) are not stored, since only these two, required
+// to shape Syriac, change how the text is joined.
+func LookupSyriacJoiningGroup(r rune) ArabicJoining {
+	if j := ArabicJoinings[r]; j == Alaph || j == DalathRish {
+		return j
+	}
+	return 0
+}
+
+// indexes in the ArabicShaping rows
+const (
+	arabicIsolated = iota
+	arabicFinal
+	arabicInitial
+	arabicMedial
+)
+
+// joinsOnTheLeft returns true for the joining types able to join with the next rune
+func joinsOnTheLeft(j ArabicJoining) bool { return j == D || j == L || j == C }
+
+// joinsOnTheRight returns true for the joining types able to join with the previous rune
+func joinsOnTheRight(j ArabicJoining) bool { return j == D || j == R || j == C }
+
+// ArabicPresentationForms is a minimal Arabic shaper : it appends
+// to [dst] the text, in logical order, where each Arabic letter is replaced by its
+// isolated, initial, medial or final presentation form (as found in the
+// Arabic Presentation Forms-B block), according to its joining context.
+// If [ligatures] is true, the mandatory lam-alef ligatures are also applied,
+// so that the output may be shorter than the input.
+//
+// It is intended as a fallback for environments where the complete
+// shaper is not available, or for fonts lacking the required OpenType layout
+// tables : the resulting runes should then be mapped to glyphs with the font cmap.
+func ArabicPresentationForms(dst, text []rune, ligatures bool) []rune {
+	start := len(dst)
+	for i, r := range text {
+		jt := LookupJoiningType(r)
+		if jt == T || r < FirstArabicShape || r > LastArabicShape {
+			dst = append(dst, r)
+			continue
+		}
+		// find the context, skipping transparent runes
+		var joinsPrevious, joinsNext bool
+		for j := i - 1; j >= 0; j-- {
+			if prev := LookupJoiningType(text[j]); prev != T {
+				joinsPrevious = joinsOnTheLeft(prev) && joinsOnTheRight(jt)
+				break
+			}
+		}
+		for j := i + 1; j < len(text); j++ {
+			if next := LookupJoiningType(text[j]); next != T {
+				joinsNext = joinsOnTheLeft(jt) && joinsOnTheRight(next)
+				break
+			}
+		}
+		form := arabicIsolated
+		switch {
+		case joinsPrevious && joinsNext:
+			form = arabicMedial
+		case joinsPrevious:
+			form = arabicFinal
+		case joinsNext:
+			form = arabicInitial
+		}
+		if s := rune(ArabicShaping[r-FirstArabicShape][form]); s != 0 {
+			r = s
+		}
+		dst = append(dst, r)
+	}
+
+	if ligatures {
+		dst = dst[:start+applyLamAlef(dst[start:])]
+	}
+	return dst
+}
+
+// applyLamAlef replaces the lam-alef pairs by their ligature, in place,
+// and returns the new length
+func applyLamAlef(text []rune) int {
+	out := 0
+	for i := 0; i < len(text); i++ {
+		r := text[i]
+		if i+1 < len(text) {
+			for _, lig := range ArabicLigatures {
+				if lig.First != r {
+					continue
+				}
+				for _, pair := range lig.Ligatures {
+					if pair[0] == text[i+1] {
+						r = pair[1]
+						i++
+						break
+					}
+				}
+			}
+		}
+		text[out] = r
+		out++
+	}
+	return out
+}
@@ -4,7 +4,7 @@ package unicodedata
 
 import "unicode"
 
-// Code generated by typesettings-utils/generators/unicodedata/cmd/main.go DO NOT EDIT.
+// Code generated by unicodedata/genbreaks/main.go DO NOT EDIT.
 
 // Mandatory Break
 var BreakBK = &unicode.RangeTable{
@@ -106,11 +106,14 @@ var BreakNU = &unicode.RangeTable{
 		{Lo: 0x11c50, Hi: 0x11c59, Stride: 1},
 		{Lo: 0x11d50, Hi: 0x11d59, Stride: 1},
 		{Lo: 0x11da0, Hi: 0x11da9, Stride: 1},
+		{Lo: 0x11f50, Hi: 0x11f59, Stride: 1},
 		{Lo: 0x16a60, Hi: 0x16a69, Stride: 1},
+		{Lo: 0x16ac0, Hi: 0x16ac9, Stride: 1},
 		{Lo: 0x16b50, Hi: 0x16b59, Stride: 1},
 		{Lo: 0x1d7ce, Hi: 0x1d7ff, Stride: 1},
 		{Lo: 0x1e140, Hi: 0x1e149, Stride: 1},
 		{Lo: 0x1e2f0, Hi: 0x1e2f9, Stride: 1},
+		{Lo: 0x1e4f0, Hi: 0x1e4f9, Stride: 1},
 		{Lo: 0x1e950, Hi: 0x1e959, Stride: 1},
 		{Lo: 0x1fbf0, Hi: 0x1fbf9, Stride: 1},
 	},
@@ -173,8 +176,9 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x0840, Hi: 0x0858, Stride: 1},
 		{Lo: 0x085e, Hi: 0x0860, Stride: 2},
 		{Lo: 0x0861, Hi: 0x086a, Stride: 1},
-		{Lo: 0x08a0, Hi: 0x08b4, Stride: 1},
-		{Lo: 0x08b6, Hi: 0x08c7, Stride: 1},
+		{Lo: 0x0870, Hi: 0x088e, Stride: 1},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+		{Lo: 0x08a0, Hi: 0x08c9, Stride: 1},
 		{Lo: 0x08e2, Hi: 0x0904, Stride: 34},
 		{Lo: 0x0905, Hi: 0x0939, Stride: 1},
 		{Lo: 0x093d, Hi: 0x0950, Stride: 19},
@@ -242,17 +246,19 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x0c2a, Hi: 0x0c39, Stride: 1},
 		{Lo: 0x0c3d, Hi: 0x0c58, Stride: 27},
 		{Lo: 0x0c59, Hi: 0x0c5a, Stride: 1},
-		{Lo: 0x0c60, Hi: 0x0c61, Stride: 1},
-		{Lo: 0x0c78, Hi: 0x0c80, Stride: 1},
+		{Lo: 0x0c5d, Hi: 0x0c60, Stride: 3},
+		{Lo: 0x0c61, Hi: 0x0c78, Stride: 23},
+		{Lo: 0x0c79, Hi: 0x0c80, Stride: 1},
 		{Lo: 0x0c85, Hi: 0x0c8c, Stride: 1},
 		{Lo: 0x0c8e, Hi: 0x0c90, Stride: 1},
 		{Lo: 0x0c92, Hi: 0x0ca8, Stride: 1},
 		{Lo: 0x0caa, Hi: 0x0cb3, Stride: 1},
 		{Lo: 0x0cb5, Hi: 0x0cb9, Stride: 1},
-		{Lo: 0x0cbd, Hi: 0x0cde, Stride: 33},
-		{Lo: 0x0ce0, Hi: 0x0ce1, Stride: 1},
-		{Lo: 0x0cf1, Hi: 0x0cf2, Stride: 1},
-		{Lo: 0x0d04, Hi: 0x0d0c, Stride: 1},
+		{Lo: 0x0cbd, Hi: 0x0cdd, Stride: 32},
+		{Lo: 0x0cde, Hi: 0x0ce0, Stride: 2},
+		{Lo: 0x0ce1, Hi: 0x0cf1, Stride: 16},
+		{Lo: 0x0cf2, Hi: 0x0d04, Stride: 18},
+		{Lo: 0x0d05, Hi: 0x0d0c, Stride: 1},
 		{Lo: 0x0d0e, Hi: 0x0d10, Stride: 1},
 		{Lo: 0x0d12, Hi: 0x0d3a, Stride: 1},
 		{Lo: 0x0d3d, Hi: 0x0d4e, Stride: 17},
@@ -309,9 +315,8 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x1681, Hi: 0x169a, Stride: 1},
 		{Lo: 0x16a0, Hi: 0x16ea, Stride: 1},
 		{Lo: 0x16ee, Hi: 0x16f8, Stride: 1},
-		{Lo: 0x1700, Hi: 0x170c, Stride: 1},
-		{Lo: 0x170e, Hi: 0x1711, Stride: 1},
-		{Lo: 0x1720, Hi: 0x1731, Stride: 1},
+		{Lo: 0x1700, Hi: 0x1711, Stride: 1},
+		{Lo: 0x171f, Hi: 0x1731, Stride: 1},
 		{Lo: 0x1740, Hi: 0x1751, Stride: 1},
 		{Lo: 0x1760, Hi: 0x176c, Stride: 1},
 		{Lo: 0x176e, Hi: 0x1770, Stride: 1},
@@ -329,7 +334,7 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x19e1, Hi: 0x1a16, Stride: 1},
 		{Lo: 0x1a1e, Hi: 0x1a1f, Stride: 1},
 		{Lo: 0x1b05, Hi: 0x1b33, Stride: 1},
-		{Lo: 0x1b45, Hi: 0x1b4b, Stride: 1},
+		{Lo: 0x1b45, Hi: 0x1b4c, Stride: 1},
 		{Lo: 0x1b5c, Hi: 0x1b61, Stride: 5},
 		{Lo: 0x1b62, Hi: 0x1b6a, Stride: 1},
 		{Lo: 0x1b74, Hi: 0x1b7c, Stride: 1},
@@ -367,7 +372,7 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x2038, Hi: 0x203e, Stride: 6},
 		{Lo: 0x203f, Hi: 0x2043, Stride: 1},
 		{Lo: 0x204a, Hi: 0x2055, Stride: 1},
-		{Lo: 0x2057, Hi: 0x2061, Stride: 5},
+		{Lo: 0x205c, Hi: 0x2061, Stride: 5},
 		{Lo: 0x2062, Hi: 0x2064, Stride: 1},
 		{Lo: 0x2070, Hi: 0x2071, Stride: 1},
 		{Lo: 0x2075, Hi: 0x207c, Stride: 1},
@@ -469,9 +474,7 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x29fe, Hi: 0x2b54, Stride: 1},
 		{Lo: 0x2b5a, Hi: 0x2b73, Stride: 1},
 		{Lo: 0x2b76, Hi: 0x2b95, Stride: 1},
-		{Lo: 0x2b97, Hi: 0x2c2e, Stride: 1},
-		{Lo: 0x2c30, Hi: 0x2c5e, Stride: 1},
-		{Lo: 0x2c60, Hi: 0x2cee, Stride: 1},
+		{Lo: 0x2b97, Hi: 0x2cee, Stride: 1},
 		{Lo: 0x2cf2, Hi: 0x2cf3, Stride: 1},
 		{Lo: 0x2cfd, Hi: 0x2d00, Stride: 3},
 		{Lo: 0x2d01, Hi: 0x2d25, Stride: 1},
@@ -505,9 +508,11 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0xa67f, Hi: 0xa69d, Stride: 1},
 		{Lo: 0xa6a0, Hi: 0xa6ef, Stride: 1},
 		{Lo: 0xa6f2, Hi: 0xa700, Stride: 14},
-		{Lo: 0xa701, Hi: 0xa7bf, Stride: 1},
-		{Lo: 0xa7c2, Hi: 0xa7ca, Stride: 1},
-		{Lo: 0xa7f5, Hi: 0xa801, Stride: 1},
+		{Lo: 0xa701, Hi: 0xa7ca, Stride: 1},
+		{Lo: 0xa7d0, Hi: 0xa7d1, Stride: 1},
+		{Lo: 0xa7d3, Hi: 0xa7d5, Stride: 2},
+		{Lo: 0xa7d6, Hi: 0xa7d9, Stride: 1},
+		{Lo: 0xa7f2, Hi: 0xa801, Stride: 1},
 		{Lo: 0xa803, Hi: 0xa805, Stride: 1},
 		{Lo: 0xa807, Hi: 0xa80a, Stride: 1},
 		{Lo: 0xa80c, Hi: 0xa822, Stride: 1},
@@ -542,13 +547,14 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0xfb00, Hi: 0xfb06, Stride: 1},
 		{Lo: 0xfb13, Hi: 0xfb17, Stride: 1},
 		{Lo: 0xfb29, Hi: 0xfb50, Stride: 39},
-		{Lo: 0xfb51, Hi: 0xfbc1, Stride: 1},
+		{Lo: 0xfb51, Hi: 0xfbc2, Stride: 1},
 		{Lo: 0xfbd3, Hi: 0xfd3d, Stride: 1},
-		{Lo: 0xfd50, Hi: 0xfd8f, Stride: 1},
+		{Lo: 0xfd40, Hi: 0xfd8f, Stride: 1},
 		{Lo: 0xfd92, Hi: 0xfdc7, Stride: 1},
-		{Lo: 0xfdf0, Hi: 0xfdfb, Stride: 1},
-		{Lo: 0xfdfd, Hi: 0xfe70, Stride: 115},
-		{Lo: 0xfe71, Hi: 0xfe74, Stride: 1},
+		{Lo: 0xfdcf, Hi: 0xfdf0, Stride: 33},
+		{Lo: 0xfdf1, Hi: 0xfdfb, Stride: 1},
+		{Lo: 0xfdfd, Hi: 0xfdff, Stride: 1},
+		{Lo: 0xfe70, Hi: 0xfe74, Stride: 1},
 		{Lo: 0xfe76, Hi: 0xfefc, Stride: 1},
 		{Lo: 0xffe8, Hi: 0xffee, Stride: 1},
 	},
@@ -580,10 +586,20 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x104d8, Hi: 0x104fb, Stride: 1},
 		{Lo: 0x10500, Hi: 0x10527, Stride: 1},
 		{Lo: 0x10530, Hi: 0x10563, Stride: 1},
-		{Lo: 0x1056f, Hi: 0x10600, Stride: 145},
-		{Lo: 0x10601, Hi: 0x10736, Stride: 1},
+		{Lo: 0x1056f, Hi: 0x1057a, Stride: 1},
+		{Lo: 0x1057c, Hi: 0x1058a, Stride: 1},
+		{Lo: 0x1058c, Hi: 0x10592, Stride: 1},
+		{Lo: 0x10594, Hi: 0x10595, Stride: 1},
+		{Lo: 0x10597, Hi: 0x105a1, Stride: 1},
+		{Lo: 0x105a3, Hi: 0x105b1, Stride: 1},
+		{Lo: 0x105b3, Hi: 0x105b9, Stride: 1},
+		{Lo: 0x105bb, Hi: 0x105bc, Stride: 1},
+		{Lo: 0x10600, Hi: 0x10736, Stride: 1},
 		{Lo: 0x10740, Hi: 0x10755, Stride: 1},
 		{Lo: 0x10760, Hi: 0x10767, Stride: 1},
+		{Lo: 0x10780, Hi: 0x10785, Stride: 1},
+		{Lo: 0x10787, Hi: 0x107b0, Stride: 1},
+		{Lo: 0x107b2, Hi: 0x107ba, Stride: 1},
 		{Lo: 0x10800, Hi: 0x10805, Stride: 1},
 		{Lo: 0x10808, Hi: 0x1080a, Stride: 2},
 		{Lo: 0x1080b, Hi: 0x10835, Stride: 1},
@@ -624,12 +640,16 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x10f00, Hi: 0x10f27, Stride: 1},
 		{Lo: 0x10f30, Hi: 0x10f45, Stride: 1},
 		{Lo: 0x10f51, Hi: 0x10f59, Stride: 1},
+		{Lo: 0x10f70, Hi: 0x10f81, Stride: 1},
+		{Lo: 0x10f86, Hi: 0x10f89, Stride: 1},
 		{Lo: 0x10fb0, Hi: 0x10fcb, Stride: 1},
 		{Lo: 0x10fe0, Hi: 0x10ff6, Stride: 1},
 		{Lo: 0x11003, Hi: 0x11037, Stride: 1},
 		{Lo: 0x11049, Hi: 0x1104d, Stride: 1},
 		{Lo: 0x11052, Hi: 0x11065, Stride: 1},
-		{Lo: 0x11083, Hi: 0x110af, Stride: 1},
+		{Lo: 0x11071, Hi: 0x11072, Stride: 1},
+		{Lo: 0x11075, Hi: 0x11083, Stride: 14},
+		{Lo: 0x11084, Hi: 0x110af, Stride: 1},
 		{Lo: 0x110bb, Hi: 0x110bd, Stride: 1},
 		{Lo: 0x110cd, Hi: 0x110d0, Stride: 3},
 		{Lo: 0x110d1, Hi: 0x110e8, Stride: 1},
@@ -645,6 +665,7 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x11200, Hi: 0x11211, Stride: 1},
 		{Lo: 0x11213, Hi: 0x1122b, Stride: 1},
 		{Lo: 0x1123a, Hi: 0x1123d, Stride: 3},
+		{Lo: 0x1123f, Hi: 0x11240, Stride: 1},
 		{Lo: 0x11280, Hi: 0x11286, Stride: 1},
 		{Lo: 0x11288, Hi: 0x1128a, Stride: 2},
 		{Lo: 0x1128b, Hi: 0x1128d, Stride: 1},
@@ -671,8 +692,8 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x11600, Hi: 0x1162f, Stride: 1},
 		{Lo: 0x11643, Hi: 0x11644, Stride: 1},
 		{Lo: 0x11680, Hi: 0x116aa, Stride: 1},
-		{Lo: 0x116b8, Hi: 0x11800, Stride: 328},
-		{Lo: 0x11801, Hi: 0x1182b, Stride: 1},
+		{Lo: 0x116b8, Hi: 0x116b9, Stride: 1},
+		{Lo: 0x11800, Hi: 0x1182b, Stride: 1},
 		{Lo: 0x1183b, Hi: 0x118a0, Stride: 101},
 		{Lo: 0x118a1, Hi: 0x118df, Stride: 1},
 		{Lo: 0x118ea, Hi: 0x118f2, Stride: 1},
@@ -690,8 +711,8 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x11a3a, Hi: 0x11a46, Stride: 6},
 		{Lo: 0x11a50, Hi: 0x11a5c, Stride: 12},
 		{Lo: 0x11a5d, Hi: 0x11a89, Stride: 1},
-		{Lo: 0x11a9d, Hi: 0x11ac0, Stride: 35},
-		{Lo: 0x11ac1, Hi: 0x11af8, Stride: 1},
+		{Lo: 0x11a9d, Hi: 0x11ab0, Stride: 19},
+		{Lo: 0x11ab1, Hi: 0x11af8, Stride: 1},
 		{Lo: 0x11c00, Hi: 0x11c08, Stride: 1},
 		{Lo: 0x11c0a, Hi: 0x11c2e, Stride: 1},
 		{Lo: 0x11c40, Hi: 0x11c5a, Stride: 26},
@@ -707,21 +728,27 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x11d98, Hi: 0x11ee0, Stride: 328},
 		{Lo: 0x11ee1, Hi: 0x11ef2, Stride: 1},
 		{Lo: 0x11ef7, Hi: 0x11ef8, Stride: 1},
+		{Lo: 0x11f02, Hi: 0x11f04, Stride: 2},
+		{Lo: 0x11f05, Hi: 0x11f10, Stride: 1},
+		{Lo: 0x11f12, Hi: 0x11f33, Stride: 1},
 		{Lo: 0x11fb0, Hi: 0x11fc0, Stride: 16},
 		{Lo: 0x11fc1, Hi: 0x11fdc, Stride: 1},
 		{Lo: 0x11fe1, Hi: 0x11ff1, Stride: 1},
 		{Lo: 0x12000, Hi: 0x12399, Stride: 1},
 		{Lo: 0x12400, Hi: 0x1246e, Stride: 1},
 		{Lo: 0x12480, Hi: 0x12543, Stride: 1},
+		{Lo: 0x12f90, Hi: 0x12ff2, Stride: 1},
 		{Lo: 0x13000, Hi: 0x13257, Stride: 1},
 		{Lo: 0x1325e, Hi: 0x13281, Stride: 1},
 		{Lo: 0x13283, Hi: 0x13285, Stride: 1},
 		{Lo: 0x1328a, Hi: 0x13378, Stride: 1},
-		{Lo: 0x1337c, Hi: 0x1342e, Stride: 1},
+		{Lo: 0x1337c, Hi: 0x1342f, Stride: 1},
+		{Lo: 0x13441, Hi: 0x13446, Stride: 1},
 		{Lo: 0x14400, Hi: 0x145cd, Stride: 1},
 		{Lo: 0x145d0, Hi: 0x14646, Stride: 1},
 		{Lo: 0x16800, Hi: 0x16a38, Stride: 1},
 		{Lo: 0x16a40, Hi: 0x16a5e, Stride: 1},
+		{Lo: 0x16a70, Hi: 0x16abe, Stride: 1},
 		{Lo: 0x16ad0, Hi: 0x16aed, Stride: 1},
 		{Lo: 0x16b00, Hi: 0x16b2f, Stride: 1},
 		{Lo: 0x16b3a, Hi: 0x16b43, Stride: 1},
@@ -735,21 +762,26 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x16f50, Hi: 0x16f93, Stride: 67},
 		{Lo: 0x16f94, Hi: 0x16f9f, Stride: 1},
 		{Lo: 0x18b00, Hi: 0x18cd5, Stride: 1},
+		{Lo: 0x1aff0, Hi: 0x1aff3, Stride: 1},
+		{Lo: 0x1aff5, Hi: 0x1affb, Stride: 1},
+		{Lo: 0x1affd, Hi: 0x1affe, Stride: 1},
 		{Lo: 0x1bc00, Hi: 0x1bc6a, Stride: 1},
 		{Lo: 0x1bc70, Hi: 0x1bc7c, Stride: 1},
 		{Lo: 0x1bc80, Hi: 0x1bc88, Stride: 1},
 		{Lo: 0x1bc90, Hi: 0x1bc99, Stride: 1},
-		{Lo: 0x1bc9c, Hi: 0x1d000, Stride: 4964},
-		{Lo: 0x1d001, Hi: 0x1d0f5, Stride: 1},
+		{Lo: 0x1bc9c, Hi: 0x1cf50, Stride: 4788},
+		{Lo: 0x1cf51, Hi: 0x1cfc3, Stride: 1},
+		{Lo: 0x1d000, Hi: 0x1d0f5, Stride: 1},
 		{Lo: 0x1d100, Hi: 0x1d126, Stride: 1},
 		{Lo: 0x1d129, Hi: 0x1d164, Stride: 1},
 		{Lo: 0x1d16a, Hi: 0x1d16c, Stride: 1},
 		{Lo: 0x1d183, Hi: 0x1d184, Stride: 1},
 		{Lo: 0x1d18c, Hi: 0x1d1a9, Stride: 1},
-		{Lo: 0x1d1ae, Hi: 0x1d1e8, Stride: 1},
+		{Lo: 0x1d1ae, Hi: 0x1d1ea, Stride: 1},
 		{Lo: 0x1d200, Hi: 0x1d241, Stride: 1},
-		{Lo: 0x1d245, Hi: 0x1d2e0, Stride: 155},
-		{Lo: 0x1d2e1, Hi: 0x1d2f3, Stride: 1},
+		{Lo: 0x1d245, Hi: 0x1d2c0, Stride: 123},
+		{Lo: 0x1d2c1, Hi: 0x1d2d3, Stride: 1},
+		{Lo: 0x1d2e0, Hi: 0x1d2f3, Stride: 1},
 		{Lo: 0x1d300, Hi: 0x1d356, Stride: 1},
 		{Lo: 0x1d360, Hi: 0x1d378, Stride: 1},
 		{Lo: 0x1d400, Hi: 0x1d454, Stride: 1},
@@ -777,11 +809,20 @@ var BreakAL = &unicode.RangeTable{
 		{Lo: 0x1da6d, Hi: 0x1da74, Stride: 1},
 		{Lo: 0x1da76, Hi: 0x1da83, Stride: 1},
 		{Lo: 0x1da85, Hi: 0x1da86, Stride: 1},
-		{Lo: 0x1da8b, Hi: 0x1e100, Stride: 1653},
-		{Lo: 0x1e101, Hi: 0x1e12c, Stride: 1},
+		{Lo: 0x1da8b, Hi: 0x1df00, Stride: 1141},
+		{Lo: 0x1df01, Hi: 0x1df1e, Stride: 1},
+		{Lo: 0x1df25, Hi: 0x1df2a, Stride: 1},
+		{Lo: 0x1e030, Hi: 0x1e06d, Stride: 1},
+		{Lo: 0x1e100, Hi: 0x1e12c, Stride: 1},
 		{Lo: 0x1e137, Hi: 0x1e13d, Stride: 1},
 		{Lo: 0x1e14e, Hi: 0x1e14f, Stride: 1},
+		{Lo: 0x1e290, Hi: 0x1e2ad, Stride: 1},
 		{Lo: 0x1e2c0, Hi: 0x1e2eb, Stride: 1},
+		{Lo: 0x1e4d0, Hi: 0x1e4eb, Stride: 1},
+		{Lo: 0x1e7e0, Hi: 0x1e7e6, Stride: 1},
+		{Lo: 0x1e7e8, Hi: 0x1e7eb, Stride: 1},
+		{Lo: 0x1e7ed, Hi: 0x1e7ee, Stride: 1},
+		{Lo: 0x1e7f0, Hi: 0x1e7fe, Stride: 1},
 		{Lo: 0x1e800, Hi: 0x1e8c4, Stride: 1},
 		{Lo: 0x1e8c7, Hi: 0x1e8cf, Stride: 1},
 		{Lo: 0x1e900, Hi: 0x1e943, Stride: 1},
@@ -874,7 +915,8 @@ var BreakPR = &unicode.RangeTable{
 		{Lo: 0x20a8, Hi: 0x20b5, Stride: 1},
 		{Lo: 0x20b7, Hi: 0x20ba, Stride: 1},
 		{Lo: 0x20bc, Hi: 0x20bd, Stride: 1},
-		{Lo: 0x20bf, Hi: 0x20cf, Stride: 1},
+		{Lo: 0x20bf, Hi: 0x20c1, Stride: 2},
+		{Lo: 0x20c2, Hi: 0x20cf, Stride: 1},
 		{Lo: 0x2116, Hi: 0x2212, Stride: 252},
 		{Lo: 0x2213, Hi: 0xfe69, Stride: 56406},
 		{Lo: 0xff04, Hi: 0xffe1, Stride: 221},
@@ -896,8 +938,9 @@ var BreakPO = &unicode.RangeTable{
 		{Lo: 0x09f3, Hi: 0x09f9, Stride: 6},
 		{Lo: 0x0d79, Hi: 0x2030, Stride: 4791},
 		{Lo: 0x2031, Hi: 0x2037, Stride: 1},
-		{Lo: 0x20a7, Hi: 0x20b6, Stride: 15},
-		{Lo: 0x20bb, Hi: 0x20be, Stride: 3},
+		{Lo: 0x2057, Hi: 0x20a7, Stride: 80},
+		{Lo: 0x20b6, Hi: 0x20bb, Stride: 5},
+		{Lo: 0x20be, Hi: 0x20c0, Stride: 2},
 		{Lo: 0x2103, Hi: 0x2109, Stride: 6},
 		{Lo: 0xa838, Hi: 0xfdfc, Stride: 21956},
 		{Lo: 0xfe6a, Hi: 0xff05, Stride: 155},
@@ -928,8 +971,9 @@ var BreakOP = &unicode.RangeTable{
 		{Lo: 0x29d8, Hi: 0x29da, Stride: 2},
 		{Lo: 0x29fc, Hi: 0x2e18, Stride: 1052},
 		{Lo: 0x2e22, Hi: 0x2e28, Stride: 2},
-		{Lo: 0x2e42, Hi: 0x3008, Stride: 454},
-		{Lo: 0x300a, Hi: 0x3010, Stride: 2},
+		{Lo: 0x2e42, Hi: 0x2e55, Stride: 19},
+		{Lo: 0x2e57, Hi: 0x2e5b, Stride: 2},
+		{Lo: 0x3008, Hi: 0x3010, Stride: 2},
 		{Lo: 0x3014, Hi: 0x301a, Stride: 2},
 		{Lo: 0x301d, Hi: 0xfd3f, Stride: 52514},
 		{Lo: 0xfe17, Hi: 0xfe35, Stride: 30},
@@ -944,6 +988,7 @@ var BreakOP = &unicode.RangeTable{
 		{Lo: 0x13258, Hi: 0x1325a, Stride: 1},
 		{Lo: 0x13286, Hi: 0x13288, Stride: 2},
 		{Lo: 0x13379, Hi: 0x13437, Stride: 190},
+		{Lo: 0x1343c, Hi: 0x1343e, Stride: 2},
 		{Lo: 0x145ce, Hi: 0x1e95e, Stride: 41872},
 		{Lo: 0x1e95f, Hi: 0x1e95f, Stride: 1},
 	},
@@ -965,6 +1010,7 @@ var BreakCL = &unicode.RangeTable{
 		{Lo: 0x29d9, Hi: 0x29db, Stride: 2},
 		{Lo: 0x29fd, Hi: 0x2e23, Stride: 1062},
 		{Lo: 0x2e25, Hi: 0x2e29, Stride: 2},
+		{Lo: 0x2e56, Hi: 0x2e5c, Stride: 2},
 		{Lo: 0x3001, Hi: 0x3002, Stride: 1},
 		{Lo: 0x3009, Hi: 0x3011, Stride: 2},
 		{Lo: 0x3015, Hi: 0x301b, Stride: 2},
@@ -986,6 +1032,7 @@ var BreakCL = &unicode.RangeTable{
 		{Lo: 0x13282, Hi: 0x13287, Stride: 5},
 		{Lo: 0x13289, Hi: 0x1337a, Stride: 241},
 		{Lo: 0x1337b, Hi: 0x13438, Stride: 189},
+		{Lo: 0x1343d, Hi: 0x1343f, Stride: 2},
 		{Lo: 0x145cf, Hi: 0x145cf, Stride: 1},
 	},
 }
@@ -1041,11 +1088,13 @@ var BreakGL = &unicode.RangeTable{
 		{Lo: 0x0f08, Hi: 0x0f0c, Stride: 4},
 		{Lo: 0x0f12, Hi: 0x0fd9, Stride: 199},
 		{Lo: 0x0fda, Hi: 0x180e, Stride: 2100},
+		{Lo: 0x1dcd, Hi: 0x1dfc, Stride: 47},
 		{Lo: 0x2007, Hi: 0x2011, Stride: 10},
 		{Lo: 0x202f, Hi: 0x202f, Stride: 1},
 	},
 	R32: []unicode.Range32{
 		{Lo: 0x13430, Hi: 0x13436, Stride: 1},
+		{Lo: 0x13439, Hi: 0x1343b, Stride: 1},
 		{Lo: 0x16fe4, Hi: 0x16fe4, Stride: 1},
 	},
 }
@@ -1077,7 +1126,7 @@ var BreakEX = &unicode.RangeTable{
 	R16: []unicode.Range16{
 		{Lo: 0x0021, Hi: 0x003f, Stride: 30},
 		{Lo: 0x05c6, Hi: 0x061b, Stride: 85},
-		{Lo: 0x061e, Hi: 0x061f, Stride: 1},
+		{Lo: 0x061d, Hi: 0x061f, Stride: 1},
 		{Lo: 0x06d4, Hi: 0x07f9, Stride: 293},
 		{Lo: 0x0f0d, Hi: 0x0f11, Stride: 1},
 		{Lo: 0x0f14, Hi: 0x1802, Stride: 2286},
@@ -1086,6 +1135,7 @@ var BreakEX = &unicode.RangeTable{
 		{Lo: 0x1945, Hi: 0x2762, Stride: 3613},
 		{Lo: 0x2763, Hi: 0x2cf9, Stride: 1430},
 		{Lo: 0x2cfe, Hi: 0x2e2e, Stride: 304},
+		{Lo: 0x2e53, Hi: 0x2e54, Stride: 1},
 		{Lo: 0xa60e, Hi: 0xa876, Stride: 616},
 		{Lo: 0xa877, Hi: 0xfe15, Stride: 21918},
 		{Lo: 0xfe16, Hi: 0xfe56, Stride: 64},
@@ -1211,10 +1261,11 @@ var BreakID = &unicode.RangeTable{
 		{Lo: 0xffe2, Hi: 0xffe4, Stride: 1},
 	},
 	R32: []unicode.Range32{
+		{Lo: 0x11f45, Hi: 0x11f4f, Stride: 1},
 		{Lo: 0x17000, Hi: 0x187f7, Stride: 1},
 		{Lo: 0x18800, Hi: 0x18aff, Stride: 1},
 		{Lo: 0x18d00, Hi: 0x18d08, Stride: 1},
-		{Lo: 0x1b000, Hi: 0x1b11e, Stride: 1},
+		{Lo: 0x1b000, Hi: 0x1b122, Stride: 1},
 		{Lo: 0x1b170, Hi: 0x1b2fb, Stride: 1},
 		{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
 		{Lo: 0x1f10d, Hi: 0x1f10f, Stride: 1},
@@ -1275,7 +1326,9 @@ var BreakID = &unicode.RangeTable{
 		{Lo: 0x1f9bc, Hi: 0x1f9cc, Stride: 1},
 		{Lo: 0x1f9d0, Hi: 0x1f9de, Stride: 14},
 		{Lo: 0x1f9df, Hi: 0x1f9ff, Stride: 1},
-		{Lo: 0x1fa54, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fa54, Hi: 0x1fac2, Stride: 1},
+		{Lo: 0x1fac6, Hi: 0x1faef, Stride: 1},
+		{Lo: 0x1faf9, Hi: 0x1faff, Stride: 1},
 		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
 		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
 		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
@@ -1314,6 +1367,7 @@ var BreakBA = &unicode.RangeTable{
 		{Lo: 0x1804, Hi: 0x1805, Stride: 1},
 		{Lo: 0x1b5a, Hi: 0x1b5b, Stride: 1},
 		{Lo: 0x1b5d, Hi: 0x1b60, Stride: 1},
+		{Lo: 0x1b7d, Hi: 0x1b7e, Stride: 1},
 		{Lo: 0x1c3b, Hi: 0x1c3f, Stride: 1},
 		{Lo: 0x1c7e, Hi: 0x1c7f, Stride: 1},
 		{Lo: 0x2000, Hi: 0x2006, Stride: 1},
@@ -1334,10 +1388,11 @@ var BreakBA = &unicode.RangeTable{
 		{Lo: 0x2e40, Hi: 0x2e41, Stride: 1},
 		{Lo: 0x2e43, Hi: 0x2e4a, Stride: 1},
 		{Lo: 0x2e4c, Hi: 0x2e4e, Stride: 2},
-		{Lo: 0x2e4f, Hi: 0x3000, Stride: 433},
-		{Lo: 0xa4fe, Hi: 0xa4ff, Stride: 1},
-		{Lo: 0xa60d, Hi: 0xa60f, Stride: 2},
-		{Lo: 0xa6f3, Hi: 0xa6f7, Stride: 1},
+		{Lo: 0x2e4f, Hi: 0x2e5d, Stride: 14},
+		{Lo: 0x3000, Hi: 0xa4fe, Stride: 29950},
+		{Lo: 0xa4ff, Hi: 0xa60d, Stride: 270},
+		{Lo: 0xa60f, Hi: 0xa6f3, Stride: 228},
+		{Lo: 0xa6f4, Hi: 0xa6f7, Stride: 1},
 		{Lo: 0xa8ce, Hi: 0xa8cf, Stride: 1},
 		{Lo: 0xa92e, Hi: 0xa92f, Stride: 1},
 		{Lo: 0xa9c7, Hi: 0xa9c9, Stride: 1},
@@ -1373,6 +1428,7 @@ var BreakBA = &unicode.RangeTable{
 		{Lo: 0x11a9a, Hi: 0x11a9c, Stride: 1},
 		{Lo: 0x11aa1, Hi: 0x11aa2, Stride: 1},
 		{Lo: 0x11c41, Hi: 0x11c45, Stride: 1},
+		{Lo: 0x11f43, Hi: 0x11f44, Stride: 1},
 		{Lo: 0x11fff, Hi: 0x12470, Stride: 1137},
 		{Lo: 0x12471, Hi: 0x12474, Stride: 1},
 		{Lo: 0x16a6e, Hi: 0x16a6f, Stride: 1},
@@ -1406,6 +1462,7 @@ var BreakBB = &unicode.RangeTable{
 		{Lo: 0x119e2, Hi: 0x11a3f, Stride: 93},
 		{Lo: 0x11a45, Hi: 0x11a9e, Stride: 89},
 		{Lo: 0x11a9f, Hi: 0x11aa0, Stride: 1},
+		{Lo: 0x11b00, Hi: 0x11b09, Stride: 1},
 		{Lo: 0x11c70, Hi: 0x11c70, Stride: 1},
 	},
 }
@@ -1458,7 +1515,8 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x0825, Hi: 0x0827, Stride: 1},
 		{Lo: 0x0829, Hi: 0x082d, Stride: 1},
 		{Lo: 0x0859, Hi: 0x085b, Stride: 1},
-		{Lo: 0x08d3, Hi: 0x08e1, Stride: 1},
+		{Lo: 0x0898, Hi: 0x089f, Stride: 1},
+		{Lo: 0x08ca, Hi: 0x08e1, Stride: 1},
 		{Lo: 0x08e3, Hi: 0x0903, Stride: 1},
 		{Lo: 0x093a, Hi: 0x093c, Stride: 1},
 		{Lo: 0x093e, Hi: 0x094f, Stride: 1},
@@ -1498,7 +1556,8 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x0bca, Hi: 0x0bcd, Stride: 1},
 		{Lo: 0x0bd7, Hi: 0x0c00, Stride: 41},
 		{Lo: 0x0c01, Hi: 0x0c04, Stride: 1},
-		{Lo: 0x0c3e, Hi: 0x0c44, Stride: 1},
+		{Lo: 0x0c3c, Hi: 0x0c3e, Stride: 2},
+		{Lo: 0x0c3f, Hi: 0x0c44, Stride: 1},
 		{Lo: 0x0c46, Hi: 0x0c48, Stride: 1},
 		{Lo: 0x0c4a, Hi: 0x0c4d, Stride: 1},
 		{Lo: 0x0c55, Hi: 0x0c56, Stride: 1},
@@ -1510,7 +1569,8 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x0cca, Hi: 0x0ccd, Stride: 1},
 		{Lo: 0x0cd5, Hi: 0x0cd6, Stride: 1},
 		{Lo: 0x0ce2, Hi: 0x0ce3, Stride: 1},
-		{Lo: 0x0d00, Hi: 0x0d03, Stride: 1},
+		{Lo: 0x0cf3, Hi: 0x0d00, Stride: 13},
+		{Lo: 0x0d01, Hi: 0x0d03, Stride: 1},
 		{Lo: 0x0d3b, Hi: 0x0d3c, Stride: 1},
 		{Lo: 0x0d3e, Hi: 0x0d44, Stride: 1},
 		{Lo: 0x0d46, Hi: 0x0d48, Stride: 1},
@@ -1533,18 +1593,18 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x0f99, Hi: 0x0fbc, Stride: 1},
 		{Lo: 0x0fc6, Hi: 0x135d, Stride: 919},
 		{Lo: 0x135e, Hi: 0x135f, Stride: 1},
-		{Lo: 0x1712, Hi: 0x1714, Stride: 1},
+		{Lo: 0x1712, Hi: 0x1715, Stride: 1},
 		{Lo: 0x1732, Hi: 0x1734, Stride: 1},
 		{Lo: 0x1752, Hi: 0x1753, Stride: 1},
 		{Lo: 0x1772, Hi: 0x1773, Stride: 1},
 		{Lo: 0x180b, Hi: 0x180d, Stride: 1},
-		{Lo: 0x1885, Hi: 0x1886, Stride: 1},
-		{Lo: 0x18a9, Hi: 0x1920, Stride: 119},
-		{Lo: 0x1921, Hi: 0x192b, Stride: 1},
+		{Lo: 0x180f, Hi: 0x1885, Stride: 118},
+		{Lo: 0x1886, Hi: 0x18a9, Stride: 35},
+		{Lo: 0x1920, Hi: 0x192b, Stride: 1},
 		{Lo: 0x1930, Hi: 0x193b, Stride: 1},
 		{Lo: 0x1a17, Hi: 0x1a1b, Stride: 1},
 		{Lo: 0x1a7f, Hi: 0x1ab0, Stride: 49},
-		{Lo: 0x1ab1, Hi: 0x1ac0, Stride: 1},
+		{Lo: 0x1ab1, Hi: 0x1ace, Stride: 1},
 		{Lo: 0x1b00, Hi: 0x1b04, Stride: 1},
 		{Lo: 0x1b34, Hi: 0x1b44, Stride: 1},
 		{Lo: 0x1b6b, Hi: 0x1b73, Stride: 1},
@@ -1556,8 +1616,9 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x1cd4, Hi: 0x1ce8, Stride: 1},
 		{Lo: 0x1ced, Hi: 0x1cf4, Stride: 7},
 		{Lo: 0x1cf7, Hi: 0x1cf9, Stride: 1},
-		{Lo: 0x1dc0, Hi: 0x1df9, Stride: 1},
-		{Lo: 0x1dfb, Hi: 0x1dff, Stride: 1},
+		{Lo: 0x1dc0, Hi: 0x1dcc, Stride: 1},
+		{Lo: 0x1dce, Hi: 0x1dfb, Stride: 1},
+		{Lo: 0x1dfd, Hi: 0x1dff, Stride: 1},
 		{Lo: 0x200c, Hi: 0x200e, Stride: 2},
 		{Lo: 0x200f, Hi: 0x202a, Stride: 27},
 		{Lo: 0x202b, Hi: 0x202e, Stride: 1},
@@ -1608,12 +1669,17 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x10ae6, Hi: 0x10d24, Stride: 574},
 		{Lo: 0x10d25, Hi: 0x10d27, Stride: 1},
 		{Lo: 0x10eab, Hi: 0x10eac, Stride: 1},
+		{Lo: 0x10efd, Hi: 0x10eff, Stride: 1},
 		{Lo: 0x10f46, Hi: 0x10f50, Stride: 1},
+		{Lo: 0x10f82, Hi: 0x10f85, Stride: 1},
 		{Lo: 0x11000, Hi: 0x11002, Stride: 1},
 		{Lo: 0x11038, Hi: 0x11046, Stride: 1},
-		{Lo: 0x1107f, Hi: 0x11082, Stride: 1},
+		{Lo: 0x11070, Hi: 0x11073, Stride: 3},
+		{Lo: 0x11074, Hi: 0x1107f, Stride: 11},
+		{Lo: 0x11080, Hi: 0x11082, Stride: 1},
 		{Lo: 0x110b0, Hi: 0x110ba, Stride: 1},
-		{Lo: 0x11100, Hi: 0x11102, Stride: 1},
+		{Lo: 0x110c2, Hi: 0x11100, Stride: 62},
+		{Lo: 0x11101, Hi: 0x11102, Stride: 1},
 		{Lo: 0x11127, Hi: 0x11134, Stride: 1},
 		{Lo: 0x11145, Hi: 0x11146, Stride: 1},
 		{Lo: 0x11173, Hi: 0x11180, Stride: 13},
@@ -1622,8 +1688,8 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x111c9, Hi: 0x111cc, Stride: 1},
 		{Lo: 0x111ce, Hi: 0x111cf, Stride: 1},
 		{Lo: 0x1122c, Hi: 0x11237, Stride: 1},
-		{Lo: 0x1123e, Hi: 0x112df, Stride: 161},
-		{Lo: 0x112e0, Hi: 0x112ea, Stride: 1},
+		{Lo: 0x1123e, Hi: 0x11241, Stride: 3},
+		{Lo: 0x112df, Hi: 0x112ea, Stride: 1},
 		{Lo: 0x11300, Hi: 0x11303, Stride: 1},
 		{Lo: 0x1133b, Hi: 0x1133c, Stride: 1},
 		{Lo: 0x1133e, Hi: 0x11344, Stride: 1},
@@ -1669,6 +1735,12 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x11d90, Hi: 0x11d91, Stride: 1},
 		{Lo: 0x11d93, Hi: 0x11d97, Stride: 1},
 		{Lo: 0x11ef3, Hi: 0x11ef6, Stride: 1},
+		{Lo: 0x11f00, Hi: 0x11f01, Stride: 1},
+		{Lo: 0x11f03, Hi: 0x11f34, Stride: 49},
+		{Lo: 0x11f35, Hi: 0x11f3a, Stride: 1},
+		{Lo: 0x11f3e, Hi: 0x11f42, Stride: 1},
+		{Lo: 0x13440, Hi: 0x13447, Stride: 7},
+		{Lo: 0x13448, Hi: 0x13455, Stride: 1},
 		{Lo: 0x16af0, Hi: 0x16af4, Stride: 1},
 		{Lo: 0x16b30, Hi: 0x16b36, Stride: 1},
 		{Lo: 0x16f4f, Hi: 0x16f51, Stride: 2},
@@ -1677,6 +1749,8 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x16ff0, Hi: 0x16ff1, Stride: 1},
 		{Lo: 0x1bc9d, Hi: 0x1bc9e, Stride: 1},
 		{Lo: 0x1bca0, Hi: 0x1bca3, Stride: 1},
+		{Lo: 0x1cf00, Hi: 0x1cf2d, Stride: 1},
+		{Lo: 0x1cf30, Hi: 0x1cf46, Stride: 1},
 		{Lo: 0x1d165, Hi: 0x1d169, Stride: 1},
 		{Lo: 0x1d16d, Hi: 0x1d182, Stride: 1},
 		{Lo: 0x1d185, Hi: 0x1d18b, Stride: 1},
@@ -1692,8 +1766,11 @@ var BreakCM = &unicode.RangeTable{
 		{Lo: 0x1e01b, Hi: 0x1e021, Stride: 1},
 		{Lo: 0x1e023, Hi: 0x1e024, Stride: 1},
 		{Lo: 0x1e026, Hi: 0x1e02a, Stride: 1},
-		{Lo: 0x1e130, Hi: 0x1e136, Stride: 1},
-		{Lo: 0x1e2ec, Hi: 0x1e2ef, Stride: 1},
+		{Lo: 0x1e08f, Hi: 0x1e130, Stride: 161},
+		{Lo: 0x1e131, Hi: 0x1e136, Stride: 1},
+		{Lo: 0x1e2ae, Hi: 0x1e2ec, Stride: 62},
+		{Lo: 0x1e2ed, Hi: 0x1e2ef, Stride: 1},
+		{Lo: 0x1e4ec, Hi: 0x1e4ef, Stride: 1},
 		{Lo: 0x1e8d0, Hi: 0x1e8d6, Stride: 1},
 		{Lo: 0x1e944, Hi: 0x1e94a, Stride: 1},
 		{Lo: 0xe0001, Hi: 0xe0020, Stride: 31},
@@ -1740,6 +1817,8 @@ var BreakEB = &unicode.RangeTable{
 		{Lo: 0x1f9b9, Hi: 0x1f9bb, Stride: 2},
 		{Lo: 0x1f9cd, Hi: 0x1f9cf, Stride: 1},
 		{Lo: 0x1f9d1, Hi: 0x1f9dd, Stride: 1},
+		{Lo: 0x1fac3, Hi: 0x1fac5, Stride: 1},
+		{Lo: 0x1faf0, Hi: 0x1faf8, Stride: 1},
 	},
 }
 
@@ -2330,8 +2409,10 @@ var BreakCJ = &unicode.RangeTable{
 		{Lo: 0xff67, Hi: 0xff70, Stride: 1},
 	},
 	R32: []unicode.Range32{
-		{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
-		{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+		{Lo: 0x1b132, Hi: 0x1b150, Stride: 30},
+		{Lo: 0x1b151, Hi: 0x1b152, Stride: 1},
+		{Lo: 0x1b155, Hi: 0x1b164, Stride: 15},
+		{Lo: 0x1b165, Hi: 0x1b167, Stride: 1},
 	},
 }
 
@@ -2348,7 +2429,7 @@ var BreakSA = &unicode.RangeTable{
 		{Lo: 0x0ea8, Hi: 0x0ebd, Stride: 1},
 		{Lo: 0x0ec0, Hi: 0x0ec4, Stride: 1},
 		{Lo: 0x0ec6, Hi: 0x0ec8, Stride: 2},
-		{Lo: 0x0ec9, Hi: 0x0ecd, Stride: 1},
+		{Lo: 0x0ec9, Hi: 0x0ece, Stride: 1},
 		{Lo: 0x0edc, Hi: 0x0edf, Stride: 1},
 		{Lo: 0x1000, Hi: 0x103f, Stride: 1},
 		{Lo: 0x1050, Hi: 0x108f, Stride: 1},
@@ -2374,7 +2455,7 @@ var BreakSA = &unicode.RangeTable{
 		{Lo: 0x11700, Hi: 0x1171a, Stride: 1},
 		{Lo: 0x1171d, Hi: 0x1172b, Stride: 1},
 		{Lo: 0x1173a, Hi: 0x1173b, Stride: 1},
-		{Lo: 0x1173f, Hi: 0x1173f, Stride: 1},
+		{Lo: 0x1173f, Hi: 0x11746, Stride: 1},
 	},
 }
 
@@ -6,6 +6,13 @@ import (
 	"unicode"
 )
 
+// UnicodeVersion is the version of the Unicode Character Database
+// the segmentation property tables (line, grapheme, word and sentence breaks)
+// are generated from, by the genbreaks command.
+const UnicodeVersion = "15.0.0"
+
+//go:generate go run ./genbreaks
+
 var categories []*unicode.RangeTable
 
 func init() {
@@ -73,6 +80,47 @@ func LookupGraphemeBreakClass(ch rune) *unicode.RangeTable {
 	return nil
 }
 
+// LookupWordBreakClass returns the word break property for the rune (see the constants WordBreakXXX),
+// or nil
+func LookupWordBreakClass(ch rune) *unicode.RangeTable {
+	// a lot of runes do not have a word break property :
+	// avoid testing all the wordBreaks classes for them
+	if !unicode.Is(wordBreakAll, ch) {
+		return nil
+	}
+	for _, class := range wordBreaks {
+		if unicode.Is(class, ch) {
+			return class
+		}
+	}
+	return nil
+}
+
+// LookupSentenceBreakClass returns the sentence break property for the rune (see the constants SentenceBreakXXX),
+// or nil
+func LookupSentenceBreakClass(ch rune) *unicode.RangeTable {
+	if !unicode.Is(sentenceBreakAll, ch) {
+		return nil
+	}
+	for _, class := range sentenceBreaks {
+		if unicode.Is(class, ch) {
+			return class
+		}
+	}
+	return nil
+}
+
+// LookupBidiClass returns the Bidi_Class property for the rune (see the constants BidiXXX),
+// defaulting to BidiL.
+func LookupBidiClass(ch rune) *unicode.RangeTable {
+	for _, class := range bidiClasses {
+		if unicode.Is(class, ch) {
+			return class
+		}
+	}
+	return BidiL
+}
+
 // LookupMirrorChar finds the mirrored equivalent of a character as defined in
 // the file BidiMirroring.txt of the Unicode Character Database available at
 // http://www.unicode.org/Public/UNIDATA/BidiMirroring.txt.
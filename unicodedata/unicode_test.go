@@ -568,6 +568,10 @@ func TestLookupGraphemeBreakClass(t *testing.T) {
 		{'\uAC02', GraphemeBreakLVT}, // ( 갂 ) HANGUL SYLLABLE GAGG
 		{'\uAC03', GraphemeBreakLVT}, // ( 갃 ) HANGUL SYLLABLE GAGS
 		{'\uAC04', GraphemeBreakLVT}, // ( 간 ) HANGUL SYLLABLE GAN
+
+		// Unicode 15.0 additions
+		{'\U00011F00', GraphemeBreakExtend},      // KAWI SIGN CANDRABINDU
+		{'\U00011F03', GraphemeBreakSpacingMark}, // KAWI SIGN VISARGA
 	}
 	for _, tt := range tests {
 		if got := LookupGraphemeBreakClass(tt.args); got != tt.want {
@@ -576,6 +580,71 @@ func TestLookupGraphemeBreakClass(t *testing.T) {
 	}
 }
 
+func TestLookupWordBreakClass(t *testing.T) {
+	// See https://www.unicode.org/reports/tr29/#Word_Boundaries
+	tests := []struct {
+		args rune
+		want *unicode.RangeTable
+	}{
+		{-1, nil},
+		{'!', nil},
+		{'a', WordBreakALetter},
+		{'\u05D0', WordBreakHebrew_Letter}, // HEBREW LETTER ALEF
+		{'\u30A2', WordBreakKatakana},      // KATAKANA LETTER A
+		{'0', WordBreakNumeric},
+		{'_', WordBreakExtendNumLet},
+		{'.', WordBreakMidNumLet},
+		{':', WordBreakMidLetter},
+		{',', WordBreakMidNum},
+		{'\'', WordBreakSingle_Quote},
+		{'"', WordBreakDouble_Quote},
+		{' ', WordBreakWSegSpace},
+		{'\r', WordBreakCR},
+		{'\n', WordBreakLF},
+		{'\u2028', WordBreakNewline},
+		{'\u0308', WordBreakExtend}, // COMBINING DIAERESIS
+		{'\u00AD', WordBreakFormat}, // SOFT HYPHEN
+		{'\u200D', WordBreakZWJ},
+		{'\U0001F1E6', WordBreakRegional_Indicator},
+	}
+	for _, tt := range tests {
+		if got := LookupWordBreakClass(tt.args); got != tt.want {
+			t.Errorf("LookupWordBreakClass(%x) = %p, want %p", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestLookupSentenceBreakClass(t *testing.T) {
+	// See https://www.unicode.org/reports/tr29/#Sentence_Boundaries
+	tests := []struct {
+		args rune
+		want *unicode.RangeTable
+	}{
+		{-1, nil},
+		{'#', nil},
+		{'a', SentenceBreakLower},
+		{'A', SentenceBreakUpper},
+		{'\u05D0', SentenceBreakOLetter}, // HEBREW LETTER ALEF
+		{'0', SentenceBreakNumeric},
+		{'.', SentenceBreakATerm},
+		{'!', SentenceBreakSTerm},
+		{'\u3002', SentenceBreakSTerm}, // IDEOGRAPHIC FULL STOP
+		{',', SentenceBreakSContinue},
+		{')', SentenceBreakClose},
+		{' ', SentenceBreakSp},
+		{'\r', SentenceBreakCR},
+		{'\n', SentenceBreakLF},
+		{'\u2029', SentenceBreakSep},
+		{'\u0308', SentenceBreakExtend}, // COMBINING DIAERESIS
+		{'\u00AD', SentenceBreakFormat}, // SOFT HYPHEN
+	}
+	for _, tt := range tests {
+		if got := LookupSentenceBreakClass(tt.args); got != tt.want {
+			t.Errorf("LookupSentenceBreakClass(%x) = %p, want %p", tt.args, got, tt.want)
+		}
+	}
+}
+
 func TestLookupMirrorChar(t *testing.T) {
 	tests := []struct {
 		args  rune
@@ -598,3 +667,112 @@ func TestLookupMirrorChar(t *testing.T) {
 		}
 	}
 }
+
+func TestLookupJoiningType(t *testing.T) {
+	for _, test := range []struct {
+		r     rune
+		want  ArabicJoining
+		group ArabicJoining
+	}{
+		{'a', U, 0},
+		{0x0627, R, 0}, // ARABIC LETTER ALEF
+		{0x0633, D, 0}, // ARABIC LETTER SEEN
+		{0x0640, C, 0}, // ARABIC TATWEEL
+		{0x064E, T, 0}, // ARABIC FATHA
+		{0x200D, C, 0}, // ZERO WIDTH JOINER
+		{0x200C, U, 0}, // ZERO WIDTH NON-JOINER
+		{0x0710, R, Alaph},
+		{0x0715, R, DalathRish},
+	} {
+		if got := LookupJoiningType(test.r); got != test.want {
+			t.Errorf("LookupJoiningType(%x) = %c, want %c", test.r, got, test.want)
+		}
+		if got := LookupSyriacJoiningGroup(test.r); got != test.group {
+			t.Errorf("LookupSyriacJoiningGroup(%x) = %c, want %c", test.r, got, test.group)
+		}
+	}
+}
+
+func TestArabicPresentationForms(t *testing.T) {
+	// salam : seen lam alef meem
+	input := []rune{0x0633, 0x0644, 0x0627, 0x0645}
+	if got, want := ArabicPresentationForms(nil, input, false), []rune{0xFEB3, 0xFEE0, 0xFE8E, 0xFEE1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %x, got %x", want, got)
+	}
+	if got, want := ArabicPresentationForms(nil, input, true), []rune{0xFEB3, 0xFEFC, 0xFEE1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %x, got %x", want, got)
+	}
+	// transparent marks are skipped : seen fatha seen
+	input = []rune{0x0633, 0x064E, 0x0633}
+	if got, want := ArabicPresentationForms([]rune{'a'}, input, true), []rune{'a', 0xFEB3, 0x064E, 0xFEB2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %x, got %x", want, got)
+	}
+	// ZWNJ breaks the joining
+	input = []rune{0x0633, 0x200C, 0x0633}
+	if got, want := ArabicPresentationForms(nil, input, true), []rune{0xFEB1, 0x200C, 0xFEB1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %x, got %x", want, got)
+	}
+}
+
+func TestWhitespace(t *testing.T) {
+	for _, test := range []struct {
+		r                                           rune
+		breaking, nonBreaking, zeroWidth, seg, para bool
+	}{
+		{'a', false, false, false, false, false},
+		{' ', true, false, false, false, false},
+		{0x2003, true, false, false, false, false},  // em space
+		{0x1680, true, false, false, false, false},  // ogham space mark
+		{0x00A0, false, true, false, false, false},  // no-break space
+		{0x202F, false, true, false, false, false},  // narrow no-break space
+		{0x200B, false, false, true, false, false},  // zero width space
+		{0x200D, false, false, true, false, false},  // zero width joiner
+		{0x2067, false, false, true, false, false},  // right-to-left isolate
+		{0x00AD, false, false, true, false, false},  // soft hyphen
+		{0xFE0F, false, false, true, false, false},  // variation selector 16
+		{0x0600, false, false, false, false, false}, // arabic number sign
+		{'\t', false, false, false, true, false},
+		{'\n', false, false, false, false, true},
+		{0x2029, false, false, false, false, true}, // paragraph separator
+	} {
+		if IsBreakingSpace(test.r) != test.breaking {
+			t.Errorf("%U: unexpected IsBreakingSpace", test.r)
+		}
+		if IsNonBreakingSpace(test.r) != test.nonBreaking {
+			t.Errorf("%U: unexpected IsNonBreakingSpace", test.r)
+		}
+		if IsZeroWidth(test.r) != test.zeroWidth {
+			t.Errorf("%U: unexpected IsZeroWidth", test.r)
+		}
+		if IsSegmentSeparator(test.r) != test.seg {
+			t.Errorf("%U: unexpected IsSegmentSeparator", test.r)
+		}
+		if IsParagraphSeparator(test.r) != test.para {
+			t.Errorf("%U: unexpected IsParagraphSeparator", test.r)
+		}
+	}
+}
+
+func TestLookupVerticalOrientation(t *testing.T) {
+	for r, expected := range map[rune]VerticalOrientation{
+		'a':     VerticalRotated,
+		'1':     VerticalRotated,
+		'ب':     VerticalRotated,
+		'©':     VerticalUpright,
+		'日':     VerticalUpright,
+		'あ':     VerticalUpright,
+		'한':     VerticalUpright,
+		0x1F600: VerticalUpright,
+		0x20000: VerticalUpright,
+		'ぁ':     VerticalTransformedUpright,
+		'。':     VerticalTransformedUpright,
+		'ー':     VerticalTransformedRotated,
+		'「':     VerticalTransformedRotated,
+		'（':     VerticalTransformedRotated,
+		'ｱ':     VerticalRotated, // halfwidth katakana
+	} {
+		if got := LookupVerticalOrientation(r); got != expected {
+			t.Errorf("%U: expected %d, got %d", r, expected, got)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package unicodedata
+
+import "unicode"
+
+// VerticalOrientation is the Vertical_Orientation property of a rune,
+// defined in UAX #50, which gives its default orientation in vertical text.
+type VerticalOrientation uint8
+
+const (
+	// VerticalRotated (R) runes are displayed sideways, rotated 90 degrees clockwise.
+	VerticalRotated VerticalOrientation = iota
+	// VerticalUpright (U) runes are displayed upright.
+	VerticalUpright
+	// VerticalTransformedUpright (Tu) runes are displayed upright, using
+	// a vertical alternate glyph if available (like small kana or ideographic punctuation).
+	VerticalTransformedUpright
+	// VerticalTransformedRotated (Tr) runes use a vertical alternate glyph
+	// if available (like brackets or the prolonged sound mark), and are rotated otherwise.
+	VerticalTransformedRotated
+)
+
+// LookupVerticalOrientation returns the Vertical_Orientation property of [r].
+// Runes not covered by the tables, like Latin, Greek or Arabic letters, are [VerticalRotated].
+func LookupVerticalOrientation(r rune) VerticalOrientation {
+	switch {
+	case unicode.Is(VerticalTransformedRotatedTable, r):
+		return VerticalTransformedRotated
+	case unicode.Is(VerticalTransformedUprightTable, r):
+		return VerticalTransformedUpright
+	case unicode.Is(VerticalUprightTable, r):
+		return VerticalUpright
+	default:
+		return VerticalRotated
+	}
+}
+
+// The tables below are a condensed version of the data of UAX #50,
+// where the unassigned code points of the East Asian blocks are upright.
+
+// VerticalUprightTable matches runes with Vertical_Orientation property of U.
+var VerticalUprightTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a7, Hi: 0x00a7, Stride: 1},
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x00b1, Hi: 0x00b1, Stride: 1},
+		{Lo: 0x00bc, Hi: 0x00be, Stride: 1},
+		{Lo: 0x00d7, Hi: 0x00d7, Stride: 1},
+		{Lo: 0x00f7, Hi: 0x00f7, Stride: 1},
+		{Lo: 0x02ea, Hi: 0x02eb, Stride: 1},
+		{Lo: 0x1100, Hi: 0x11ff, Stride: 1},
+		{Lo: 0x1401, Hi: 0x167f, Stride: 1},
+		{Lo: 0x18b0, Hi: 0x18ff, Stride: 1},
+		{Lo: 0x2016, Hi: 0x2016, Stride: 1},
+		{Lo: 0x2020, Hi: 0x2021, Stride: 1},
+		{Lo: 0x2030, Hi: 0x2031, Stride: 1},
+		{Lo: 0x203b, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2042, Hi: 0x2042, Stride: 1},
+		{Lo: 0x2047, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2051, Hi: 0x2051, Stride: 1},
+		{Lo: 0x2065, Hi: 0x2065, Stride: 1},
+		{Lo: 0x20dd, Hi: 0x20e0, Stride: 1},
+		{Lo: 0x20e2, Hi: 0x20e4, Stride: 1},
+		{Lo: 0x2100, Hi: 0x2101, Stride: 1},
+		{Lo: 0x2103, Hi: 0x2109, Stride: 1},
+		{Lo: 0x210f, Hi: 0x210f, Stride: 1},
+		{Lo: 0x2113, Hi: 0x2114, Stride: 1},
+		{Lo: 0x2116, Hi: 0x2117, Stride: 1},
+		{Lo: 0x211e, Hi: 0x2123, Stride: 1},
+		{Lo: 0x2125, Hi: 0x2125, Stride: 1},
+		{Lo: 0x2127, Hi: 0x2127, Stride: 1},
+		{Lo: 0x2129, Hi: 0x2129, Stride: 1},
+		{Lo: 0x212e, Hi: 0x212e, Stride: 1},
+		{Lo: 0x2135, Hi: 0x213f, Stride: 1},
+		{Lo: 0x2145, Hi: 0x214a, Stride: 1},
+		{Lo: 0x214c, Hi: 0x214d, Stride: 1},
+		{Lo: 0x214f, Hi: 0x2189, Stride: 1},
+		{Lo: 0x218c, Hi: 0x218f, Stride: 1},
+		{Lo: 0x221e, Hi: 0x221e, Stride: 1},
+		{Lo: 0x2234, Hi: 0x2235, Stride: 1},
+		{Lo: 0x2300, Hi: 0x2307, Stride: 1},
+		{Lo: 0x230c, Hi: 0x231f, Stride: 1},
+		{Lo: 0x2324, Hi: 0x2328, Stride: 1},
+		{Lo: 0x232b, Hi: 0x232b, Stride: 1},
+		{Lo: 0x237d, Hi: 0x239a, Stride: 1},
+		{Lo: 0x23be, Hi: 0x23cd, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23d1, Hi: 0x23db, Stride: 1},
+		{Lo: 0x23e2, Hi: 0x24ff, Stride: 1},
+		{Lo: 0x25a0, Hi: 0x2619, Stride: 1},
+		{Lo: 0x2620, Hi: 0x2767, Stride: 1},
+		{Lo: 0x2776, Hi: 0x2793, Stride: 1},
+		{Lo: 0x2b12, Hi: 0x2b2f, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b59, Stride: 1},
+		{Lo: 0x2bb8, Hi: 0x2bff, Stride: 1},
+		{Lo: 0x2e50, Hi: 0x2e51, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x3000, Stride: 1},
+		{Lo: 0x3003, Hi: 0x3007, Stride: 1},
+		{Lo: 0x3012, Hi: 0x3013, Stride: 1},
+		{Lo: 0x3020, Hi: 0x302f, Stride: 1},
+		{Lo: 0x3031, Hi: 0x3040, Stride: 1},
+		{Lo: 0x3042, Hi: 0x3042, Stride: 1},
+		{Lo: 0x3044, Hi: 0x3044, Stride: 1},
+		{Lo: 0x3046, Hi: 0x3046, Stride: 1},
+		{Lo: 0x3048, Hi: 0x3048, Stride: 1},
+		{Lo: 0x304a, Hi: 0x3062, Stride: 1},
+		{Lo: 0x3064, Hi: 0x3082, Stride: 1},
+		{Lo: 0x3084, Hi: 0x3084, Stride: 1},
+		{Lo: 0x3086, Hi: 0x3086, Stride: 1},
+		{Lo: 0x3088, Hi: 0x308d, Stride: 1},
+		{Lo: 0x308f, Hi: 0x3094, Stride: 1},
+		{Lo: 0x3097, Hi: 0x309a, Stride: 1},
+		{Lo: 0x309d, Hi: 0x309f, Stride: 1},
+		{Lo: 0x30a2, Hi: 0x30a2, Stride: 1},
+		{Lo: 0x30a4, Hi: 0x30a4, Stride: 1},
+		{Lo: 0x30a6, Hi: 0x30a6, Stride: 1},
+		{Lo: 0x30a8, Hi: 0x30a8, Stride: 1},
+		{Lo: 0x30aa, Hi: 0x30c2, Stride: 1},
+		{Lo: 0x30c4, Hi: 0x30e2, Stride: 1},
+		{Lo: 0x30e4, Hi: 0x30e4, Stride: 1},
+		{Lo: 0x30e6, Hi: 0x30e6, Stride: 1},
+		{Lo: 0x30e8, Hi: 0x30ed, Stride: 1},
+		{Lo: 0x30ef, Hi: 0x30f4, Stride: 1},
+		{Lo: 0x30f7, Hi: 0x30fb, Stride: 1},
+		{Lo: 0x30fd, Hi: 0x31ef, Stride: 1},
+		{Lo: 0x3200, Hi: 0x32ff, Stride: 1},
+		{Lo: 0x3358, Hi: 0x337a, Stride: 1},
+		{Lo: 0x3380, Hi: 0xa4cf, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa97f, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7ff, Stride: 1},
+		{Lo: 0xe000, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe1f, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe48, Stride: 1},
+		{Lo: 0xfe53, Hi: 0xfe57, Stride: 1},
+		{Lo: 0xfe5f, Hi: 0xfe62, Stride: 1},
+		{Lo: 0xfe67, Hi: 0xfe6f, Stride: 1},
+		{Lo: 0xff02, Hi: 0xff07, Stride: 1},
+		{Lo: 0xff0a, Hi: 0xff0b, Stride: 1},
+		{Lo: 0xff0d, Hi: 0xff0d, Stride: 1},
+		{Lo: 0xff0f, Hi: 0xff19, Stride: 1},
+		{Lo: 0xff20, Hi: 0xff3a, Stride: 1},
+		{Lo: 0xff3c, Hi: 0xff3c, Stride: 1},
+		{Lo: 0xff3e, Hi: 0xff3e, Stride: 1},
+		{Lo: 0xff40, Hi: 0xff5a, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe2, Stride: 1},
+		{Lo: 0xffe4, Hi: 0xffe7, Stride: 1},
+		{Lo: 0xfff0, Hi: 0xfff8, Stride: 1},
+		{Lo: 0xfffc, Hi: 0xfffd, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10980, Hi: 0x1099f, Stride: 1},
+		{Lo: 0x11580, Hi: 0x115ff, Stride: 1},
+		{Lo: 0x11a00, Hi: 0x11aaf, Stride: 1},
+		{Lo: 0x13000, Hi: 0x1345f, Stride: 1},
+		{Lo: 0x14400, Hi: 0x1467f, Stride: 1},
+		{Lo: 0x16fe0, Hi: 0x18d8f, Stride: 1},
+		{Lo: 0x1aff0, Hi: 0x1b14f, Stride: 1},
+		{Lo: 0x1b153, Hi: 0x1b163, Stride: 1},
+		{Lo: 0x1b168, Hi: 0x1b2ff, Stride: 1},
+		{Lo: 0x1cf00, Hi: 0x1cfcf, Stride: 1},
+		{Lo: 0x1d000, Hi: 0x1d1ff, Stride: 1},
+		{Lo: 0x1d2e0, Hi: 0x1d37f, Stride: 1},
+		{Lo: 0x1d800, Hi: 0x1daaf, Stride: 1},
+		{Lo: 0x1f000, Hi: 0x1f1ff, Stride: 1},
+		{Lo: 0x1f202, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f7ff, Stride: 1},
+		{Lo: 0x1f900, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+		{Lo: 0xf0000, Hi: 0xffffd, Stride: 1},
+		{Lo: 0x100000, Hi: 0x10fffd, Stride: 1},
+	},
+	LatinOffset: 7,
+}
+
+// VerticalTransformedUprightTable matches runes with Vertical_Orientation property of Tu.
+var VerticalTransformedUprightTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x3001, Hi: 0x3002, Stride: 1},
+		{Lo: 0x3041, Hi: 0x3041, Stride: 1},
+		{Lo: 0x3043, Hi: 0x3043, Stride: 1},
+		{Lo: 0x3045, Hi: 0x3045, Stride: 1},
+		{Lo: 0x3047, Hi: 0x3047, Stride: 1},
+		{Lo: 0x3049, Hi: 0x3049, Stride: 1},
+		{Lo: 0x3063, Hi: 0x3063, Stride: 1},
+		{Lo: 0x3083, Hi: 0x3083, Stride: 1},
+		{Lo: 0x3085, Hi: 0x3085, Stride: 1},
+		{Lo: 0x3087, Hi: 0x3087, Stride: 1},
+		{Lo: 0x308e, Hi: 0x308e, Stride: 1},
+		{Lo: 0x3095, Hi: 0x3096, Stride: 1},
+		{Lo: 0x309b, Hi: 0x309c, Stride: 1},
+		{Lo: 0x30a1, Hi: 0x30a1, Stride: 1},
+		{Lo: 0x30a3, Hi: 0x30a3, Stride: 1},
+		{Lo: 0x30a5, Hi: 0x30a5, Stride: 1},
+		{Lo: 0x30a7, Hi: 0x30a7, Stride: 1},
+		{Lo: 0x30a9, Hi: 0x30a9, Stride: 1},
+		{Lo: 0x30c3, Hi: 0x30c3, Stride: 1},
+		{Lo: 0x30e3, Hi: 0x30e3, Stride: 1},
+		{Lo: 0x30e5, Hi: 0x30e5, Stride: 1},
+		{Lo: 0x30e7, Hi: 0x30e7, Stride: 1},
+		{Lo: 0x30ee, Hi: 0x30ee, Stride: 1},
+		{Lo: 0x30f5, Hi: 0x30f6, Stride: 1},
+		{Lo: 0x31f0, Hi: 0x31ff, Stride: 1},
+		{Lo: 0x3300, Hi: 0x3357, Stride: 1},
+		{Lo: 0x337b, Hi: 0x337f, Stride: 1},
+		{Lo: 0xfe50, Hi: 0xfe52, Stride: 1},
+		{Lo: 0xff01, Hi: 0xff01, Stride: 1},
+		{Lo: 0xff0c, Hi: 0xff0c, Stride: 1},
+		{Lo: 0xff0e, Hi: 0xff0e, Stride: 1},
+		{Lo: 0xff1f, Hi: 0xff1f, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
+		{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+		{Lo: 0x1f200, Hi: 0x1f201, Stride: 1},
+	},
+}
+
+// VerticalTransformedRotatedTable matches runes with Vertical_Orientation property of Tr.
+var VerticalTransformedRotatedTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2329, Hi: 0x232a, Stride: 1},
+		{Lo: 0x2e3a, Hi: 0x2e3b, Stride: 1},
+		{Lo: 0x3008, Hi: 0x3011, Stride: 1},
+		{Lo: 0x3014, Hi: 0x301f, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x30a0, Hi: 0x30a0, Stride: 1},
+		{Lo: 0x30fc, Hi: 0x30fc, Stride: 1},
+		{Lo: 0xfe59, Hi: 0xfe5e, Stride: 1},
+		{Lo: 0xff08, Hi: 0xff09, Stride: 1},
+		{Lo: 0xff1a, Hi: 0xff1e, Stride: 1},
+		{Lo: 0xff3b, Hi: 0xff3b, Stride: 1},
+		{Lo: 0xff3d, Hi: 0xff3d, Stride: 1},
+		{Lo: 0xff3f, Hi: 0xff3f, Stride: 1},
+		{Lo: 0xff5b, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe3, Hi: 0xffe3, Stride: 1},
+	},
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Unlicense OR BSD-3-Clause
+
+package unicodedata
+
+import "unicode"
+
+// IsNonBreakingSpace returns true for the space separators
+// preventing line breaks : the no-break space (U+00A0), the figure space (U+2007)
+// and the narrow no-break space (U+202F).
+func IsNonBreakingSpace(r rune) bool {
+	return r == 0x00A0 || r == 0x2007 || r == 0x202F
+}
+
+// IsBreakingSpace returns true for the space separators (general category Zs)
+// allowing a line break after them, like the usual space (U+0020) or the em space (U+2003).
+// See also [IsNonBreakingSpace].
+func IsBreakingSpace(r rune) bool {
+	return unicode.Is(unicode.Zs, r) && !IsNonBreakingSpace(r)
+}
+
+// prependedConcatenationMarks are format characters
+// which are visible (Prepended_Concatenation_Mark property)
+var prependedConcatenationMarks = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+		{Lo: 0x06dd, Hi: 0x070f, Stride: 0x0032},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+		{Lo: 0x08e2, Hi: 0x08e2, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x110bd, Hi: 0x110cd, Stride: 0x10},
+	},
+}
+
+// IsZeroWidth returns true for the characters which are not displayed,
+// but may change how the surrounding text is segmented or shaped :
+// the format controls (general category Cf, like the zero width space U+200B,
+// the zero width joiner U+200D or the bidi controls), and the variation selectors.
+//
+// Note that the soft hyphen (U+00AD) is included, even if it is
+// displayed when a line is broken after it.
+func IsZeroWidth(r rune) bool {
+	if unicode.Is(unicode.Variation_Selector, r) {
+		return true
+	}
+	return unicode.Is(unicode.Cf, r) && !unicode.Is(prependedConcatenationMarks, r)
+}
+
+// IsSegmentSeparator returns true for the segment separators (Bidi_Class S),
+// like the tabulation (U+0009), which are typically displayed as
+// variable width spaces.
+func IsSegmentSeparator(r rune) bool {
+	return unicode.Is(BidiS, r)
+}
+
+// IsParagraphSeparator returns true for the paragraph separators (Bidi_Class B),
+// like the line feed (U+000A) or the paragraph separator (U+2029), which end a paragraph.
+func IsParagraphSeparator(r rune) bool {
+	return unicode.Is(BidiB, r)
+}